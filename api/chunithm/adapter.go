@@ -0,0 +1,110 @@
+package chunithm
+
+import (
+	"context"
+	"haruki-database/api/gamebinding"
+	entchuniMain "haruki-database/database/schema/chunithm/maindb"
+	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ================= Game Binding Adapter =================
+
+// NewBindingGame declares Chunithm to the generic game binding API. Account
+// IDs are Aime card numbers and bindings stay in the Chunithm main database.
+// Every operation goes through BindingService, shared with the
+// /chunithm/user endpoints.
+func NewBindingGame(client *entchuniMain.Client, redisClient *redis.Client, usersClient *users.Client,
+	policy utils.SharedBindingPolicy, publisher *events.Publisher) gamebinding.Game {
+	servers := make([]string, len(utils.ChunithmServers))
+	for i, s := range utils.ChunithmServers {
+		servers[i] = string(s)
+	}
+	return gamebinding.Game{
+		Name:    string(utils.GameChunithm),
+		Servers: servers,
+		ValidateAccountID: func(server string, aimeID string) error {
+			return utils.ValidateAimeID(utils.ChunithmServer(server), aimeID)
+		},
+		Store: &bindingStore{svc: NewBindingService(client, redisClient, usersClient, policy, publisher)},
+	}
+}
+
+func (s *bindingStore) List(ctx context.Context, harukiUserID int, server string) ([]gamebinding.Binding, error) {
+	rows, err := s.svc.ListBindings(ctx, harukiUserID, server)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]gamebinding.Binding, len(rows))
+	for i, r := range rows {
+		out[i] = gamebinding.Binding{
+			ID:           r.ID,
+			Game:         string(utils.GameChunithm),
+			HarukiUserID: r.HarukiUserID,
+			Server:       r.Server,
+			AccountID:    r.AimeID,
+			Label:        r.Label,
+			Visible:      r.Visible,
+			IsDefault:    r.IsDefault,
+			SortOrder:    r.SortOrder,
+		}
+	}
+	return out, nil
+}
+
+func (s *bindingStore) Add(ctx context.Context, harukiUserID int, req *gamebinding.AddBindingRequest) (int, bool, error) {
+	row, shared, err := s.svc.CreateBinding(ctx, harukiUserID, &AddBindingRequest{
+		Server:    req.Server,
+		AimeID:    req.AccountID,
+		Label:     req.Label,
+		Visible:   req.Visible,
+		IsDefault: req.IsDefault,
+	})
+	if err != nil {
+		return 0, shared, err
+	}
+	return row.ID, shared, nil
+}
+
+func (s *bindingStore) Update(ctx context.Context, harukiUserID int, bindingID int, req *gamebinding.UpdateBindingRequest) error {
+	row, err := s.owned(ctx, harukiUserID, bindingID)
+	if err != nil {
+		return err
+	}
+	return s.svc.UpdateBinding(ctx, row, &UpdateBindingRequest{Label: req.Label, Visible: req.Visible})
+}
+
+func (s *bindingStore) SetDefault(ctx context.Context, harukiUserID int, bindingID int) error {
+	row, err := s.owned(ctx, harukiUserID, bindingID)
+	if err != nil {
+		return err
+	}
+	return s.svc.SetDefaultBinding(ctx, row)
+}
+
+func (s *bindingStore) Reorder(ctx context.Context, harukiUserID int, req *gamebinding.ReorderBindingsRequest) error {
+	return s.svc.ReorderBindings(ctx, harukiUserID, &ReorderBindingsRequest{Server: req.Server, BindingIDs: req.BindingIDs})
+}
+
+func (s *bindingStore) Remove(ctx context.Context, harukiUserID int, bindingID int) error {
+	row, err := s.owned(ctx, harukiUserID, bindingID)
+	if err != nil {
+		return err
+	}
+	return s.svc.RemoveBinding(ctx, row)
+}
+
+func (s *bindingStore) ClearCache(ctx context.Context, harukiUserID int) {
+	s.svc.ClearBindingCache(ctx, harukiUserID)
+}
+
+func (s *bindingStore) owned(ctx context.Context, harukiUserID int, bindingID int) (*entchuniMain.ChunithmBinding, error) {
+	row, err := s.svc.ownedBinding(ctx, harukiUserID, bindingID)
+	if entchuniMain.IsNotFound(err) {
+		return nil, ErrBindingNotFound
+	}
+	return row, err
+}
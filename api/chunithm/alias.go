@@ -5,12 +5,134 @@ import (
 	"haruki-database/api"
 	"haruki-database/config"
 	"haruki-database/database/schema/chunithm/maindb"
+	"haruki-database/database/schema/chunithm/maindb/chunithmgroupalias"
 	"haruki-database/database/schema/chunithm/maindb/chunithmmusicalias"
+	"haruki-database/database/schema/chunithm/maindb/chunithmpendingalias"
+	"haruki-database/database/schema/chunithm/maindb/chunithmrejectedalias"
+	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/redis/go-redis/v9"
 )
 
+func (h *AliasHandler) GetGroupMusicIDByAlias(c fiber.Ctx) error {
+	ctx := context.Background()
+	params := getGroupAliasParams(c)
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSAlias)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	rows, err := h.svc.client.ChunithmGroupAlias.
+		Query().
+		Where(
+			chunithmgroupalias.PlatformEQ(params.Platform),
+			chunithmgroupalias.GroupIDEQ(params.GroupID),
+			chunithmgroupalias.AliasEQ(params.AliasStr),
+		).
+		All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if len(rows) == 0 {
+		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrAliasNotFound)
+	}
+	ids := extractGroupMusicIDs(rows)
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", AliasToMusicIDResponse{MatchIDs: ids})
+}
+
+func (h *AliasHandler) GetGroupAliasesByMusicID(c fiber.Ctx) error {
+	ctx := context.Background()
+	params := getGroupAliasParams(c)
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSAlias)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	rows, err := h.svc.client.ChunithmGroupAlias.
+		Query().
+		Where(
+			chunithmgroupalias.PlatformEQ(params.Platform),
+			chunithmgroupalias.GroupIDEQ(params.GroupID),
+			chunithmgroupalias.MusicIDEQ(params.MusicID),
+		).
+		All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if len(rows) == 0 {
+		return api.JSONResponse(c, fiber.StatusNotFound, "No aliases found for this group")
+	}
+	aliases := extractGroupAliasStrings(rows)
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", AllAliasesResponse{Aliases: aliases})
+}
+
+func (h *AliasHandler) AddGroupAlias(c fiber.Ctx) error {
+	ctx := context.Background()
+	params := getGroupAliasParams(c)
+	var req AliasRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	if !api.ValidateAlias(req.Alias) {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid alias")
+	}
+
+	exists, _ := h.svc.client.ChunithmGroupAlias.
+		Query().
+		Where(
+			chunithmgroupalias.PlatformEQ(params.Platform),
+			chunithmgroupalias.GroupIDEQ(params.GroupID),
+			chunithmgroupalias.MusicIDEQ(params.MusicID),
+			chunithmgroupalias.AliasEQ(req.Alias),
+		).
+		Exist(ctx)
+	if exists {
+		return api.JSONResponse(c, fiber.StatusConflict, api.ErrAlreadyExists)
+	}
+
+	_, err := h.svc.client.ChunithmGroupAlias.
+		Create().
+		SetPlatform(params.Platform).
+		SetGroupID(params.GroupID).
+		SetMusicID(params.MusicID).
+		SetAlias(req.Alias).
+		Save(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	h.svc.ClearGroupCache(ctx, params.Platform, params.GroupID, params.MusicID, req.Alias)
+	return api.JSONResponse(c, fiber.StatusOK, "Group alias added")
+}
+
+func (h *AliasHandler) DeleteGroupAlias(c fiber.Ctx) error {
+	ctx := context.Background()
+	params := getGroupAliasParams(c)
+	var req AliasRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	_, err := h.svc.client.ChunithmGroupAlias.
+		Delete().
+		Where(
+			chunithmgroupalias.PlatformEQ(params.Platform),
+			chunithmgroupalias.GroupIDEQ(params.GroupID),
+			chunithmgroupalias.MusicIDEQ(params.MusicID),
+			chunithmgroupalias.AliasEQ(req.Alias),
+		).
+		Exec(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	h.svc.ClearGroupCache(ctx, params.Platform, params.GroupID, params.MusicID, req.Alias)
+	return api.JSONResponse(c, fiber.StatusOK, "Group alias deleted")
+}
+
 func (h *AliasHandler) GetMusicIDByAlias(c fiber.Ctx) error {
 	ctx := context.Background()
 	aliasStr := c.Query("alias")
@@ -71,6 +193,10 @@ func (h *AliasHandler) AddMusicAlias(c fiber.Ctx) error {
 	if musicID <= 0 {
 		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid music_id")
 	}
+	harukiUserID := api.GetHarukiUserIDFromQuery(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid or missing haruki_user_id")
+	}
 	var body AliasRequest
 	if err := c.Bind().Body(&body); err != nil {
 		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
@@ -85,16 +211,43 @@ func (h *AliasHandler) AddMusicAlias(c fiber.Ctx) error {
 	if exists {
 		return api.JSONResponse(c, fiber.StatusConflict, api.ErrAlreadyExists)
 	}
-	newAlias, err := h.svc.client.ChunithmMusicAlias.
+
+	isAdmin, err := h.svc.IsAdmin(ctx, harukiUserID)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if isAdmin {
+		newAlias, err := h.svc.client.ChunithmMusicAlias.
+			Create().
+			SetMusicID(musicID).
+			SetAlias(body.Alias).
+			Save(ctx)
+		if err != nil {
+			return api.InternalError(c)
+		}
+		h.svc.ClearCache(ctx, musicID, body.Alias)
+		return api.JSONResponse(c, fiber.StatusOK, "Alias added", MusicAliasSchema{ID: newAlias.ID, Alias: newAlias.Alias})
+	}
+
+	pendingExists, _ := h.svc.client.ChunithmPendingAlias.
+		Query().
+		Where(chunithmpendingalias.MusicIDEQ(musicID), chunithmpendingalias.AliasEQ(body.Alias)).
+		Exist(ctx)
+	if pendingExists {
+		return api.JSONResponse(c, fiber.StatusConflict, "Alias already pending approval")
+	}
+
+	pending, err := h.svc.client.ChunithmPendingAlias.
 		Create().
 		SetMusicID(musicID).
 		SetAlias(body.Alias).
+		SetSubmittedBy(strconv.Itoa(harukiUserID)).
+		SetSubmittedAt(time.Now()).
 		Save(ctx)
 	if err != nil {
 		return api.InternalError(c)
 	}
-	h.svc.ClearCache(ctx, musicID, body.Alias)
-	return api.JSONResponse(c, fiber.StatusOK, "Alias added", MusicAliasSchema{ID: newAlias.ID, Alias: newAlias.Alias})
+	return api.JSONResponse(c, fiber.StatusOK, "Alias submitted for approval", MusicAliasSchema{ID: pending.ID, Alias: pending.Alias})
 }
 
 func (h *AliasHandler) DeleteMusicAlias(c fiber.Ctx) error {
@@ -121,13 +274,150 @@ func (h *AliasHandler) DeleteMusicAlias(c fiber.Ctx) error {
 	return api.JSONResponse(c, fiber.StatusOK, "Alias deleted")
 }
 
+func (h *AliasHandler) GetPendingAliases(c fiber.Ctx) error {
+	ctx := context.Background()
+	rows, err := h.svc.client.ChunithmPendingAlias.Query().All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if len(rows) == 0 {
+		return api.JSONResponse(c, fiber.StatusNotFound, "No pending aliases")
+	}
+	resp := make([]PendingAlias, len(rows))
+	for i, r := range rows {
+		resp[i] = PendingAlias{
+			ID:          r.ID,
+			MusicID:     r.MusicID,
+			Alias:       r.Alias,
+			SubmittedAt: r.SubmittedAt,
+			SubmittedBy: r.SubmittedBy,
+		}
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "ok", resp)
+}
+
+func (h *AliasHandler) ApprovePendingAlias(c fiber.Ctx) error {
+	ctx := context.Background()
+	pendingID := fiber.Params[int64](c, "pending_id", 0)
+	if pendingID == 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid pending_id")
+	}
+	row, err := h.svc.client.ChunithmPendingAlias.Get(ctx, pendingID)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusNotFound, "Pending alias not found")
+	}
+	if _, err = h.svc.client.ChunithmMusicAlias.
+		Create().
+		SetMusicID(row.MusicID).
+		SetAlias(row.Alias).
+		Save(ctx); err != nil {
+		return api.InternalError(c)
+	}
+	if _, err = h.svc.client.ChunithmPendingAlias.Delete().Where(chunithmpendingalias.IDEQ(pendingID)).Exec(ctx); err != nil {
+		return api.InternalError(c)
+	}
+	h.svc.ClearCache(ctx, row.MusicID, row.Alias)
+	h.svc.ClearStatusCache(ctx, pendingID)
+	return api.JSONResponse(c, fiber.StatusOK, "Alias approved")
+}
+
+func (h *AliasHandler) RejectPendingAlias(c fiber.Ctx) error {
+	ctx := context.Background()
+	pendingID := fiber.Params[int64](c, "pending_id", 0)
+	if pendingID == 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid pending_id")
+	}
+	harukiUserID := api.GetHarukiUserIDFromQuery(c)
+	row, err := h.svc.client.ChunithmPendingAlias.Get(ctx, pendingID)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusNotFound, "Pending alias not found")
+	}
+	var req RejectRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	if !api.ValidateStringLength(req.Reason, api.MaxReasonLength) {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "reason too long")
+	}
+	if _, err = h.svc.client.ChunithmRejectedAlias.
+		Create().
+		SetID(pendingID).
+		SetMusicID(row.MusicID).
+		SetAlias(row.Alias).
+		SetReviewedBy(strconv.Itoa(harukiUserID)).
+		SetReviewedAt(time.Now()).
+		SetReason(req.Reason).
+		Save(ctx); err != nil {
+		return api.InternalError(c)
+	}
+	if _, err = h.svc.client.ChunithmPendingAlias.Delete().Where(chunithmpendingalias.IDEQ(pendingID)).Exec(ctx); err != nil {
+		return api.InternalError(c)
+	}
+	h.svc.ClearStatusCache(ctx, pendingID)
+	return api.JSONResponse(c, fiber.StatusOK, "Alias rejected")
+}
+
+func (h *AliasHandler) GetAliasStatus(c fiber.Ctx) error {
+	ctx := context.Background()
+	pendingID := fiber.Params[int64](c, "pending_id", 0)
+	if pendingID == 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid pending_id")
+	}
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSAlias)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	if _, err = h.svc.client.ChunithmPendingAlias.Get(ctx, pendingID); err == nil {
+		return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", fiber.Map{"status": "pending"})
+	}
+	if rejected, err := h.svc.client.ChunithmRejectedAlias.Query().Where(chunithmrejectedalias.IDEQ(pendingID)).First(ctx); err == nil {
+		return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", fiber.Map{"status": "rejected", "reason": rejected.Reason})
+	}
+	return api.JSONResponse(c, fiber.StatusNotFound, "Not found")
+}
+
 func registerAliasRoutes(router fiber.Router, client *maindb.Client, redisClient *redis.Client) {
 	svc := NewAliasService(client, redisClient)
 	h := NewAliasHandler(svc)
 	r := router.Group("/alias")
 
+	groupRoutes := r.Group("/group/:platform/:group_id")
+	groupRoutes.Get("/by-alias",
+		api.VerifyAPIAuthorization(),
+		parseGroupAliasParams(false, true),
+		h.GetGroupMusicIDByAlias)
+	groupRoutes.Get("/:music_id",
+		api.VerifyAPIAuthorization(),
+		parseGroupAliasParams(true, false),
+		h.GetGroupAliasesByMusicID)
+	groupRoutes.Post("/:music_id",
+		api.VerifyAPIAuthorization(),
+		parseGroupAliasParams(true, false),
+		h.AddGroupAlias)
+	groupRoutes.Delete("/:music_id",
+		api.VerifyAPIAuthorization(),
+		parseGroupAliasParams(true, false),
+		h.DeleteGroupAlias)
 	r.Get("/music-id", h.GetMusicIDByAlias)
+	r.Get("/pending",
+		api.VerifyAPIAuthorization(),
+		requireChunithmAdmin(svc.client),
+		h.GetPendingAliases)
+	r.Post("/pending/:pending_id/approve",
+		api.VerifyAPIAuthorization(),
+		requireChunithmAdmin(svc.client),
+		h.ApprovePendingAlias)
+	r.Post("/pending/:pending_id/reject",
+		api.VerifyAPIAuthorization(),
+		requireChunithmAdmin(svc.client),
+		h.RejectPendingAlias)
+	r.Get("/status/:pending_id",
+		api.VerifyAPIAuthorization(),
+		h.GetAliasStatus)
 	r.Get("/:music_id", h.GetAliasesByMusicID)
 	r.Post("/:music_id", api.VerifyAPIAuthorization(), h.AddMusicAlias)
-	r.Delete("/:music_id", api.VerifyAPIAuthorization(), h.DeleteMusicAlias)
+	r.Delete("/:music_id", api.VerifyAPIAuthorization(), requireChunithmAdmin(svc.client), h.DeleteMusicAlias)
 }
@@ -2,24 +2,35 @@ package chunithm
 
 import (
 	"context"
+	"errors"
 	"haruki-database/api"
+	"haruki-database/api/gamebinding"
 	"haruki-database/config"
 	entchuniMain "haruki-database/database/schema/chunithm/maindb"
 	"haruki-database/database/schema/chunithm/maindb/chunithmbinding"
 	"haruki-database/database/schema/chunithm/maindb/chunithmdefaultserver"
 	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/redis/go-redis/v9"
 )
 
+var (
+	ErrBindingExists   = gamebinding.ErrBindingExists
+	ErrBindingNotFound = gamebinding.ErrBindingNotFound
+	ErrInvalidOrder    = gamebinding.ErrInvalidOrder
+	ErrBindingShared   = gamebinding.ErrBindingShared
+)
+
 // ================= Binding Handlers =================
 
 func (h *BindingHandler) GetDefaultServer(c fiber.Ctx) error {
 	ctx := context.Background()
 	userID := api.GetHarukiUserIDFromPath(c)
 	if userID <= 0 {
-		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
 	}
 
 	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSBinding)
@@ -48,27 +59,15 @@ func (h *BindingHandler) SetDefaultServer(c fiber.Ctx) error {
 	ctx := context.Background()
 	userID := api.GetHarukiUserIDFromPath(c)
 	if userID <= 0 {
-		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
 	}
 	server := c.Params("server")
+	if err := validateServer(server); err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
 
-	row, _ := h.svc.client.ChunithmDefaultServer.
-		Query().
-		Where(chunithmdefaultserver.HarukiUserIDEQ(userID)).
-		First(ctx)
-
-	if row != nil {
-		if _, err := row.Update().SetServer(server).Save(ctx); err != nil {
-			return api.InternalError(c)
-		}
-	} else {
-		if _, err := h.svc.client.ChunithmDefaultServer.
-			Create().
-			SetHarukiUserID(userID).
-			SetServer(server).
-			Save(ctx); err != nil {
-			return api.InternalError(c)
-		}
+	if err := h.svc.SetDefaultServer(ctx, userID, server); err != nil {
+		return api.InternalError(c)
 	}
 
 	h.svc.ClearDefaultServerCache(ctx, userID)
@@ -79,13 +78,10 @@ func (h *BindingHandler) DeleteDefaultServer(c fiber.Ctx) error {
 	ctx := context.Background()
 	userID := api.GetHarukiUserIDFromPath(c)
 	if userID <= 0 {
-		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
 	}
 
-	count, err := h.svc.client.ChunithmDefaultServer.
-		Delete().
-		Where(chunithmdefaultserver.HarukiUserIDEQ(userID)).
-		Exec(ctx)
+	count, err := h.svc.DeleteDefaultServer(ctx, userID)
 	if err != nil {
 		return api.InternalError(c)
 	}
@@ -101,9 +97,12 @@ func (h *BindingHandler) GetBinding(c fiber.Ctx) error {
 	ctx := context.Background()
 	userID := api.GetHarukiUserIDFromPath(c)
 	if userID <= 0 {
-		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
 	}
 	server := c.Params("server")
+	if err := validateServer(server); err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
 
 	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSBinding)
 	if err != nil {
@@ -116,87 +115,657 @@ func (h *BindingHandler) GetBinding(c fiber.Ctx) error {
 	row, err := h.svc.client.ChunithmBinding.
 		Query().
 		Where(chunithmbinding.HarukiUserIDEQ(userID), chunithmbinding.ServerEQ(server)).
+		Order(
+			entchuniMain.Desc(chunithmbinding.FieldIsDefault),
+			entchuniMain.Asc(chunithmbinding.FieldSortOrder),
+			entchuniMain.Asc(chunithmbinding.FieldID),
+		).
 		First(ctx)
 	if err != nil {
 		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
 	}
 
-	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", BindingSchema{
-		UserID: row.HarukiUserID,
-		Server: &row.Server,
-		AimeID: &row.AimeID,
-	})
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", toBindingSchema(row))
 }
 
+// SetBinding is the single-card form of AddBinding: it adds the card when
+// missing and makes it the default card of the server.
 func (h *BindingHandler) SetBinding(c fiber.Ctx) error {
 	ctx := context.Background()
 	userID := api.GetHarukiUserIDFromPath(c)
 	if userID <= 0 {
-		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+	req := AddBindingRequest{Server: c.Params("server"), AimeID: c.Params("aime_id"), IsDefault: true}
+	if err := validateAddBinding(&req); err != nil {
+		return api.ValidationErrorResponse(c, err)
 	}
-	server := c.Params("server")
-	aimeID := c.Params("aime_id")
 
-	row, _ := h.svc.client.ChunithmBinding.
+	row, err := h.svc.client.ChunithmBinding.
 		Query().
-		Where(chunithmbinding.HarukiUserIDEQ(userID), chunithmbinding.ServerEQ(server)).
+		Where(
+			chunithmbinding.HarukiUserIDEQ(userID),
+			chunithmbinding.ServerEQ(req.Server),
+			chunithmbinding.AimeIDEQ(req.AimeID),
+		).
+		Only(ctx)
+	shared := false
+	switch {
+	case err == nil:
+		err = h.svc.SetDefaultBinding(ctx, row)
+	case entchuniMain.IsNotFound(err):
+		row, shared, err = h.svc.CreateBinding(ctx, userID, &req)
+	}
+	if errors.Is(err, ErrBindingExists) {
+		return api.JSONResponse(c, fiber.StatusConflict, api.ErrAlreadyExists)
+	}
+	if errors.Is(err, ErrBindingShared) {
+		return api.ErrorCodeResponse(c, fiber.StatusConflict, api.ErrCodeBindingShared, err.Error())
+	}
+	if err != nil {
+		return api.InternalError(c)
+	}
+
+	h.svc.ClearBindingCache(ctx, userID)
+	return api.JSONResponse(c, fiber.StatusOK, "Binding updated", AddBindingResponse{BindingID: row.ID, Shared: shared})
+}
+
+func (h *BindingHandler) DeleteBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	userID := api.GetHarukiUserIDFromPath(c)
+	if userID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+
+	row, err := h.svc.client.ChunithmBinding.
+		Query().
+		Where(
+			chunithmbinding.HarukiUserIDEQ(userID),
+			chunithmbinding.ServerEQ(c.Params("server")),
+			chunithmbinding.AimeIDEQ(c.Params("aime_id")),
+		).
+		Only(ctx)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
+	}
+	if err := h.svc.RemoveBinding(ctx, row); err != nil {
+		return api.InternalError(c)
+	}
+
+	h.svc.ClearBindingCache(ctx, userID)
+	return api.JSONResponse(c, fiber.StatusOK, "Binding deleted")
+}
+
+// ================= Multi-Binding Handlers =================
+
+func (h *BindingHandler) ListBindings(c fiber.Ctx) error {
+	ctx := context.Background()
+	userID := api.GetHarukiUserIDFromPath(c)
+	if userID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+
+	server := c.Query("server")
+	if server != "" {
+		if err := validateServer(server); err != nil {
+			return api.ValidationErrorResponse(c, err)
+		}
+	}
+
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSBinding)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+
+	rows, err := h.svc.ListBindings(ctx, userID, server)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	out := make([]BindingSchema, len(rows))
+	for i, r := range rows {
+		out[i] = toBindingSchema(r)
+	}
+
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", BindingListResponse{Bindings: out})
+}
+
+func (h *BindingHandler) AddBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	userID := api.GetHarukiUserIDFromPath(c)
+	if userID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+	var req AddBindingRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidRequest, api.ErrInvalidRequest)
+	}
+	if err := validateAddBinding(&req); err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+
+	row, shared, err := h.svc.CreateBinding(ctx, userID, &req)
+	if errors.Is(err, ErrBindingExists) {
+		return api.JSONResponse(c, fiber.StatusConflict, api.ErrAlreadyExists)
+	}
+	if errors.Is(err, ErrBindingShared) {
+		return api.ErrorCodeResponse(c, fiber.StatusConflict, api.ErrCodeBindingShared, err.Error())
+	}
+	if err != nil {
+		return api.InternalError(c)
+	}
+
+	h.svc.ClearBindingCache(ctx, userID)
+	return api.JSONResponse(c, fiber.StatusCreated, "ok", AddBindingResponse{BindingID: row.ID, Shared: shared})
+}
+
+func (h *BindingHandler) UpdateBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	userID := api.GetHarukiUserIDFromPath(c)
+	if userID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+	var req UpdateBindingRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidRequest, api.ErrInvalidRequest)
+	}
+	if err := validateLabel(req.Label); err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+
+	row, err := h.svc.getOwnedBinding(ctx, c, userID)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
+	}
+	if err := h.svc.UpdateBinding(ctx, row, &req); err != nil {
+		return api.InternalError(c)
+	}
+
+	h.svc.ClearBindingCache(ctx, userID)
+	return api.JSONResponse(c, fiber.StatusOK, "Binding updated")
+}
+
+func (h *BindingHandler) SetDefaultBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	userID := api.GetHarukiUserIDFromPath(c)
+	if userID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+
+	row, err := h.svc.getOwnedBinding(ctx, c, userID)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
+	}
+	if err := h.svc.SetDefaultBinding(ctx, row); err != nil {
+		return api.InternalError(c)
+	}
+
+	h.svc.ClearBindingCache(ctx, userID)
+	return api.JSONResponse(c, fiber.StatusOK, "Default binding set for "+row.Server)
+}
+
+func (h *BindingHandler) ReorderBindings(c fiber.Ctx) error {
+	ctx := context.Background()
+	userID := api.GetHarukiUserIDFromPath(c)
+	if userID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+	var req ReorderBindingsRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidRequest, api.ErrInvalidRequest)
+	}
+
+	if err := validateServer(req.Server); err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+
+	err := h.svc.ReorderBindings(ctx, userID, &req)
+	if errors.Is(err, ErrInvalidOrder) {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidOrder, err.Error())
+	}
+	if err != nil {
+		return api.InternalError(c)
+	}
+
+	h.svc.ClearBindingCache(ctx, userID)
+	return api.JSONResponse(c, fiber.StatusOK, "Bindings reordered")
+}
+
+func (h *BindingHandler) RemoveBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	userID := api.GetHarukiUserIDFromPath(c)
+	if userID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+
+	row, err := h.svc.getOwnedBinding(ctx, c, userID)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
+	}
+	if err := h.svc.RemoveBinding(ctx, row); err != nil {
+		return api.InternalError(c)
+	}
+
+	h.svc.ClearBindingCache(ctx, userID)
+	return api.JSONResponse(c, fiber.StatusOK, "Binding deleted")
+}
+
+// ================= Admin Handlers =================
+
+// GetBindingOwners lists every Haruki user bound to an Aime card.
+func (h *BindingHandler) GetBindingOwners(c fiber.Ctx) error {
+	ctx := context.Background()
+	server, err := utils.ParseChunithmServer(c.Query("server"))
+	if err != nil {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidServer, err.Error())
+	}
+	aimeID := c.Query("aime_id")
+	if err := utils.ValidateAimeID(server, aimeID); err != nil {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidAimeID, err.Error())
+	}
+
+	rows, err := h.svc.client.ChunithmBinding.
+		Query().
+		Where(chunithmbinding.ServerEQ(string(server)), chunithmbinding.AimeIDEQ(aimeID)).
+		Order(entchuniMain.Asc(chunithmbinding.FieldHarukiUserID)).
+		All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	out := make([]BindingSchema, len(rows))
+	for i, r := range rows {
+		out[i] = toBindingSchema(r)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "ok", BindingOwnersResponse{Server: string(server), AimeID: aimeID, Bindings: out})
+}
+
+// ================= Binding Service Methods =================
+
+// SetDefaultServer stores server as the user's default server.
+func (s *BindingService) SetDefaultServer(ctx context.Context, userID int, server string) error {
+	row, _ := s.client.ChunithmDefaultServer.
+		Query().
+		Where(chunithmdefaultserver.HarukiUserIDEQ(userID)).
 		First(ctx)
 
 	if row != nil {
-		if _, err := row.Update().SetAimeID(aimeID).Save(ctx); err != nil {
-			return api.InternalError(c)
+		if _, err := row.Update().SetServer(server).Save(ctx); err != nil {
+			return err
 		}
 	} else {
-		if _, err := h.svc.client.ChunithmBinding.
+		if _, err := s.client.ChunithmDefaultServer.
 			Create().
 			SetHarukiUserID(userID).
 			SetServer(server).
-			SetAimeID(aimeID).
 			Save(ctx); err != nil {
-			return api.InternalError(c)
+			return err
 		}
 	}
+	s.publish(ctx, utils.EventDefaultServerChanged, userID, server, 0)
+	return nil
+}
 
-	h.svc.ClearBindingCache(ctx, userID, server)
-	return api.JSONResponse(c, fiber.StatusOK, "Binding updated")
+// DeleteDefaultServer clears the user's default server and returns how many
+// rows were removed.
+func (s *BindingService) DeleteDefaultServer(ctx context.Context, userID int) (int, error) {
+	count, err := s.client.ChunithmDefaultServer.
+		Delete().
+		Where(chunithmdefaultserver.HarukiUserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil || count == 0 {
+		return count, err
+	}
+	s.publish(ctx, utils.EventDefaultServerChanged, userID, "", 0)
+	return count, nil
 }
 
-func (h *BindingHandler) DeleteBinding(c fiber.Ctx) error {
-	ctx := context.Background()
-	userID := api.GetHarukiUserIDFromPath(c)
-	if userID <= 0 {
-		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+// ListBindings returns the user's cards in display order, limited to server
+// when it is not empty.
+func (s *BindingService) ListBindings(ctx context.Context, userID int, server string) ([]*entchuniMain.ChunithmBinding, error) {
+	q := s.client.ChunithmBinding.Query().Where(chunithmbinding.HarukiUserIDEQ(userID))
+	if server != "" {
+		q = q.Where(chunithmbinding.ServerEQ(server))
 	}
-	server := c.Params("server")
-	aimeID := c.Params("aime_id")
+	return q.
+		Order(
+			entchuniMain.Asc(chunithmbinding.FieldServer),
+			entchuniMain.Asc(chunithmbinding.FieldSortOrder),
+			entchuniMain.Asc(chunithmbinding.FieldID),
+		).
+		All(ctx)
+}
 
-	count, err := h.svc.client.ChunithmBinding.
-		Delete().
+// CreateBinding appends a card the user has not bound on the server yet
+// after the user's other cards there. The first card of a server always
+// becomes its default. The checks and the insert share one transaction. It
+// returns ErrBindingExists or ErrBindingShared when the card cannot be added,
+// and whether another user has bound the card.
+func (s *BindingService) CreateBinding(ctx context.Context, userID int, req *AddBindingRequest) (*entchuniMain.ChunithmBinding, bool, error) {
+	var row *entchuniMain.ChunithmBinding
+	var shared bool
+	err := s.withTx(ctx, func(tx *entchuniMain.Tx) error {
+		exists, err := tx.ChunithmBinding.
+			Query().
+			Where(
+				chunithmbinding.HarukiUserIDEQ(userID),
+				chunithmbinding.ServerEQ(req.Server),
+				chunithmbinding.AimeIDEQ(req.AimeID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrBindingExists
+		}
+		if shared, err = s.checkSharedBinding(ctx, tx.Client(), userID, req.Server, req.AimeID); err != nil {
+			return err
+		}
+		last, err := tx.ChunithmBinding.
+			Query().
+			Where(chunithmbinding.HarukiUserIDEQ(userID), chunithmbinding.ServerEQ(req.Server)).
+			Order(entchuniMain.Desc(chunithmbinding.FieldSortOrder)).
+			First(ctx)
+		if err != nil && !entchuniMain.IsNotFound(err) {
+			return err
+		}
+		sortOrder, isDefault := 0, true
+		if last != nil {
+			sortOrder, isDefault = last.SortOrder+1, req.IsDefault
+		}
+		if isDefault {
+			if err := clearDefaultBinding(ctx, tx, userID, req.Server); err != nil {
+				return err
+			}
+		}
+		visible := true
+		if req.Visible != nil {
+			visible = *req.Visible
+		}
+		row, err = tx.ChunithmBinding.
+			Create().
+			SetHarukiUserID(userID).
+			SetServer(req.Server).
+			SetAimeID(req.AimeID).
+			SetNillableLabel(req.Label).
+			SetVisible(visible).
+			SetIsDefault(isDefault).
+			SetSortOrder(sortOrder).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, shared, err
+	}
+	s.publish(ctx, utils.EventBindingCreated, userID, row.Server, row.ID)
+	return row, shared, nil
+}
+
+// UpdateBinding changes the label and visibility of a card. An empty label
+// clears it.
+func (s *BindingService) UpdateBinding(ctx context.Context, row *entchuniMain.ChunithmBinding, req *UpdateBindingRequest) error {
+	upd := row.Update()
+	if req.Label != nil {
+		if *req.Label == "" {
+			upd.ClearLabel()
+		} else {
+			upd.SetLabel(*req.Label)
+		}
+	}
+	if req.Visible != nil {
+		upd.SetVisible(*req.Visible)
+	}
+	if err := upd.Exec(ctx); err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventBindingUpdated, row.HarukiUserID, row.Server, row.ID)
+	return nil
+}
+
+func (s *BindingService) SetDefaultBinding(ctx context.Context, row *entchuniMain.ChunithmBinding) error {
+	err := s.withTx(ctx, func(tx *entchuniMain.Tx) error {
+		if err := clearDefaultBinding(ctx, tx, row.HarukiUserID, row.Server); err != nil {
+			return err
+		}
+		return tx.ChunithmBinding.UpdateOneID(row.ID).SetIsDefault(true).Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventDefaultBindingChanged, row.HarukiUserID, row.Server, row.ID)
+	return nil
+}
+
+// RemoveBinding deletes a card and, when it was the default, promotes the
+// next card of the server in display order.
+func (s *BindingService) RemoveBinding(ctx context.Context, row *entchuniMain.ChunithmBinding) error {
+	err := s.withTx(ctx, func(tx *entchuniMain.Tx) error {
+		if err := tx.ChunithmBinding.DeleteOneID(row.ID).Exec(ctx); err != nil {
+			return err
+		}
+		if !row.IsDefault {
+			return nil
+		}
+		next, err := tx.ChunithmBinding.
+			Query().
+			Where(chunithmbinding.HarukiUserIDEQ(row.HarukiUserID), chunithmbinding.ServerEQ(row.Server)).
+			Order(entchuniMain.Asc(chunithmbinding.FieldSortOrder), entchuniMain.Asc(chunithmbinding.FieldID)).
+			First(ctx)
+		if entchuniMain.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return next.Update().SetIsDefault(true).Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventBindingDeleted, row.HarukiUserID, row.Server, row.ID)
+	return nil
+}
+
+func (s *BindingService) ReorderBindings(ctx context.Context, userID int, req *ReorderBindingsRequest) error {
+	err := s.withTx(ctx, func(tx *entchuniMain.Tx) error {
+		ids, err := tx.ChunithmBinding.
+			Query().
+			Where(chunithmbinding.HarukiUserIDEQ(userID), chunithmbinding.ServerEQ(req.Server)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 || len(ids) != len(req.BindingIDs) {
+			return ErrInvalidOrder
+		}
+		owned := make(map[int]bool, len(ids))
+		for _, id := range ids {
+			owned[id] = true
+		}
+		for i, id := range req.BindingIDs {
+			if !owned[id] {
+				return ErrInvalidOrder
+			}
+			delete(owned, id)
+			if err := tx.ChunithmBinding.UpdateOneID(id).SetSortOrder(i).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventBindingReordered, userID, req.Server, 0)
+	return nil
+}
+
+// publish sends a change event for the Chunithm bindings of the user. Server
+// and bindingID are left out of the event when empty.
+func (s *BindingService) publish(ctx context.Context, eventType utils.EventType, userID int, server string, bindingID int) {
+	s.publisher.Publish(ctx, eventType, events.Event{
+		Game:         string(utils.GameChunithm),
+		HarukiUserID: userID,
+		Server:       server,
+		BindingID:    bindingID,
+	})
+}
+
+// checkSharedBinding reports whether another Haruki user has bound the card,
+// returning ErrBindingShared when the shared binding policy forbids it.
+func (s *BindingService) checkSharedBinding(ctx context.Context, client *entchuniMain.Client, userID int, server, aimeID string) (bool, error) {
+	if s.policy == utils.SharedBindingPolicyAllow {
+		return false, nil
+	}
+	shared, err := client.ChunithmBinding.
+		Query().
 		Where(
-			chunithmbinding.HarukiUserIDEQ(userID),
 			chunithmbinding.ServerEQ(server),
 			chunithmbinding.AimeIDEQ(aimeID),
+			chunithmbinding.HarukiUserIDNEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+	if shared && s.policy == utils.SharedBindingPolicyForbid {
+		return true, ErrBindingShared
+	}
+	return shared, nil
+}
+
+func (s *BindingService) getOwnedBinding(ctx context.Context, c fiber.Ctx, userID int) (*entchuniMain.ChunithmBinding, error) {
+	return s.ownedBinding(ctx, userID, fiber.Params[int](c, "binding_id", 0))
+}
+
+func (s *BindingService) ownedBinding(ctx context.Context, userID, bindingID int) (*entchuniMain.ChunithmBinding, error) {
+	return s.client.ChunithmBinding.
+		Query().
+		Where(
+			chunithmbinding.HarukiUserIDEQ(userID),
+			chunithmbinding.IDEQ(bindingID),
 		).
+		Only(ctx)
+}
+
+func (s *BindingService) withTx(ctx context.Context, fn func(tx *entchuniMain.Tx) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// MigrateBindingDefaults marks a default card on every server that has cards
+// but no default, picking the first card in display order. Bindings created
+// before multiple cards per server existed all become their server's default.
+func MigrateBindingDefaults(ctx context.Context, client *entchuniMain.Client) error {
+	rows, err := client.ChunithmBinding.
+		Query().
+		Order(entchuniMain.Asc(chunithmbinding.FieldSortOrder), entchuniMain.Asc(chunithmbinding.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	type serverKey struct {
+		userID int
+		server string
+	}
+	first := make(map[serverKey]*entchuniMain.ChunithmBinding)
+	hasDefault := make(map[serverKey]bool)
+	for _, r := range rows {
+		k := serverKey{r.HarukiUserID, r.Server}
+		if _, ok := first[k]; !ok {
+			first[k] = r
+		}
+		hasDefault[k] = hasDefault[k] || r.IsDefault
+	}
+	for k, r := range first {
+		if hasDefault[k] {
+			continue
+		}
+		if err := r.Update().SetIsDefault(true).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func clearDefaultBinding(ctx context.Context, tx *entchuniMain.Tx, userID int, server string) error {
+	return tx.ChunithmBinding.
+		Update().
+		Where(
+			chunithmbinding.HarukiUserIDEQ(userID),
+			chunithmbinding.ServerEQ(server),
+			chunithmbinding.IsDefault(true),
+		).
+		SetIsDefault(false).
 		Exec(ctx)
+}
+
+func validateServer(server string) error {
+	if _, err := utils.ParseChunithmServer(server); err != nil {
+		return &api.ValidationError{Code: api.ErrCodeInvalidServer, Message: err.Error()}
+	}
+	return nil
+}
+
+func validateLabel(label *string) error {
+	if label != nil && !api.ValidateStringLength(*label, MaxBindingLabelLength) {
+		return &api.ValidationError{Code: api.ErrCodeInvalidLabel, Message: "invalid label"}
+	}
+	return nil
+}
+
+func validateAddBinding(req *AddBindingRequest) error {
+	server, err := utils.ParseChunithmServer(req.Server)
 	if err != nil {
-		return api.InternalError(c)
+		return &api.ValidationError{Code: api.ErrCodeInvalidServer, Message: err.Error()}
 	}
-	if count == 0 {
-		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
+	if err := utils.ValidateAimeID(server, req.AimeID); err != nil {
+		return &api.ValidationError{Code: api.ErrCodeInvalidAimeID, Message: err.Error()}
 	}
+	return validateLabel(req.Label)
+}
 
-	h.svc.ClearBindingCache(ctx, userID, server)
-	return api.JSONResponse(c, fiber.StatusOK, "Binding deleted")
+func toBindingSchema(row *entchuniMain.ChunithmBinding) BindingSchema {
+	server, aimeID := row.Server, row.AimeID
+	return BindingSchema{
+		ID:        row.ID,
+		UserID:    row.HarukiUserID,
+		Server:    &server,
+		AimeID:    &aimeID,
+		Label:     row.Label,
+		Visible:   row.Visible,
+		IsDefault: row.IsDefault,
+		SortOrder: row.SortOrder,
+	}
 }
 
 // ================= Route Registration =================
 
-func registerBindingRoutes(router fiber.Router, client *entchuniMain.Client, redisClient *redis.Client, usersClient *users.Client) {
-	svc := NewBindingService(client, redisClient, usersClient)
+func registerBindingRoutes(router fiber.Router, client *entchuniMain.Client, redisClient *redis.Client, usersClient *users.Client,
+	policy utils.SharedBindingPolicy, publisher *events.Publisher) {
+	svc := NewBindingService(client, redisClient, usersClient, policy, publisher)
 	h := NewBindingHandler(svc)
 
+	router.Get("/binding/by-aime", api.VerifyAPIAuthorization(), requireChunithmAdmin(client), h.GetBindingOwners)
+
 	r := router.Group("/user/:haruki_user_id", api.VerifyAPIAuthorization())
 
+	r.Get("/binding", h.ListBindings)
+	r.Post("/binding", h.AddBinding)
+	r.Put("/binding/order", h.ReorderBindings)
+	r.Patch("/binding/:binding_id", h.UpdateBinding)
+	r.Put("/binding/:binding_id/default", h.SetDefaultBinding)
+	r.Delete("/binding/:binding_id", h.RemoveBinding)
+
 	r.Get("/default", h.GetDefaultServer)
 	r.Put("/default/:server", h.SetDefaultServer)
 	r.Delete("/default", h.DeleteDefaultServer)
@@ -0,0 +1,445 @@
+package chunithm
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"haruki-database/api"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	entchuniMain "haruki-database/database/schema/chunithm/maindb"
+	entchuniMusic "haruki-database/database/schema/chunithm/music"
+	"haruki-database/database/schema/chunithm/music/chunithmchartdata"
+	"haruki-database/database/schema/chunithm/music/chunithmmusic"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicdifficulty"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/redis/go-redis/v9"
+)
+
+func (h *ChartHandler) GetNoteDensity(c fiber.Ctx) error {
+	ctx := context.Background()
+	difficulty, err := parseOptionalDifficulty(c, "difficulty")
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	sortOrder := c.Query("order", "desc")
+	if sortOrder != "asc" && sortOrder != "desc" {
+		return api.JSONResponse(c, fiber.StatusBadRequest, fmt.Sprintf("invalid order: %s", sortOrder))
+	}
+	limit := fiber.Query[int](c, "limit", DefaultChartStatsLimit)
+	if limit <= 0 || limit > MaxChartStatsLimit {
+		return api.JSONResponse(c, fiber.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", MaxChartStatsLimit))
+	}
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSMusic)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	entries, err := h.svc.loadChartEntries(ctx, c.Query("version"), difficulty, nil)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	items := make([]ChartSummarySchema, 0, len(entries))
+	for _, e := range entries {
+		item := toChartSummary(e)
+		if item.NotesPerMinute != nil {
+			items = append(items, item)
+		}
+	}
+	slices.SortFunc(items, func(a, b ChartSummarySchema) int {
+		c := cmp.Compare(*a.NotesPerMinute, *b.NotesPerMinute)
+		if sortOrder == "desc" {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+		return compareChartKeys(a, b)
+	})
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, h.svc.releaseAwareTTL(ctx), key, fiber.StatusOK, "ok", items[:min(len(items), limit)])
+}
+
+func (h *ChartHandler) GetConstantDistribution(c fiber.Ctx) error {
+	ctx := context.Background()
+	difficulty, err := parseOptionalDifficulty(c, "difficulty")
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSMusic)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	musicIDs, err := h.svc.client.ChunithmMusic.
+		Query().
+		Where(
+			chunithmmusic.IsDeletedEQ(false),
+			chunithmmusic.Or(
+				chunithmmusic.ReleaseDateLTE(time.Now()),
+				chunithmmusic.ReleaseDateIsNil(),
+			),
+		).
+		Select(chunithmmusic.FieldMusicID).
+		Ints(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	diffRows, err := h.svc.client.ChunithmMusicDifficulty.
+		Query().
+		Where(chunithmmusicdifficulty.MusicIDIn(musicIDs...)).
+		All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	order, err := h.svc.loadVersionOrder(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	counts := make(map[int]int)
+	for _, d := range pickDifficulties(diffRows, c.Query("version"), order) {
+		for i, v := range difficultyConsts(d) {
+			if v != nil && (difficulty == nil || *difficulty == i) {
+				counts[int(math.Round(*v*10))]++
+			}
+		}
+	}
+	constKeys := slices.Sorted(maps.Keys(counts))
+	result := []LevelDistributionSchema{}
+	for _, k := range constKeys {
+		level := levelLabel(k)
+		if len(result) == 0 || result[len(result)-1].Level != level {
+			result = append(result, LevelDistributionSchema{Level: level, Constants: []ConstantCountSchema{}})
+		}
+		last := &result[len(result)-1]
+		last.Count += counts[k]
+		last.Constants = append(last.Constants, ConstantCountSchema{Const: float64(k) / 10, Count: counts[k]})
+	}
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, h.svc.releaseAwareTTL(ctx), key, fiber.StatusOK, "ok", result)
+}
+
+func (h *ChartHandler) GetCreators(c fiber.Ctx) error {
+	ctx := context.Background()
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSMusic)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	entries, err := h.svc.loadChartEntries(ctx, "", nil, nil)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	counts := make(map[string]int)
+	for _, e := range entries {
+		if e.chart.Creator != nil {
+			counts[*e.chart.Creator]++
+		}
+	}
+	result := make([]CreatorStatSchema, 0, len(counts))
+	for creator, n := range counts {
+		result = append(result, CreatorStatSchema{Creator: creator, ChartCount: n})
+	}
+	slices.SortFunc(result, func(a, b CreatorStatSchema) int {
+		if c := cmp.Compare(b.ChartCount, a.ChartCount); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Creator, b.Creator)
+	})
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, h.svc.releaseAwareTTL(ctx), key, fiber.StatusOK, "ok", result)
+}
+
+func (h *ChartHandler) GetChartsByCreator(c fiber.Ctx) error {
+	ctx := context.Background()
+	creator := c.Query("creator")
+	if creator == "" {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "creator required")
+	}
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSMusic)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	entries, err := h.svc.loadChartEntries(ctx, c.Query("version"), nil, &creator)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	result := make([]ChartSummarySchema, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, toChartSummary(e))
+	}
+	slices.SortFunc(result, compareChartKeys)
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, h.svc.releaseAwareTTL(ctx), key, fiber.StatusOK, "ok", result)
+}
+
+func (h *ChartHandler) CompareCharts(c fiber.Ctx) error {
+	ctx := context.Background()
+	musicA := fiber.Query[int](c, "music_a", -1)
+	musicB := fiber.Query[int](c, "music_b", -1)
+	if musicA <= 0 || musicB <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "music_a and music_b required")
+	}
+	diffA, err := parseOptionalDifficulty(c, "difficulty_a")
+	if err != nil || diffA == nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid difficulty_a")
+	}
+	diffB, err := parseOptionalDifficulty(c, "difficulty_b")
+	if err != nil || diffB == nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid difficulty_b")
+	}
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSMusic)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	a, err := h.svc.loadChartEntry(ctx, musicA, *diffA, c.Query("version"))
+	if err != nil {
+		if entchuniMusic.IsNotFound(err) {
+			return api.JSONResponse(c, fiber.StatusNotFound, "Chart a not found")
+		}
+		return api.InternalError(c)
+	}
+	b, err := h.svc.loadChartEntry(ctx, musicB, *diffB, c.Query("version"))
+	if err != nil {
+		if entchuniMusic.IsNotFound(err) {
+			return api.JSONResponse(c, fiber.StatusNotFound, "Chart b not found")
+		}
+		return api.InternalError(c)
+	}
+	detailA, detailB := toChartDetail(a), toChartDetail(b)
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, h.svc.releaseAwareTTL(ctx), key, fiber.StatusOK, "ok", ChartComparisonSchema{
+		A: detailA,
+		B: detailB,
+		Delta: NoteCountsSchema{
+			Tap:   detailB.Counts.Tap - detailA.Counts.Tap,
+			Hold:  detailB.Counts.Hold - detailA.Counts.Hold,
+			Slide: detailB.Counts.Slide - detailA.Counts.Slide,
+			Air:   detailB.Counts.Air - detailA.Counts.Air,
+			Flick: detailB.Counts.Flick - detailA.Counts.Flick,
+			Total: detailB.Counts.Total - detailA.Counts.Total,
+		},
+		Share: NoteCompositionSchema{
+			Tap:   roundShare(detailB.Composition.Tap - detailA.Composition.Tap),
+			Hold:  roundShare(detailB.Composition.Hold - detailA.Composition.Hold),
+			Slide: roundShare(detailB.Composition.Slide - detailA.Composition.Slide),
+			Air:   roundShare(detailB.Composition.Air - detailA.Composition.Air),
+			Flick: roundShare(detailB.Composition.Flick - detailA.Composition.Flick),
+		},
+	})
+}
+
+// loadChartEntries returns the charts of released, non-deleted songs,
+// optionally narrowed to one difficulty or creator.
+func (s *MusicService) loadChartEntries(ctx context.Context, version string, difficulty *int, creator *string) ([]chartEntry, error) {
+	query := s.client.ChunithmChartData.Query()
+	if difficulty != nil {
+		query = query.Where(chunithmchartdata.DifficultyEQ(*difficulty))
+	}
+	if creator != nil {
+		query = query.Where(chunithmchartdata.CreatorEQ(*creator))
+	}
+	charts, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	musicRows, err := s.client.ChunithmMusic.
+		Query().
+		Where(
+			chunithmmusic.IsDeletedEQ(false),
+			chunithmmusic.Or(
+				chunithmmusic.ReleaseDateLTE(time.Now()),
+				chunithmmusic.ReleaseDateIsNil(),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	musicMap := make(map[int]*entchuniMusic.ChunithmMusic, len(musicRows))
+	for _, m := range musicRows {
+		musicMap[m.MusicID] = m
+	}
+	diffRows, err := s.client.ChunithmMusicDifficulty.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	order, err := s.loadVersionOrder(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diffMap := pickDifficulties(diffRows, version, order)
+	entries := make([]chartEntry, 0, len(charts))
+	for _, ch := range charts {
+		m, ok := musicMap[ch.MusicID]
+		if !ok {
+			continue
+		}
+		entries = append(entries, chartEntry{
+			chart:    ch,
+			music:    m,
+			constant: chartConst(diffMap[ch.MusicID], ch.Difficulty),
+		})
+	}
+	return entries, nil
+}
+
+// loadChartEntry returns one chart of a released, non-deleted song; charts of
+// other songs are reported as not found like in loadChartEntries.
+func (s *MusicService) loadChartEntry(ctx context.Context, musicID, difficulty int, version string) (chartEntry, error) {
+	ch, err := s.client.ChunithmChartData.
+		Query().
+		Where(
+			chunithmchartdata.MusicIDEQ(musicID),
+			chunithmchartdata.DifficultyEQ(difficulty),
+		).
+		Only(ctx)
+	if err != nil {
+		return chartEntry{}, err
+	}
+	m, err := s.client.ChunithmMusic.
+		Query().
+		Where(
+			chunithmmusic.MusicIDEQ(musicID),
+			chunithmmusic.IsDeletedEQ(false),
+			chunithmmusic.Or(
+				chunithmmusic.ReleaseDateLTE(time.Now()),
+				chunithmmusic.ReleaseDateIsNil(),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		return chartEntry{}, err
+	}
+	diffRows, err := s.client.ChunithmMusicDifficulty.
+		Query().
+		Where(chunithmmusicdifficulty.MusicIDEQ(musicID)).
+		All(ctx)
+	if err != nil {
+		return chartEntry{}, err
+	}
+	order, err := s.loadVersionOrder(ctx)
+	if err != nil {
+		return chartEntry{}, err
+	}
+	return chartEntry{
+		chart:    ch,
+		music:    m,
+		constant: chartConst(pickDifficulties(diffRows, version, order)[musicID], difficulty),
+	}, nil
+}
+
+func parseOptionalDifficulty(c fiber.Ctx, name string) (*int, error) {
+	v := c.Query(name)
+	if v == "" {
+		return nil, nil
+	}
+	d, err := strconv.Atoi(v)
+	if err != nil || d < 0 || d > MaxDifficultyIndex {
+		return nil, fmt.Errorf("invalid %s: %s", name, v)
+	}
+	return &d, nil
+}
+
+func chartConst(d *entchuniMusic.ChunithmMusicDifficulty, difficulty int) *float64 {
+	return difficultyConsts(d)[difficulty]
+}
+
+func toChartSummary(e chartEntry) ChartSummarySchema {
+	item := ChartSummarySchema{
+		MusicID:    e.chart.MusicID,
+		Difficulty: e.chart.Difficulty,
+		Title:      e.music.Title,
+		Creator:    e.chart.Creator,
+		Const:      e.constant,
+		TotalCount: e.chart.TotalCount,
+		Duration:   e.chart.Duration,
+	}
+	if e.chart.TotalCount != nil && e.chart.Duration != nil && *e.chart.Duration > 0 {
+		npm := math.Round(float64(*e.chart.TotalCount)/(*e.chart.Duration)*60*100) / 100
+		item.NotesPerMinute = &npm
+	}
+	return item
+}
+
+func toChartDetail(e chartEntry) ChartDetailSchema {
+	counts := NoteCountsSchema{
+		Tap:   derefInt(e.chart.TapCount),
+		Hold:  derefInt(e.chart.HoldCount),
+		Slide: derefInt(e.chart.SlideCount),
+		Air:   derefInt(e.chart.AirCount),
+		Flick: derefInt(e.chart.FlickCount),
+	}
+	sum := counts.Tap + counts.Hold + counts.Slide + counts.Air + counts.Flick
+	counts.Total = sum
+	if e.chart.TotalCount != nil {
+		counts.Total = *e.chart.TotalCount
+	}
+	var composition NoteCompositionSchema
+	if sum > 0 {
+		composition = NoteCompositionSchema{
+			Tap:   roundShare(float64(counts.Tap) / float64(sum)),
+			Hold:  roundShare(float64(counts.Hold) / float64(sum)),
+			Slide: roundShare(float64(counts.Slide) / float64(sum)),
+			Air:   roundShare(float64(counts.Air) / float64(sum)),
+			Flick: roundShare(float64(counts.Flick) / float64(sum)),
+		}
+	}
+	return ChartDetailSchema{
+		ChunithmChartSummary: toChartSummary(e),
+		Counts:               counts,
+		Composition:          composition,
+	}
+}
+
+// levelLabel maps a constant in tenths to the level shown in game: constants
+// from .5 upwards display as "N+" from level 7 on.
+func levelLabel(tenths int) string {
+	level := tenths / 10
+	if level >= PlusLevelThreshold && tenths%10 >= 5 {
+		return strconv.Itoa(level) + "+"
+	}
+	return strconv.Itoa(level)
+}
+
+func compareChartKeys(a, b ChartSummarySchema) int {
+	if c := cmp.Compare(a.MusicID, b.MusicID); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Difficulty, b.Difficulty)
+}
+
+func roundShare(v float64) float64 {
+	return math.Round(v*10000) / 10000
+}
+
+func derefInt(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+func registerChartRoutes(r fiber.Router, client *entchuniMusic.Client, mainClient *entchuniMain.Client, redisClient *redis.Client) {
+	svc := NewMusicService(client, mainClient, redisClient)
+	h := NewChartHandler(svc)
+	apiGroup := r.Group("/chart")
+
+	apiGroup.Get("/density", h.GetNoteDensity)
+	apiGroup.Get("/constants", h.GetConstantDistribution)
+	apiGroup.Get("/creators", h.GetCreators)
+	apiGroup.Get("/by-creator", h.GetChartsByCreator)
+	apiGroup.Get("/compare", h.CompareCharts)
+}
@@ -1,28 +1,51 @@
 package chunithm
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"haruki-database/api"
+	"haruki-database/config"
 	entchuniMain "haruki-database/database/schema/chunithm/maindb"
+	"haruki-database/database/schema/chunithm/maindb/chunithmaliasadmin"
+	"haruki-database/database/schema/chunithm/maindb/chunithmdefaultserver"
 	entchuniMusic "haruki-database/database/schema/chunithm/music"
+	"haruki-database/database/schema/chunithm/music/chunithmmusic"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicavailability"
 	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
 	harukiRedis "haruki-database/utils/redis"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
 
+	"github.com/gofiber/fiber/v3"
 	"github.com/redis/go-redis/v9"
+	"golang.org/x/text/width"
 )
 
+// ================= Context Keys =================
+
+const groupParamsKey = "group_params"
+
 // ================= Service Constructors =================
 
 func NewAliasService(client *entchuniMain.Client, redisClient *redis.Client) *AliasService {
 	return &AliasService{client: client, redisClient: redisClient}
 }
 
-func NewBindingService(client *entchuniMain.Client, redisClient *redis.Client, usersClient *users.Client) *BindingService {
-	return &BindingService{client: client, redisClient: redisClient, usersClient: usersClient}
+func NewBindingService(client *entchuniMain.Client, redisClient *redis.Client, usersClient *users.Client,
+	policy utils.SharedBindingPolicy, publisher *events.Publisher) *BindingService {
+	return &BindingService{client: client, redisClient: redisClient, usersClient: usersClient, policy: policy, publisher: publisher}
 }
 
-func NewMusicService(client *entchuniMusic.Client, redisClient *redis.Client) *MusicService {
-	return &MusicService{client: client, redisClient: redisClient}
+func NewMusicService(client *entchuniMusic.Client, mainClient *entchuniMain.Client, redisClient *redis.Client) *MusicService {
+	return &MusicService{client: client, mainClient: mainClient, redisClient: redisClient}
 }
 
 // ================= Handler Constructors =================
@@ -39,14 +62,44 @@ func NewMusicHandler(svc *MusicService) *MusicHandler {
 	return &MusicHandler{svc: svc}
 }
 
+func NewRatingHandler(svc *MusicService) *RatingHandler {
+	return &RatingHandler{svc: svc}
+}
+
+func NewChartHandler(svc *MusicService) *ChartHandler {
+	return &ChartHandler{svc: svc}
+}
+
 // ================= AliasService Methods =================
 
+func (s *AliasService) IsAdmin(ctx context.Context, harukiUserID int) (bool, error) {
+	return isChunithmAdmin(ctx, s.client, harukiUserID)
+}
+
+// isChunithmAdmin reports whether the user is listed as a Chunithm admin. The
+// alias admin table doubles as the admin list for music previews.
+func isChunithmAdmin(ctx context.Context, client *entchuniMain.Client, harukiUserID int) (bool, error) {
+	return client.ChunithmAliasAdmin.Query().
+		Where(chunithmaliasadmin.HarukiUserIDEQ(harukiUserID)).
+		Exist(ctx)
+}
+
 func (s *AliasService) ClearCache(ctx context.Context, musicID int, alias string) {
 	query := fmt.Sprintf("alias=%s", alias)
 	_ = harukiRedis.ClearCache(ctx, s.redisClient, CacheNSAlias, fmt.Sprintf("/chunithm/alias/%d", musicID), nil)
 	_ = harukiRedis.ClearCache(ctx, s.redisClient, CacheNSAlias, "/chunithm/alias/music-id", &query)
 }
 
+func (s *AliasService) ClearGroupCache(ctx context.Context, platform, groupID string, musicID int, alias string) {
+	query := fmt.Sprintf("alias=%s", alias)
+	_ = harukiRedis.ClearCache(ctx, s.redisClient, CacheNSAlias, fmt.Sprintf("/chunithm/alias/group/%s/%s/%d", platform, groupID, musicID), nil)
+	_ = harukiRedis.ClearCache(ctx, s.redisClient, CacheNSAlias, fmt.Sprintf("/chunithm/alias/group/%s/%s/by-alias", platform, groupID), &query)
+}
+
+func (s *AliasService) ClearStatusCache(ctx context.Context, pendingID int64) {
+	_ = harukiRedis.ClearCache(ctx, s.redisClient, CacheNSAlias, fmt.Sprintf("/chunithm/alias/status/%d", pendingID), nil)
+}
+
 // ================= BindingService Methods =================
 
 func (s *BindingService) ClearDefaultServerCache(ctx context.Context, userID int) {
@@ -54,9 +107,76 @@ func (s *BindingService) ClearDefaultServerCache(ctx context.Context, userID int
 	_ = harukiRedis.ClearCache(ctx, s.redisClient, CacheNSBinding, path, nil)
 }
 
-func (s *BindingService) ClearBindingCache(ctx context.Context, userID int, server string) {
-	path := fmt.Sprintf("/chunithm/user/%d/%s", userID, server)
-	_ = harukiRedis.ClearCache(ctx, s.redisClient, CacheNSBinding, path, nil)
+// ClearBindingCache drops every cached binding response of the user. A change
+// to one card can move the default or the order of the others, so the whole
+// user is invalidated rather than a single server.
+func (s *BindingService) ClearBindingCache(ctx context.Context, userID int) {
+	_ = harukiRedis.ClearAllCacheForPath(ctx, s.redisClient, CacheNSBinding, fmt.Sprintf("/chunithm/user/%d/*", userID))
+}
+
+// ================= Alias Middleware =================
+
+func parseGroupAliasParams(requireID bool, requireAlias bool) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		params := GroupAliasParams{
+			Platform: c.Params("platform"),
+			GroupID:  c.Params("group_id"),
+		}
+		if requireID {
+			params.MusicID = fiber.Params[int](c, "music_id", -1)
+			if params.MusicID <= 0 {
+				return api.JSONResponse(c, fiber.StatusBadRequest, "invalid music_id")
+			}
+		}
+		if requireAlias {
+			params.AliasStr = c.Query("alias")
+			if !api.ValidateAlias(params.AliasStr) {
+				return api.JSONResponse(c, fiber.StatusBadRequest, "invalid alias")
+			}
+		}
+		c.Locals(groupParamsKey, &params)
+		return c.Next()
+	}
+}
+
+// requireChunithmAdmin rejects requests whose haruki_user_id is not a
+// Chunithm admin.
+func requireChunithmAdmin(client *entchuniMain.Client) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		harukiUserID := api.GetHarukiUserIDFromQuery(c)
+		if harukiUserID <= 0 {
+			return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid or missing haruki_user_id")
+		}
+		ok, err := isChunithmAdmin(context.Background(), client, harukiUserID)
+		if err != nil {
+			return api.InternalError(c)
+		}
+		if !ok {
+			return api.JSONResponse(c, fiber.StatusForbidden, api.ErrPermissionDenied)
+		}
+		return c.Next()
+	}
+}
+
+// requireAdminForUnreleased applies requireChunithmAdmin only to requests
+// asking for include_unreleased.
+func requireAdminForUnreleased(client *entchuniMain.Client) fiber.Handler {
+	requireAdmin := requireChunithmAdmin(client)
+	return func(c fiber.Ctx) error {
+		if !fiber.Query[bool](c, "include_unreleased", false) {
+			return c.Next()
+		}
+		return requireAdmin(c)
+	}
+}
+
+// ================= Context Getters =================
+
+func getGroupAliasParams(c fiber.Ctx) *GroupAliasParams {
+	if p, ok := c.Locals(groupParamsKey).(*GroupAliasParams); ok {
+		return p
+	}
+	return nil
 }
 
 // ================= Extract Helpers =================
@@ -76,3 +196,441 @@ func extractAliasStrings(rows []*entchuniMain.ChunithmMusicAlias) []string {
 	}
 	return aliases
 }
+
+func extractGroupMusicIDs(rows []*entchuniMain.ChunithmGroupAlias) []int {
+	ids := make([]int, len(rows))
+	for i, r := range rows {
+		ids[i] = r.MusicID
+	}
+	return ids
+}
+
+func extractGroupAliasStrings(rows []*entchuniMain.ChunithmGroupAlias) []string {
+	aliases := make([]string, len(rows))
+	for i, r := range rows {
+		aliases[i] = r.Alias
+	}
+	return aliases
+}
+
+// ================= MusicService Methods =================
+
+// releaseAwareTTL caps the API cache TTL at the next scheduled release on any
+// server, so responses that hide unreleased songs expire the moment one goes
+// live.
+func (s *MusicService) releaseAwareTTL(ctx context.Context) time.Duration {
+	now := time.Now()
+	ttl := config.Cfg.Backend.APICacheTTL
+	var next *time.Time
+	if m, err := s.client.ChunithmMusic.
+		Query().
+		Where(chunithmmusic.ReleaseDateGT(now)).
+		Order(entchuniMusic.Asc(chunithmmusic.FieldReleaseDate)).
+		First(ctx); err == nil {
+		next = m.ReleaseDate
+	}
+	if a, err := s.client.ChunithmMusicAvailability.
+		Query().
+		Where(chunithmmusicavailability.ReleaseDateGT(now)).
+		Order(entchuniMusic.Asc(chunithmmusicavailability.FieldReleaseDate)).
+		First(ctx); err == nil && (next == nil || a.ReleaseDate.Before(*next)) {
+		next = a.ReleaseDate
+	}
+	if next == nil {
+		return ttl
+	}
+	until := max(next.Sub(now), time.Millisecond)
+	if ttl <= 0 || until < ttl {
+		return until
+	}
+	return ttl
+}
+
+// ================= Server Availability Helpers =================
+
+// resolveServer returns the requested server, or the caller's default server
+// when none was requested and a haruki_user_id was given. An unknown server
+// yields a *api.ValidationError.
+func (s *MusicService) resolveServer(ctx context.Context, server string, harukiUserID int) (string, error) {
+	if server != "" {
+		return server, validateServer(server)
+	}
+	if harukiUserID <= 0 {
+		return "", nil
+	}
+	row, err := s.mainClient.ChunithmDefaultServer.
+		Query().
+		Where(chunithmdefaultserver.HarukiUserIDEQ(harukiUserID)).
+		Only(ctx)
+	if entchuniMain.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return row.Server, nil
+}
+
+// loadAvailability returns the availability rows of server keyed by music
+// id, limited to musicIDs when it is not nil.
+func (s *MusicService) loadAvailability(ctx context.Context, server string, musicIDs []int) (map[int]*entchuniMusic.ChunithmMusicAvailability, error) {
+	query := s.client.ChunithmMusicAvailability.
+		Query().
+		Where(chunithmmusicavailability.ServerEQ(server))
+	if musicIDs != nil {
+		query = query.Where(chunithmmusicavailability.MusicIDIn(musicIDs...))
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int]*entchuniMusic.ChunithmMusicAvailability, len(rows))
+	for _, r := range rows {
+		result[r.MusicID] = r
+	}
+	return result, nil
+}
+
+// serverCacheQuery is api.CacheQuery for endpoints that accept a server or a
+// haruki_user_id to resolve it from. The key carries the resolved server
+// instead of the user, so users on the same server share entries and a
+// changed default server never hits a stale one.
+func (s *MusicService) serverCacheQuery(ctx context.Context, c fiber.Ctx, server string) (string, map[string]any, bool, error) {
+	query := url.Values{}
+	for k, v := range c.Queries() {
+		query.Set(k, v)
+	}
+	query.Del("haruki_user_id")
+	if server != "" {
+		query.Set("server", server)
+	}
+	key := harukiRedis.CacheKey(CacheNSMusic, c.Path(), query.Encode())
+	var cached map[string]any
+	found, err := harukiRedis.GetCache(ctx, s.redisClient, key, &cached)
+	if err != nil {
+		return key, nil, false, err
+	}
+	return key, cached, found, nil
+}
+
+// applyAvailability overlays the server-specific release and deletion data
+// on info.
+func applyAvailability(info *MusicInfoSchema, a *entchuniMusic.ChunithmMusicAvailability) {
+	server := a.Server
+	deleted := a.IsDeleted
+	info.Server = &server
+	if a.ReleaseVersion != nil {
+		info.Version = a.ReleaseVersion
+	}
+	if a.ReleaseDate != nil {
+		info.ReleaseDate = a.ReleaseDate
+	}
+	info.IsDeleted = &deleted
+	info.DeletedVersion = a.DeletedVersion
+}
+
+// ================= Version Helpers =================
+
+func (s *MusicService) loadVersionOrder(ctx context.Context) (*versionOrder, error) {
+	rows, err := s.client.ChunithmVersion.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newVersionOrder(rows), nil
+}
+
+func newVersionOrder(rows []*entchuniMusic.ChunithmVersion) *versionOrder {
+	o := &versionOrder{
+		ranks: make(map[string]int, len(rows)),
+		info:  make(map[string]*entchuniMusic.ChunithmVersion, len(rows)),
+	}
+	for _, r := range rows {
+		o.ranks[r.Version] = r.ReleaseOrder
+		o.info[r.Version] = r
+	}
+	return o
+}
+
+func (o *versionOrder) compare(a, b string) int {
+	ra, okA := o.ranks[a]
+	rb, okB := o.ranks[b]
+	if okA && okB {
+		return cmp.Compare(ra, rb)
+	}
+	return compareVersionStrings(a, b)
+}
+
+func (o *versionOrder) releaseDate(version string) *time.Time {
+	if v, ok := o.info[version]; ok {
+		return v.ReleaseDate
+	}
+	return nil
+}
+
+// compareVersionStrings compares dot-separated versions segment by segment,
+// numerically where both segments are numbers, so "2.10" sorts after "2.9".
+func compareVersionStrings(a, b string) int {
+	pa, pb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		na, errA := strconv.Atoi(pa[i])
+		nb, errB := strconv.Atoi(pb[i])
+		var c int
+		if errA == nil && errB == nil {
+			c = cmp.Compare(na, nb)
+		} else {
+			c = strings.Compare(pa[i], pb[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(pa), len(pb))
+}
+
+// ================= Music Search Helpers =================
+
+func parseMusicSearchParams(c fiber.Ctx) (*MusicSearchParams, error) {
+	params := &MusicSearchParams{
+		Keyword:           normalizeSearchText(c.Query("q")),
+		Category:          c.Query("category"),
+		Version:           c.Query("version"),
+		ConstVersion:      c.Query("const_version"),
+		IncludeUnreleased: fiber.Query[bool](c, "include_unreleased", false),
+		Sort:              c.Query("sort", "music_id"),
+		Order:             c.Query("order", "asc"),
+		Page:              fiber.Query[int](c, "page", 1),
+		PageSize:          fiber.Query[int](c, "page_size", DefaultSearchPageSize),
+	}
+	if v := c.Query("is_deleted"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid is_deleted: %s", v)
+		}
+		params.IsDeleted = &b
+	}
+	for name, dst := range map[string]**time.Time{"release_from": &params.ReleaseFrom, "release_to": &params.ReleaseTo} {
+		if v := c.Query(name); v != "" {
+			t, err := time.ParseInLocation(time.DateOnly, v, time.Local)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %s", name, v)
+			}
+			*dst = &t
+		}
+	}
+	if params.ReleaseTo != nil {
+		end := params.ReleaseTo.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		params.ReleaseTo = &end
+	}
+	if v := c.Query("difficulty"); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil || d < 0 || d > MaxDifficultyIndex {
+			return nil, fmt.Errorf("invalid difficulty: %s", v)
+		}
+		params.Difficulty = &d
+	}
+	for name, dst := range map[string]**float64{"const_min": &params.ConstMin, "const_max": &params.ConstMax} {
+		if v := c.Query(name); v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %s", name, v)
+			}
+			*dst = &f
+		}
+	}
+	switch params.Sort {
+	case "music_id", "title", "artist", "release_date", "const":
+	default:
+		return nil, fmt.Errorf("invalid sort: %s", params.Sort)
+	}
+	if params.Order != "asc" && params.Order != "desc" {
+		return nil, fmt.Errorf("invalid order: %s", params.Order)
+	}
+	if params.Page <= 0 {
+		return nil, fmt.Errorf("invalid page: %d", params.Page)
+	}
+	if params.PageSize <= 0 || params.PageSize > MaxSearchPageSize {
+		return nil, fmt.Errorf("page_size must be between 1 and %d", MaxSearchPageSize)
+	}
+	// The offset (page-1)*page_size must not overflow.
+	if params.Page > math.MaxInt/params.PageSize {
+		return nil, fmt.Errorf("invalid page: %d", params.Page)
+	}
+	return params, nil
+}
+
+// normalizeSearchText folds width and case and drops spaces and punctuation,
+// so that "ＡＢＣ!" and "a b c" compare equal.
+func normalizeSearchText(s string) string {
+	s = strings.ToLower(width.Fold.String(s))
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func matchesKeyword(row *entchuniMusic.ChunithmMusic, keyword string) bool {
+	if keyword == "" {
+		return true
+	}
+	return strings.Contains(normalizeSearchText(row.Title), keyword) ||
+		strings.Contains(normalizeSearchText(row.Artist), keyword)
+}
+
+// pickDifficulties returns one difficulty row per music: the row of the
+// nearest version not after version, or the latest row when version is empty.
+func pickDifficulties(rows []*entchuniMusic.ChunithmMusicDifficulty, version string, order *versionOrder) map[int]*entchuniMusic.ChunithmMusicDifficulty {
+	diffMap := make(map[int]*entchuniMusic.ChunithmMusicDifficulty)
+	for _, d := range rows {
+		if version != "" && order.compare(d.Version, version) > 0 {
+			continue
+		}
+		if cur, ok := diffMap[d.MusicID]; !ok || order.compare(d.Version, cur.Version) > 0 {
+			diffMap[d.MusicID] = d
+		}
+	}
+	return diffMap
+}
+
+func difficultyConsts(d *entchuniMusic.ChunithmMusicDifficulty) []*float64 {
+	if d == nil {
+		return []*float64{nil, nil, nil, nil, nil}
+	}
+	return []*float64{d.Diff0Const, d.Diff1Const, d.Diff2Const, d.Diff3Const, d.Diff4Const}
+}
+
+// sortConst returns the constant used for const filtering and sorting: the
+// requested difficulty, or the highest constant of the chart when unset.
+func sortConst(consts []*float64, difficulty *int) *float64 {
+	if difficulty != nil {
+		return consts[*difficulty]
+	}
+	var best *float64
+	for _, v := range consts {
+		if v != nil && (best == nil || *v > *best) {
+			best = v
+		}
+	}
+	return best
+}
+
+func constInRange(consts []*float64, params *MusicSearchParams) bool {
+	if params.ConstMin == nil && params.ConstMax == nil {
+		return true
+	}
+	candidates := consts
+	if params.Difficulty != nil {
+		candidates = consts[*params.Difficulty : *params.Difficulty+1]
+	}
+	for _, v := range candidates {
+		if v == nil {
+			continue
+		}
+		if params.ConstMin != nil && *v < *params.ConstMin {
+			continue
+		}
+		if params.ConstMax != nil && *v > *params.ConstMax {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func sortSearchItems(items []MusicBatchItemSchema, params *MusicSearchParams) {
+	less := func(a, b MusicBatchItemSchema) bool {
+		switch params.Sort {
+		case "title":
+			return a.Info.Title < b.Info.Title
+		case "artist":
+			return a.Info.Artist < b.Info.Artist
+		case "release_date":
+			if a.Info.ReleaseDate == nil || b.Info.ReleaseDate == nil {
+				return a.Info.ReleaseDate != nil
+			}
+			return a.Info.ReleaseDate.Before(*b.Info.ReleaseDate)
+		case "const":
+			ca, cb := sortConst(a.Difficulty, params.Difficulty), sortConst(b.Difficulty, params.Difficulty)
+			if ca == nil || cb == nil {
+				return ca != nil
+			}
+			return *ca < *cb
+		}
+		return a.Info.MusicID < b.Info.MusicID
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if params.Order == "desc" {
+			return less(items[j], items[i])
+		}
+		return less(items[i], items[j])
+	})
+}
+
+func toMusicInfoSchema(row *entchuniMusic.ChunithmMusic) MusicInfoSchema {
+	deleted := row.IsDeleted
+	return MusicInfoSchema{
+		MusicID:        row.MusicID,
+		Title:          row.Title,
+		Artist:         row.Artist,
+		Category:       row.Category,
+		Version:        row.Version,
+		ReleaseDate:    row.ReleaseDate,
+		IsDeleted:      &deleted,
+		DeletedVersion: row.DeletedVersion,
+	}
+}
+
+func toChartDataSchema(r *entchuniMusic.ChunithmChartData) ChartDataSchema {
+	return ChartDataSchema{
+		Difficulty: r.Difficulty,
+		Creator:    r.Creator,
+		BPM:        r.Bpm,
+		TapCount:   r.TapCount,
+		HoldCount:  r.HoldCount,
+		SlideCount: r.SlideCount,
+		AirCount:   r.AirCount,
+		FlickCount: r.FlickCount,
+		TotalCount: r.TotalCount,
+		Duration:   r.Duration,
+	}
+}
+
+// ================= Query Batch Helpers =================
+
+// normalizeBatchIDs validates the requested ids against the configured batch
+// limit and drops duplicates while keeping the request order.
+func normalizeBatchIDs(ids []int) ([]int, error) {
+	maxSize := config.Cfg.Chunithm.BatchMaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultQueryBatchMaxSize
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("music_ids required")
+	}
+	if len(ids) > maxSize {
+		return nil, fmt.Errorf("too many music_ids, max %d", maxSize)
+	}
+	seen := make(map[int]bool, len(ids))
+	result := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, fmt.Errorf("invalid music_id: %d", id)
+		}
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+// batchItemCacheKey keys a single query-batch item under the music namespace
+// so that ClearMusicCache drops it together with the other music responses.
+func batchItemCacheKey(musicID int, version, server string, includeCharts bool) string {
+	query := url.Values{}
+	query.Set("version", version)
+	query.Set("server", server)
+	query.Set("include_charts", strconv.FormatBool(includeCharts))
+	return harukiRedis.CacheKey(CacheNSMusic, fmt.Sprintf("/chunithm/music/query-batch/%d", musicID), query.Encode())
+}
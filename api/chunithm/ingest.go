@@ -0,0 +1,520 @@
+package chunithm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/api"
+	"haruki-database/config"
+	entchuniMusic "haruki-database/database/schema/chunithm/music"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicdifficulty"
+	"haruki-database/database/schema/chunithm/music/chunithmversion"
+	"haruki-database/utils"
+	harukiRedis "haruki-database/utils/redis"
+	"sort"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+var ErrInvalidDataset = errors.New("invalid dataset")
+
+// ================= Ingestion Handlers =================
+
+func (h *MusicHandler) IngestMusicData(c fiber.Ctx) error {
+	ctx := context.Background()
+	if config.Cfg.Chunithm.IngestToken == "" || c.Get("X-Ingest-Token") != config.Cfg.Chunithm.IngestToken {
+		return api.JSONResponse(c, fiber.StatusForbidden, api.ErrPermissionDenied)
+	}
+	dryRun := fiber.Query[bool](c, "dry_run", false)
+	var dataset MusicDataset
+	if err := c.Bind().Body(&dataset); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	report, err := h.svc.IngestDataset(ctx, &dataset, dryRun)
+	if errors.Is(err, ErrInvalidDataset) {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return api.InternalError(c)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "ok", report)
+}
+
+// ================= MusicService Ingestion =================
+
+// IngestDataset upserts every song, difficulty and chart of the dataset in a
+// single transaction and marks songs missing from it as deleted in
+// dataset.Version. Charts of listed songs that the dataset no longer contains
+// are removed, as is the dataset.Version difficulty row of listed songs
+// without constants. With dryRun the transaction is rolled back and only the
+// report is returned.
+func (s *MusicService) IngestDataset(ctx context.Context, dataset *MusicDataset, dryRun bool) (*IngestReport, error) {
+	if err := validateDataset(dataset); err != nil {
+		return nil, err
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report, err := applyDataset(ctx, tx, dataset)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	report.DryRun = dryRun
+	if dryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, err
+		}
+		return report, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.ClearMusicCache(ctx)
+	return report, nil
+}
+
+func (s *MusicService) ClearMusicCache(ctx context.Context) {
+	_ = harukiRedis.ClearNamespace(ctx, s.redisClient, CacheNSMusic)
+}
+
+func applyDataset(ctx context.Context, tx *entchuniMusic.Tx, dataset *MusicDataset) (*IngestReport, error) {
+	report := &IngestReport{
+		Version:       dataset.Version,
+		Added:         []int{},
+		Updated:       []int{},
+		Restored:      []int{},
+		Deleted:       []int{},
+		ChartsDeleted: []ChartRef{},
+	}
+	if err := upsertVersion(ctx, tx, dataset); err != nil {
+		return nil, err
+	}
+	existingRows, err := tx.ChunithmMusic.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[int]*entchuniMusic.ChunithmMusic, len(existingRows))
+	for _, row := range existingRows {
+		existing[row.MusicID] = row
+	}
+	diffRows, err := tx.ChunithmMusicDifficulty.Query().
+		Where(chunithmmusicdifficulty.VersionEQ(dataset.Version)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diffs := make(map[int]*entchuniMusic.ChunithmMusicDifficulty, len(diffRows))
+	for _, d := range diffRows {
+		diffs[d.MusicID] = d
+	}
+	chartRows, err := tx.ChunithmChartData.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	charts := make(map[[2]int]*entchuniMusic.ChunithmChartData, len(chartRows))
+	for _, ch := range chartRows {
+		charts[[2]int{ch.MusicID, ch.Difficulty}] = ch
+	}
+	availabilityRows, err := tx.ChunithmMusicAvailability.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	availability := make(map[int]map[string]*entchuniMusic.ChunithmMusicAvailability)
+	for _, a := range availabilityRows {
+		if availability[a.MusicID] == nil {
+			availability[a.MusicID] = make(map[string]*entchuniMusic.ChunithmMusicAvailability)
+		}
+		availability[a.MusicID][a.Server] = a
+	}
+
+	seen := make(map[int]bool, len(dataset.Music))
+	for i := range dataset.Music {
+		m := &dataset.Music[i]
+		seen[m.MusicID] = true
+		row, ok := existing[m.MusicID]
+		switch {
+		case !ok:
+			if _, err := tx.ChunithmMusic.Create().
+				SetMusicID(m.MusicID).
+				SetTitle(m.Title).
+				SetArtist(m.Artist).
+				SetNillableCategory(m.Category).
+				SetNillableVersion(m.Version).
+				SetNillableReleaseDate(m.ReleaseDate).
+				Save(ctx); err != nil {
+				return nil, err
+			}
+			report.Added = append(report.Added, m.MusicID)
+		case row.IsDeleted || !musicEqual(row, m):
+			upd := row.Update().
+				SetTitle(m.Title).
+				SetArtist(m.Artist).
+				SetIsDeleted(false).
+				ClearDeletedVersion()
+			if m.Category != nil {
+				upd.SetCategory(*m.Category)
+			} else {
+				upd.ClearCategory()
+			}
+			if m.Version != nil {
+				upd.SetVersion(*m.Version)
+			} else {
+				upd.ClearVersion()
+			}
+			if m.ReleaseDate != nil {
+				upd.SetReleaseDate(*m.ReleaseDate)
+			} else {
+				upd.ClearReleaseDate()
+			}
+			if _, err := upd.Save(ctx); err != nil {
+				return nil, err
+			}
+			if row.IsDeleted {
+				report.Restored = append(report.Restored, m.MusicID)
+			} else {
+				report.Updated = append(report.Updated, m.MusicID)
+			}
+		default:
+			report.Unchanged++
+		}
+
+		if current := diffs[m.MusicID]; current != nil && len(m.Difficulty) == 0 {
+			if err := tx.ChunithmMusicDifficulty.DeleteOne(current).Exec(ctx); err != nil {
+				return nil, err
+			}
+			report.DifficultiesDeleted++
+		}
+		written, err := upsertDifficulty(ctx, tx, diffs[m.MusicID], dataset.Version, m)
+		if err != nil {
+			return nil, err
+		}
+		if written {
+			report.DifficultiesWritten++
+		}
+		removed, err := pruneCharts(ctx, tx, charts, m)
+		if err != nil {
+			return nil, err
+		}
+		report.ChartsDeleted = append(report.ChartsDeleted, removed...)
+		for j := range m.Charts {
+			written, err := upsertChart(ctx, tx, charts[[2]int{m.MusicID, m.Charts[j].Difficulty}], m.MusicID, &m.Charts[j])
+			if err != nil {
+				return nil, err
+			}
+			if written {
+				report.ChartsWritten++
+			}
+		}
+		if m.Servers != nil {
+			written, err := syncAvailability(ctx, tx, availability[m.MusicID], m.MusicID, m.Servers)
+			if err != nil {
+				return nil, err
+			}
+			report.AvailabilityWritten += written
+		}
+	}
+
+	for _, row := range existingRows {
+		if seen[row.MusicID] || row.IsDeleted {
+			continue
+		}
+		if _, err := row.Update().
+			SetIsDeleted(true).
+			SetDeletedVersion(dataset.Version).
+			Save(ctx); err != nil {
+			return nil, err
+		}
+		report.Deleted = append(report.Deleted, row.MusicID)
+	}
+	sort.Ints(report.Deleted)
+	return report, nil
+}
+
+// upsertVersion records dataset.Version in the versions table. A new version
+// without an explicit release_order is appended after the latest known one.
+func upsertVersion(ctx context.Context, tx *entchuniMusic.Tx, dataset *MusicDataset) error {
+	if dataset.ReleaseOrder != nil {
+		taken, err := tx.ChunithmVersion.Query().
+			Where(
+				chunithmversion.ReleaseOrderEQ(*dataset.ReleaseOrder),
+				chunithmversion.VersionNEQ(dataset.Version),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return fmt.Errorf("%w: release_order %d is already used by another version", ErrInvalidDataset, *dataset.ReleaseOrder)
+		}
+	}
+	current, err := tx.ChunithmVersion.Query().
+		Where(chunithmversion.VersionEQ(dataset.Version)).
+		Only(ctx)
+	if err != nil && !entchuniMusic.IsNotFound(err) {
+		return err
+	}
+	if current != nil {
+		_, err := current.Update().
+			SetNillableName(dataset.VersionName).
+			SetNillableReleaseDate(dataset.VersionReleaseDate).
+			SetNillableReleaseOrder(dataset.ReleaseOrder).
+			Save(ctx)
+		return err
+	}
+	releaseOrder := 1
+	if dataset.ReleaseOrder != nil {
+		releaseOrder = *dataset.ReleaseOrder
+	} else if latest, err := tx.ChunithmVersion.Query().
+		Order(entchuniMusic.Desc(chunithmversion.FieldReleaseOrder)).
+		First(ctx); err == nil {
+		releaseOrder = latest.ReleaseOrder + 1
+	} else if !entchuniMusic.IsNotFound(err) {
+		return err
+	}
+	_, err = tx.ChunithmVersion.Create().
+		SetVersion(dataset.Version).
+		SetNillableName(dataset.VersionName).
+		SetReleaseOrder(releaseOrder).
+		SetNillableReleaseDate(dataset.VersionReleaseDate).
+		Save(ctx)
+	return err
+}
+
+func upsertDifficulty(ctx context.Context, tx *entchuniMusic.Tx, current *entchuniMusic.ChunithmMusicDifficulty, version string, m *DatasetMusic) (bool, error) {
+	if len(m.Difficulty) == 0 {
+		return false, nil
+	}
+	consts := make([]*float64, MaxDifficultyIndex+1)
+	copy(consts, m.Difficulty)
+	if current != nil {
+		if floatSliceEqual(difficultyConsts(current), consts) {
+			return false, nil
+		}
+		if err := tx.ChunithmMusicDifficulty.DeleteOne(current).Exec(ctx); err != nil {
+			return false, err
+		}
+	}
+	_, err := tx.ChunithmMusicDifficulty.Create().
+		SetMusicID(m.MusicID).
+		SetVersion(version).
+		SetNillableDiff0Const(consts[0]).
+		SetNillableDiff1Const(consts[1]).
+		SetNillableDiff2Const(consts[2]).
+		SetNillableDiff3Const(consts[3]).
+		SetNillableDiff4Const(consts[4]).
+		Save(ctx)
+	return err == nil, err
+}
+
+// syncAvailability makes the availability rows of a song match servers:
+// changed rows are replaced and servers no longer listed are removed. It
+// returns the number of rows written or removed.
+func syncAvailability(ctx context.Context, tx *entchuniMusic.Tx, current map[string]*entchuniMusic.ChunithmMusicAvailability, musicID int, servers []AvailabilitySchema) (int, error) {
+	written := 0
+	listed := make(map[string]bool, len(servers))
+	for i := range servers {
+		a := &servers[i]
+		listed[a.Server] = true
+		row := current[a.Server]
+		if row != nil {
+			if availabilityEqual(row, a) {
+				continue
+			}
+			if err := tx.ChunithmMusicAvailability.DeleteOne(row).Exec(ctx); err != nil {
+				return 0, err
+			}
+		}
+		if _, err := tx.ChunithmMusicAvailability.Create().
+			SetMusicID(musicID).
+			SetServer(a.Server).
+			SetNillableReleaseVersion(a.ReleaseVersion).
+			SetNillableReleaseDate(a.ReleaseDate).
+			SetIsDeleted(a.IsDeleted).
+			SetNillableDeletedVersion(a.DeletedVersion).
+			Save(ctx); err != nil {
+			return 0, err
+		}
+		written++
+	}
+	for server, row := range current {
+		if listed[server] {
+			continue
+		}
+		if err := tx.ChunithmMusicAvailability.DeleteOne(row).Exec(ctx); err != nil {
+			return 0, err
+		}
+		written++
+	}
+	return written, nil
+}
+
+// pruneCharts deletes the charts of m that its chart list no longer contains
+// and returns them.
+func pruneCharts(ctx context.Context, tx *entchuniMusic.Tx, current map[[2]int]*entchuniMusic.ChunithmChartData, m *DatasetMusic) ([]ChartRef, error) {
+	listed := make(map[int]bool, len(m.Charts))
+	for _, ch := range m.Charts {
+		listed[ch.Difficulty] = true
+	}
+	var removed []ChartRef
+	for difficulty := 0; difficulty <= MaxDifficultyIndex; difficulty++ {
+		row := current[[2]int{m.MusicID, difficulty}]
+		if row == nil || listed[difficulty] {
+			continue
+		}
+		if err := tx.ChunithmChartData.DeleteOne(row).Exec(ctx); err != nil {
+			return nil, err
+		}
+		removed = append(removed, ChartRef{MusicID: m.MusicID, Difficulty: difficulty})
+	}
+	return removed, nil
+}
+
+func upsertChart(ctx context.Context, tx *entchuniMusic.Tx, current *entchuniMusic.ChunithmChartData, musicID int, chart *ChartDataSchema) (bool, error) {
+	if current != nil {
+		if chartEqual(current, chart) {
+			return false, nil
+		}
+		if err := tx.ChunithmChartData.DeleteOne(current).Exec(ctx); err != nil {
+			return false, err
+		}
+	}
+	_, err := tx.ChunithmChartData.Create().
+		SetMusicID(musicID).
+		SetDifficulty(chart.Difficulty).
+		SetNillableCreator(chart.Creator).
+		SetNillableBpm(chart.BPM).
+		SetNillableTapCount(chart.TapCount).
+		SetNillableHoldCount(chart.HoldCount).
+		SetNillableSlideCount(chart.SlideCount).
+		SetNillableAirCount(chart.AirCount).
+		SetNillableFlickCount(chart.FlickCount).
+		SetNillableTotalCount(chart.TotalCount).
+		SetNillableDuration(chart.Duration).
+		Save(ctx)
+	return err == nil, err
+}
+
+// ================= Dataset Validation =================
+
+func validateDataset(dataset *MusicDataset) error {
+	if dataset.Version == "" || !api.ValidateStringLength(dataset.Version, MaxVersionLength) {
+		return fmt.Errorf("%w: invalid version %q", ErrInvalidDataset, dataset.Version)
+	}
+	if len(dataset.Music) == 0 {
+		return fmt.Errorf("%w: music list is empty", ErrInvalidDataset)
+	}
+	seen := make(map[int]bool, len(dataset.Music))
+	for _, m := range dataset.Music {
+		if m.MusicID <= 0 {
+			return fmt.Errorf("%w: invalid music_id %d", ErrInvalidDataset, m.MusicID)
+		}
+		if seen[m.MusicID] {
+			return fmt.Errorf("%w: duplicate music_id %d", ErrInvalidDataset, m.MusicID)
+		}
+		seen[m.MusicID] = true
+		if m.Title == "" || !api.ValidateStringLength(m.Title, MaxTitleLength) {
+			return fmt.Errorf("%w: music %d has invalid title", ErrInvalidDataset, m.MusicID)
+		}
+		if m.Artist == "" || !api.ValidateStringLength(m.Artist, MaxTitleLength) {
+			return fmt.Errorf("%w: music %d has invalid artist", ErrInvalidDataset, m.MusicID)
+		}
+		if m.Category != nil && !api.ValidateStringLength(*m.Category, MaxCategoryLength) {
+			return fmt.Errorf("%w: music %d has invalid category", ErrInvalidDataset, m.MusicID)
+		}
+		if m.Version != nil && !api.ValidateStringLength(*m.Version, MaxVersionLength) {
+			return fmt.Errorf("%w: music %d has invalid version", ErrInvalidDataset, m.MusicID)
+		}
+		if len(m.Difficulty) > MaxDifficultyIndex+1 {
+			return fmt.Errorf("%w: music %d has too many difficulties", ErrInvalidDataset, m.MusicID)
+		}
+		for _, v := range m.Difficulty {
+			if v != nil && (*v <= 0 || *v > MaxChartConstant) {
+				return fmt.Errorf("%w: music %d has invalid constant %v", ErrInvalidDataset, m.MusicID, *v)
+			}
+		}
+		chartSeen := make(map[int]bool, len(m.Charts))
+		for _, ch := range m.Charts {
+			if ch.Difficulty < 0 || ch.Difficulty > MaxDifficultyIndex || chartSeen[ch.Difficulty] {
+				return fmt.Errorf("%w: music %d has invalid chart difficulty %d", ErrInvalidDataset, m.MusicID, ch.Difficulty)
+			}
+			chartSeen[ch.Difficulty] = true
+			if ch.Creator != nil && !api.ValidateStringLength(*ch.Creator, MaxCreatorLength) {
+				return fmt.Errorf("%w: music %d has invalid chart creator", ErrInvalidDataset, m.MusicID)
+			}
+			if ch.Duration != nil && *ch.Duration <= 0 {
+				return fmt.Errorf("%w: music %d has invalid chart duration", ErrInvalidDataset, m.MusicID)
+			}
+		}
+		serverSeen := make(map[string]bool, len(m.Servers))
+		for _, a := range m.Servers {
+			if _, err := utils.ParseChunithmServer(a.Server); err != nil || serverSeen[a.Server] {
+				return fmt.Errorf("%w: music %d has invalid server %q", ErrInvalidDataset, m.MusicID, a.Server)
+			}
+			serverSeen[a.Server] = true
+			for _, v := range []*string{a.ReleaseVersion, a.DeletedVersion} {
+				if v != nil && (*v == "" || !api.ValidateStringLength(*v, MaxVersionLength)) {
+					return fmt.Errorf("%w: music %d has invalid version for server %s", ErrInvalidDataset, m.MusicID, a.Server)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ================= Comparison Helpers =================
+
+func musicEqual(row *entchuniMusic.ChunithmMusic, m *DatasetMusic) bool {
+	return row.Title == m.Title &&
+		row.Artist == m.Artist &&
+		ptrEqual(row.Category, m.Category) &&
+		ptrEqual(row.Version, m.Version) &&
+		timePtrEqual(row.ReleaseDate, m.ReleaseDate)
+}
+
+func availabilityEqual(row *entchuniMusic.ChunithmMusicAvailability, a *AvailabilitySchema) bool {
+	return ptrEqual(row.ReleaseVersion, a.ReleaseVersion) &&
+		timePtrEqual(row.ReleaseDate, a.ReleaseDate) &&
+		row.IsDeleted == a.IsDeleted &&
+		ptrEqual(row.DeletedVersion, a.DeletedVersion)
+}
+
+func chartEqual(row *entchuniMusic.ChunithmChartData, chart *ChartDataSchema) bool {
+	return ptrEqual(row.Creator, chart.Creator) &&
+		ptrEqual(row.Bpm, chart.BPM) &&
+		ptrEqual(row.TapCount, chart.TapCount) &&
+		ptrEqual(row.HoldCount, chart.HoldCount) &&
+		ptrEqual(row.SlideCount, chart.SlideCount) &&
+		ptrEqual(row.AirCount, chart.AirCount) &&
+		ptrEqual(row.FlickCount, chart.FlickCount) &&
+		ptrEqual(row.TotalCount, chart.TotalCount) &&
+		ptrEqual(row.Duration, chart.Duration)
+}
+
+func floatSliceEqual(a, b []*float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !ptrEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func ptrEqual[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func timePtrEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -4,14 +4,21 @@ import (
 	"context"
 	"haruki-database/api"
 	"haruki-database/config"
+	harukiRedis "haruki-database/utils/redis"
+	"maps"
+	"slices"
 	"sort"
 	"time"
 
+	entchuniMain "haruki-database/database/schema/chunithm/maindb"
+	"haruki-database/database/schema/chunithm/maindb/chunithmmusicalias"
 	entchuniMusic "haruki-database/database/schema/chunithm/music"
 	"haruki-database/database/schema/chunithm/music/chunithmchartdata"
 	"haruki-database/database/schema/chunithm/music/chunithmmusic"
 	"haruki-database/database/schema/chunithm/music/chunithmmusicdifficulty"
+	"haruki-database/database/schema/chunithm/music/chunithmversion"
 
+	"github.com/bytedance/sonic"
 	"github.com/gofiber/fiber/v3"
 	"github.com/redis/go-redis/v9"
 )
@@ -19,40 +26,115 @@ import (
 func (h *MusicHandler) GetAllMusic(c fiber.Ctx) error {
 	ctx := context.Background()
 	now := time.Now()
-	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSMusic)
+	includeUnreleased := fiber.Query[bool](c, "include_unreleased", false)
+	server, err := h.svc.resolveServer(ctx, c.Query("server"), api.GetHarukiUserIDFromQuery(c))
+	if api.IsValidationError(err) {
+		return api.ValidationErrorResponse(c, err)
+	}
+	if err != nil {
+		return api.InternalError(c)
+	}
+	key, cached, hit, err := h.svc.serverCacheQuery(ctx, c, server)
 	if err != nil {
 		return api.InternalError(c)
 	}
 	if hit {
 		return c.Status(fiber.StatusOK).JSON(cached)
 	}
-	rows, err := h.svc.client.ChunithmMusic.
-		Query().
-		Where(
+	q := h.svc.client.ChunithmMusic.Query()
+	if !includeUnreleased && server == "" {
+		q = q.Where(
 			chunithmmusic.Or(
 				chunithmmusic.ReleaseDateLTE(now),
 				chunithmmusic.ReleaseDateIsNil(),
 			),
-		).
-		All(ctx)
+		)
+	}
+	rows, err := q.All(ctx)
 	if err != nil {
 		return api.InternalError(c)
 	}
-	result := make([]MusicInfoSchema, len(rows))
-	for i, row := range rows {
-		deleted := row.IsDeleted
-		result[i] = MusicInfoSchema{
-			MusicID:        row.MusicID,
-			Title:          row.Title,
-			Artist:         row.Artist,
-			Category:       row.Category,
-			Version:        row.Version,
-			ReleaseDate:    row.ReleaseDate,
-			IsDeleted:      &deleted,
-			DeletedVersion: row.DeletedVersion,
+	var availability map[int]*entchuniMusic.ChunithmMusicAvailability
+	if server != "" {
+		if availability, err = h.svc.loadAvailability(ctx, server, nil); err != nil {
+			return api.InternalError(c)
 		}
 	}
-	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", result)
+	result := make([]MusicInfoSchema, 0, len(rows))
+	for _, row := range rows {
+		info := toMusicInfoSchema(row)
+		if server != "" {
+			a, ok := availability[row.MusicID]
+			if !ok {
+				continue
+			}
+			applyAvailability(&info, a)
+			// The server's own release date decides whether the song is out.
+			if !includeUnreleased && info.ReleaseDate != nil && info.ReleaseDate.After(now) {
+				continue
+			}
+		}
+		result = append(result, info)
+	}
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, h.svc.releaseAwareTTL(ctx), key, fiber.StatusOK, "ok", result)
+}
+
+// GetUpcomingMusic lists songs not released yet. With a server, resolved like
+// in GetAllMusic, the server's own release date decides, so a song already
+// out elsewhere is still listed when the server gets it later.
+func (h *MusicHandler) GetUpcomingMusic(c fiber.Ctx) error {
+	ctx := context.Background()
+	now := time.Now()
+	server, err := h.svc.resolveServer(ctx, c.Query("server"), api.GetHarukiUserIDFromQuery(c))
+	if api.IsValidationError(err) {
+		return api.ValidationErrorResponse(c, err)
+	}
+	if err != nil {
+		return api.InternalError(c)
+	}
+	key, cached, hit, err := h.svc.serverCacheQuery(ctx, c, server)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	q := h.svc.client.ChunithmMusic.Query()
+	if server == "" {
+		q = q.Where(chunithmmusic.ReleaseDateGT(now))
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	var availability map[int]*entchuniMusic.ChunithmMusicAvailability
+	if server != "" {
+		if availability, err = h.svc.loadAvailability(ctx, server, nil); err != nil {
+			return api.InternalError(c)
+		}
+	}
+	result := make([]MusicInfoSchema, 0, len(rows))
+	for _, row := range rows {
+		info := toMusicInfoSchema(row)
+		if server != "" {
+			a, ok := availability[row.MusicID]
+			if !ok {
+				continue
+			}
+			applyAvailability(&info, a)
+			if info.ReleaseDate == nil || !info.ReleaseDate.After(now) {
+				continue
+			}
+		}
+		result = append(result, info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].ReleaseDate.Equal(*result[j].ReleaseDate) {
+			return result[i].ReleaseDate.Before(*result[j].ReleaseDate)
+		}
+		return result[i].MusicID < result[j].MusicID
+	})
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, h.svc.releaseAwareTTL(ctx), key, fiber.StatusOK, "ok", result)
 }
 
 func (h *MusicHandler) GetDifficultyInfo(c fiber.Ctx) error {
@@ -72,40 +154,117 @@ func (h *MusicHandler) GetDifficultyInfo(c fiber.Ctx) error {
 	if hit {
 		return c.Status(fiber.StatusOK).JSON(cached)
 	}
-	record, _ := h.svc.client.ChunithmMusicDifficulty.
+	rows, err := h.svc.client.ChunithmMusicDifficulty.
 		Query().
-		Where(chunithmmusicdifficulty.MusicIDEQ(musicID), chunithmmusicdifficulty.VersionEQ(version)).
-		First(ctx)
-	if record != nil {
-		payload := MusicDifficultySchema{
-			MusicID: record.MusicID,
-			Version: record.Version,
-			Diff0:   record.Diff0Const,
-			Diff1:   record.Diff1Const,
-			Diff2:   record.Diff2Const,
-			Diff3:   record.Diff3Const,
-			Diff4:   record.Diff4Const,
-		}
-		return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", payload)
+		Where(chunithmmusicdifficulty.MusicIDEQ(musicID)).
+		All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	order, err := h.svc.loadVersionOrder(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	record, ok := pickDifficulties(rows, version, order)[musicID]
+	if !ok {
+		return api.JSONResponse(c, fiber.StatusNotFound, "No difficulty data")
 	}
-	latest, _ := h.svc.client.ChunithmMusicDifficulty.
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", MusicDifficultySchema{
+		MusicID: record.MusicID,
+		Version: record.Version,
+		Diff0:   record.Diff0Const,
+		Diff1:   record.Diff1Const,
+		Diff2:   record.Diff2Const,
+		Diff3:   record.Diff3Const,
+		Diff4:   record.Diff4Const,
+	})
+}
+
+func (h *MusicHandler) GetDifficultyHistory(c fiber.Ctx) error {
+	ctx := context.Background()
+	musicID := fiber.Params[int](c, "music_id", -1)
+	if musicID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid music_id")
+	}
+	changesOnly := fiber.Query[bool](c, "changes_only", false)
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSMusic)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	rows, err := h.svc.client.ChunithmMusicDifficulty.
 		Query().
 		Where(chunithmmusicdifficulty.MusicIDEQ(musicID)).
-		Order(entchuniMusic.Desc(chunithmmusicdifficulty.FieldVersion)).
-		First(ctx)
-	if latest != nil {
-		payload := MusicDifficultySchema{
-			MusicID: latest.MusicID,
-			Version: latest.Version,
-			Diff0:   latest.Diff0Const,
-			Diff1:   latest.Diff1Const,
-			Diff2:   latest.Diff2Const,
-			Diff3:   latest.Diff3Const,
-			Diff4:   latest.Diff4Const,
+		All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if len(rows) == 0 {
+		return api.JSONResponse(c, fiber.StatusNotFound, "No difficulty data")
+	}
+	order, err := h.svc.loadVersionOrder(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return order.compare(rows[i].Version, rows[j].Version) < 0
+	})
+	history := make([]DifficultyHistoryEntry, 0, len(rows))
+	var prev []*float64
+	for _, r := range rows {
+		consts := difficultyConsts(r)
+		changes := []DifficultyChangeSchema{}
+		if prev != nil {
+			for d := range consts {
+				if !ptrEqual(prev[d], consts[d]) {
+					changes = append(changes, DifficultyChangeSchema{Difficulty: d, From: prev[d], To: consts[d]})
+				}
+			}
+		}
+		if prev == nil || !changesOnly || len(changes) > 0 {
+			history = append(history, DifficultyHistoryEntry{
+				Version:     r.Version,
+				ReleaseDate: order.releaseDate(r.Version),
+				Difficulty:  consts,
+				Changes:     changes,
+			})
+		}
+		prev = consts
+	}
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", DifficultyHistorySchema{
+		MusicID: musicID,
+		History: history,
+	})
+}
+
+func (h *MusicHandler) GetVersions(c fiber.Ctx) error {
+	ctx := context.Background()
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSMusic)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	rows, err := h.svc.client.ChunithmVersion.
+		Query().
+		Order(entchuniMusic.Asc(chunithmversion.FieldReleaseOrder)).
+		All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	result := make([]VersionSchema, len(rows))
+	for i, r := range rows {
+		result[i] = VersionSchema{
+			Version:      r.Version,
+			Name:         r.Name,
+			ReleaseOrder: r.ReleaseOrder,
+			ReleaseDate:  r.ReleaseDate,
 		}
-		return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", payload)
 	}
-	return api.JSONResponse(c, fiber.StatusNotFound, "No difficulty data")
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", result)
 }
 
 func (h *MusicHandler) GetBasicInfo(c fiber.Ctx) error {
@@ -114,7 +273,14 @@ func (h *MusicHandler) GetBasicInfo(c fiber.Ctx) error {
 	if musicID <= 0 {
 		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid music_id")
 	}
-	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSMusic)
+	server, err := h.svc.resolveServer(ctx, c.Query("server"), api.GetHarukiUserIDFromQuery(c))
+	if api.IsValidationError(err) {
+		return api.ValidationErrorResponse(c, err)
+	}
+	if err != nil {
+		return api.InternalError(c)
+	}
+	key, cached, hit, err := h.svc.serverCacheQuery(ctx, c, server)
 	if err != nil {
 		return api.InternalError(c)
 	}
@@ -128,17 +294,19 @@ func (h *MusicHandler) GetBasicInfo(c fiber.Ctx) error {
 	if row == nil {
 		return api.JSONResponse(c, fiber.StatusNotFound, "Music not found")
 	}
-	deleted := row.IsDeleted
-	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", MusicInfoSchema{
-		MusicID:        row.MusicID,
-		Title:          row.Title,
-		Artist:         row.Artist,
-		Category:       row.Category,
-		Version:        row.Version,
-		ReleaseDate:    row.ReleaseDate,
-		IsDeleted:      &deleted,
-		DeletedVersion: row.DeletedVersion,
-	})
+	info := toMusicInfoSchema(row)
+	if server != "" {
+		availability, err := h.svc.loadAvailability(ctx, server, []int{musicID})
+		if err != nil {
+			return api.InternalError(c)
+		}
+		a, ok := availability[musicID]
+		if !ok {
+			return api.JSONResponse(c, fiber.StatusNotFound, "Music not available on this server")
+		}
+		applyAvailability(&info, a)
+	}
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", info)
 }
 
 func (h *MusicHandler) GetChartData(c fiber.Ctx) error {
@@ -163,110 +331,268 @@ func (h *MusicHandler) GetChartData(c fiber.Ctx) error {
 	}
 	result := make([]ChartDataSchema, len(rows))
 	for i, r := range rows {
-		result[i] = ChartDataSchema{
-			Difficulty: r.Difficulty,
-			Creator:    r.Creator,
-			BPM:        r.Bpm,
-			TapCount:   r.TapCount,
-			HoldCount:  r.HoldCount,
-			SlideCount: r.SlideCount,
-			AirCount:   r.AirCount,
-			FlickCount: r.FlickCount,
-			TotalCount: r.TotalCount,
-		}
+		result[i] = toChartDataSchema(r)
 	}
 	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", result)
 }
 
 func (h *MusicHandler) QueryBatch(c fiber.Ctx) error {
 	ctx := context.Background()
-	var req struct {
-		MusicIDs []int  `json:"music_ids"`
-		Version  string `json:"version"`
-	}
+	var req QueryBatchRequest
 	if err := c.Bind().Body(&req); err != nil {
 		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
 	}
-	musicRows, _ := h.svc.client.ChunithmMusic.
+	musicIDs, err := normalizeBatchIDs(req.MusicIDs)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	req.Server, err = h.svc.resolveServer(ctx, req.Server, req.HarukiUserID)
+	if api.IsValidationError(err) {
+		return api.ValidationErrorResponse(c, err)
+	}
+	if err != nil {
+		return api.InternalError(c)
+	}
+	result, err := h.svc.QueryBatch(ctx, musicIDs, &req)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "success", result)
+}
+
+// QueryBatch serves each song from its own cache entry and loads only the
+// misses from the database. Aliases are always read fresh, since alias
+// changes do not invalidate the music cache. With req.Server set, songs that
+// are not available on that server are reported as missing.
+func (s *MusicService) QueryBatch(ctx context.Context, musicIDs []int, req *QueryBatchRequest) (*QueryBatchResponse, error) {
+	keys := make([]string, len(musicIDs))
+	for i, id := range musicIDs {
+		keys[i] = batchItemCacheKey(id, req.Version, req.Server, req.IncludeCharts)
+	}
+	cached, err := harukiRedis.GetCacheMulti(ctx, s.redisClient, keys)
+	if err != nil {
+		return nil, err
+	}
+	result := &QueryBatchResponse{
+		Items:   make(map[int]MusicBatchItemSchema, len(musicIDs)),
+		Missing: []int{},
+	}
+	var misses []int
+	for i, id := range musicIDs {
+		var item MusicBatchItemSchema
+		if data, ok := cached[keys[i]]; ok && sonic.Unmarshal(data, &item) == nil {
+			result.Items[id] = item
+		} else {
+			misses = append(misses, id)
+		}
+	}
+	if len(misses) > 0 {
+		loaded, err := s.loadBatchItems(ctx, misses, req.Version, req.Server, req.IncludeCharts)
+		if err != nil {
+			return nil, err
+		}
+		toCache := make(map[string]interface{}, len(loaded))
+		for _, id := range misses {
+			item, ok := loaded[id]
+			if !ok {
+				result.Missing = append(result.Missing, id)
+				continue
+			}
+			result.Items[id] = item
+			toCache[batchItemCacheKey(id, req.Version, req.Server, req.IncludeCharts)] = item
+		}
+		_ = harukiRedis.SetCacheMulti(ctx, s.redisClient, toCache, config.Cfg.Backend.APICacheTTL)
+	}
+	if req.IncludeAliases && len(result.Items) > 0 {
+		ids := slices.Collect(maps.Keys(result.Items))
+		rows, err := s.mainClient.ChunithmMusicAlias.
+			Query().
+			Where(chunithmmusicalias.MusicIDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		aliases := make(map[int][]string)
+		for _, r := range rows {
+			aliases[r.MusicID] = append(aliases[r.MusicID], r.Alias)
+		}
+		for id, item := range result.Items {
+			item.Aliases = aliases[id]
+			if item.Aliases == nil {
+				item.Aliases = []string{}
+			}
+			result.Items[id] = item
+		}
+	}
+	slices.Sort(result.Missing)
+	return result, nil
+}
+
+func (s *MusicService) loadBatchItems(ctx context.Context, musicIDs []int, version, server string, includeCharts bool) (map[int]MusicBatchItemSchema, error) {
+	musicRows, err := s.client.ChunithmMusic.
 		Query().
-		Where(chunithmmusic.MusicIDIn(req.MusicIDs...)).
+		Where(chunithmmusic.MusicIDIn(musicIDs...)).
 		All(ctx)
-	musicMap := make(map[int]*entchuniMusic.ChunithmMusic)
-	for _, m := range musicRows {
-		musicMap[m.MusicID] = m
+	if err != nil {
+		return nil, err
 	}
-	diffRows, _ := h.svc.client.ChunithmMusicDifficulty.
+	diffRows, err := s.client.ChunithmMusicDifficulty.
 		Query().
-		Where(chunithmmusicdifficulty.MusicIDIn(req.MusicIDs...)).
+		Where(chunithmmusicdifficulty.MusicIDIn(musicIDs...)).
 		All(ctx)
-	sort.Slice(diffRows, func(i, j int) bool {
-		return diffRows[i].Version > diffRows[j].Version
-	})
-	diffMap := make(map[int]*entchuniMusic.ChunithmMusicDifficulty)
-	for _, d := range diffRows {
-		if _, ok := diffMap[d.MusicID]; !ok || d.Version == req.Version {
-			diffMap[d.MusicID] = d
+	if err != nil {
+		return nil, err
+	}
+	order, err := s.loadVersionOrder(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diffMap := pickDifficulties(diffRows, version, order)
+	var availability map[int]*entchuniMusic.ChunithmMusicAvailability
+	if server != "" {
+		if availability, err = s.loadAvailability(ctx, server, musicIDs); err != nil {
+			return nil, err
 		}
 	}
-	result := make(map[int]MusicBatchItemSchema)
-	for _, mid := range req.MusicIDs {
-		music := musicMap[mid]
-		diff := diffMap[mid]
-		var diffList []*float64
-		if diff != nil {
-			diffList = []*float64{
-				diff.Diff0Const, diff.Diff1Const, diff.Diff2Const,
-				diff.Diff3Const, diff.Diff4Const,
-			}
-		} else {
-			diffList = []*float64{nil, nil, nil, nil, nil}
+	charts := make(map[int][]ChartDataSchema)
+	if includeCharts {
+		chartRows, err := s.client.ChunithmChartData.
+			Query().
+			Where(chunithmchartdata.MusicIDIn(musicIDs...)).
+			Order(entchuniMusic.Asc(chunithmchartdata.FieldDifficulty)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range chartRows {
+			charts[r.MusicID] = append(charts[r.MusicID], toChartDataSchema(r))
+		}
+	}
+	items := make(map[int]MusicBatchItemSchema, len(musicRows))
+	for _, m := range musicRows {
+		item := MusicBatchItemSchema{
+			Version:    m.Version,
+			Difficulty: difficultyConsts(diffMap[m.MusicID]),
+			Info:       toMusicInfoSchema(m),
 		}
-		var info MusicInfoSchema
-		var version *string
-		if music != nil {
-			deleted := music.IsDeleted
-			info = MusicInfoSchema{
-				MusicID:        music.MusicID,
-				Title:          music.Title,
-				Artist:         music.Artist,
-				Category:       music.Category,
-				Version:        music.Version,
-				ReleaseDate:    music.ReleaseDate,
-				IsDeleted:      &deleted,
-				DeletedVersion: music.DeletedVersion,
+		if server != "" {
+			a, ok := availability[m.MusicID]
+			if !ok {
+				continue
 			}
-			version = music.Version
-		} else {
-			title := "Unknown"
-			artist := "Unknown"
-			category := "Unknown"
-			isDeleted := false
-			info = MusicInfoSchema{
-				MusicID:   mid,
-				Title:     title,
-				Artist:    artist,
-				Category:  &category,
-				IsDeleted: &isDeleted,
+			applyAvailability(&item.Info, a)
+			item.Version = item.Info.Version
+		}
+		if includeCharts {
+			item.Charts = charts[m.MusicID]
+			if item.Charts == nil {
+				item.Charts = []ChartDataSchema{}
 			}
-			version = nil
 		}
-		result[mid] = MusicBatchItemSchema{
-			Version:    version,
-			Difficulty: diffList,
-			Info:       info,
+		items[m.MusicID] = item
+	}
+	return items, nil
+}
+
+func (h *MusicHandler) SearchMusic(c fiber.Ctx) error {
+	ctx := context.Background()
+	params, err := parseMusicSearchParams(c)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSMusic)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	q := h.svc.client.ChunithmMusic.Query()
+	if !params.IncludeUnreleased {
+		q = q.Where(
+			chunithmmusic.Or(
+				chunithmmusic.ReleaseDateLTE(time.Now()),
+				chunithmmusic.ReleaseDateIsNil(),
+			),
+		)
+	}
+	if params.Category != "" {
+		q = q.Where(chunithmmusic.CategoryEQ(params.Category))
+	}
+	if params.Version != "" {
+		q = q.Where(chunithmmusic.VersionEQ(params.Version))
+	}
+	if params.IsDeleted != nil {
+		q = q.Where(chunithmmusic.IsDeletedEQ(*params.IsDeleted))
+	}
+	if params.ReleaseFrom != nil {
+		q = q.Where(chunithmmusic.ReleaseDateGTE(*params.ReleaseFrom))
+	}
+	if params.ReleaseTo != nil {
+		q = q.Where(chunithmmusic.ReleaseDateLTE(*params.ReleaseTo))
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	matched := make([]*entchuniMusic.ChunithmMusic, 0, len(rows))
+	musicIDs := make([]int, 0, len(rows))
+	for _, row := range rows {
+		if matchesKeyword(row, params.Keyword) {
+			matched = append(matched, row)
+			musicIDs = append(musicIDs, row.MusicID)
+		}
+	}
+	diffRows, err := h.svc.client.ChunithmMusicDifficulty.
+		Query().
+		Where(chunithmmusicdifficulty.MusicIDIn(musicIDs...)).
+		All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	order, err := h.svc.loadVersionOrder(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	diffMap := pickDifficulties(diffRows, params.ConstVersion, order)
+	items := make([]MusicBatchItemSchema, 0, len(matched))
+	for _, row := range matched {
+		diff := diffMap[row.MusicID]
+		consts := difficultyConsts(diff)
+		if !constInRange(consts, params) {
+			continue
+		}
+		item := MusicBatchItemSchema{Difficulty: consts, Info: toMusicInfoSchema(row)}
+		if diff != nil {
+			item.Version = &diff.Version
 		}
+		items = append(items, item)
 	}
-	return api.JSONResponse(c, fiber.StatusOK, "success", result)
+	sortSearchItems(items, params)
+	total := len(items)
+	start := min(max((params.Page-1)*params.PageSize, 0), total)
+	end := min(start+params.PageSize, total)
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, h.svc.releaseAwareTTL(ctx), key, fiber.StatusOK, "ok", MusicSearchResponse{
+		Total:    total,
+		Page:     params.Page,
+		PageSize: params.PageSize,
+		Items:    items[start:end],
+	})
 }
 
-func registerMusicRoutes(r fiber.Router, client *entchuniMusic.Client, redisClient *redis.Client) {
-	svc := NewMusicService(client, redisClient)
+func registerMusicRoutes(r fiber.Router, client *entchuniMusic.Client, mainClient *entchuniMain.Client, redisClient *redis.Client) {
+	svc := NewMusicService(client, mainClient, redisClient)
 	h := NewMusicHandler(svc)
 	apiGroup := r.Group("/music")
 
-	apiGroup.Get("/all-music", h.GetAllMusic)
+	apiGroup.Get("/all-music", requireAdminForUnreleased(mainClient), h.GetAllMusic)
+	apiGroup.Get("/upcoming", requireChunithmAdmin(mainClient), h.GetUpcomingMusic)
+	apiGroup.Get("/search", requireAdminForUnreleased(mainClient), h.SearchMusic)
+	apiGroup.Get("/versions", h.GetVersions)
 	apiGroup.Get("/:music_id/difficulty-info", h.GetDifficultyInfo)
+	apiGroup.Get("/:music_id/difficulty-history", h.GetDifficultyHistory)
 	apiGroup.Get("/:music_id/basic-info", h.GetBasicInfo)
 	apiGroup.Get("/:music_id/chart-data", h.GetChartData)
 	apiGroup.Post("/query-batch", h.QueryBatch)
+	apiGroup.Post("/ingest", api.VerifyAPIAuthorization(), h.IngestMusicData)
 }
@@ -0,0 +1,457 @@
+package chunithm
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"haruki-database/api"
+	"maps"
+	"math"
+	"slices"
+	"time"
+
+	entchuniMain "haruki-database/database/schema/chunithm/maindb"
+	entchuniMusic "haruki-database/database/schema/chunithm/music"
+	"haruki-database/database/schema/chunithm/music/chunithmmusic"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicdifficulty"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/redis/go-redis/v9"
+)
+
+func (h *RatingHandler) CalculateRating(c fiber.Ctx) error {
+	ctx := context.Background()
+	var req RatingRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	if err := validateRatingRequest(&req); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	result, err := h.svc.CalculateRating(ctx, &req)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "ok", result)
+}
+
+// CalculateRating rates every play against the constants of req.Version and
+// fills the best and new-song frames. Duplicate charts keep their best score.
+func (s *MusicService) CalculateRating(ctx context.Context, req *RatingRequest) (*RatingResult, error) {
+	plays := dedupePlays(req.Plays)
+	musicIDs := make([]int, 0, len(plays))
+	seen := make(map[int]bool, len(plays))
+	for _, p := range plays {
+		if !seen[p.MusicID] {
+			seen[p.MusicID] = true
+			musicIDs = append(musicIDs, p.MusicID)
+		}
+	}
+	musicRows, err := s.client.ChunithmMusic.
+		Query().
+		Where(chunithmmusic.MusicIDIn(musicIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	musicMap := make(map[int]*entchuniMusic.ChunithmMusic, len(musicRows))
+	for _, m := range musicRows {
+		musicMap[m.MusicID] = m
+	}
+	diffRows, err := s.client.ChunithmMusicDifficulty.
+		Query().
+		Where(chunithmmusicdifficulty.MusicIDIn(musicIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	order, err := s.loadVersionOrder(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diffMap := pickDifficulties(diffRows, req.Version, order)
+	return ratePlays(plays, req.Version, musicMap, diffMap, order), nil
+}
+
+// ratePlays rates deduplicated plays and fills the best and new-song frames.
+func ratePlays(plays []RatingPlay, version string, musicMap map[int]*entchuniMusic.ChunithmMusic, diffMap map[int]*entchuniMusic.ChunithmMusicDifficulty, order *versionOrder) *RatingResult {
+	result := &RatingResult{
+		Version: version,
+		Best:    []RatingPlayResult{},
+		New:     []RatingPlayResult{},
+		Plays:   []RatingPlayResult{},
+		Missing: []RatingPlay{},
+	}
+	var oldPlays, newPlays []RatingPlayResult
+	for _, p := range plays {
+		constant := difficultyConsts(diffMap[p.MusicID])[p.Difficulty]
+		if constant == nil {
+			result.Missing = append(result.Missing, p)
+			continue
+		}
+		item := RatingPlayResult{
+			MusicID:    p.MusicID,
+			Difficulty: p.Difficulty,
+			Score:      p.Score,
+			Const:      constant,
+			Rating:     float64(playRating(*constant, p.Score)) / 100,
+		}
+		if m := musicMap[p.MusicID]; m != nil {
+			title := m.Title
+			item.Title = &title
+			item.IsNew = isNewMusic(m, version, order)
+		}
+		result.Plays = append(result.Plays, item)
+		if item.IsNew {
+			newPlays = append(newPlays, item)
+		} else {
+			oldPlays = append(oldPlays, item)
+		}
+	}
+	slices.SortFunc(result.Plays, compareRatingPlays)
+	slices.SortFunc(oldPlays, compareRatingPlays)
+	slices.SortFunc(newPlays, compareRatingPlays)
+	result.Best = append(result.Best, oldPlays[:min(len(oldPlays), BestFrameSize)]...)
+	result.New = append(result.New, newPlays[:min(len(newPlays), NewFrameSize)]...)
+
+	bestTotal, newTotal := frameTotal(result.Best), frameTotal(result.New)
+	result.BestTotal = float64(bestTotal) / 100
+	result.NewTotal = float64(newTotal) / 100
+	result.Rating = float64((bestTotal+newTotal)/(BestFrameSize+NewFrameSize)) / 100
+	return result
+}
+
+func isNewMusic(m *entchuniMusic.ChunithmMusic, version string, order *versionOrder) bool {
+	return m.Version != nil && order.compare(*m.Version, version) == 0
+}
+
+func (h *RatingHandler) RecommendCharts(c fiber.Ctx) error {
+	ctx := context.Background()
+	var req RecommendRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	if err := validateRecommendRequest(&req); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	result, err := h.svc.RecommendCharts(ctx, &req)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "ok", result)
+}
+
+// RecommendCharts lists released charts that would raise the player's rating
+// if played well enough, with the score needed to enter (or improve on) the
+// frame the chart belongs to. With a target overall rating it also reports
+// the frame total still missing and, per chart, the score whose play alone
+// closes that gap by replacing the entry the chart would push out.
+func (s *MusicService) RecommendCharts(ctx context.Context, req *RecommendRequest) (*RecommendResult, error) {
+	now := time.Now()
+	query := s.client.ChunithmMusic.
+		Query().
+		Where(
+			chunithmmusic.IsDeletedEQ(false),
+			chunithmmusic.Or(
+				chunithmmusic.ReleaseDateLTE(now),
+				chunithmmusic.ReleaseDateIsNil(),
+			),
+		)
+	if req.Category != nil {
+		query = query.Where(chunithmmusic.CategoryEQ(*req.Category))
+	}
+	if req.MusicVersion != nil {
+		query = query.Where(chunithmmusic.VersionEQ(*req.MusicVersion))
+	}
+	musicRows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	candidateMap := make(map[int]*entchuniMusic.ChunithmMusic, len(musicRows))
+	for _, m := range musicRows {
+		candidateMap[m.MusicID] = m
+	}
+
+	plays := dedupePlays(req.Plays)
+	playedIDs := make([]int, 0, len(plays))
+	for _, p := range plays {
+		playedIDs = append(playedIDs, p.MusicID)
+	}
+	playedRows, err := s.client.ChunithmMusic.
+		Query().
+		Where(chunithmmusic.MusicIDIn(playedIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	musicMap := make(map[int]*entchuniMusic.ChunithmMusic, len(musicRows)+len(playedRows))
+	for _, m := range playedRows {
+		musicMap[m.MusicID] = m
+	}
+	for id, m := range candidateMap {
+		musicMap[id] = m
+	}
+	diffRows, err := s.client.ChunithmMusicDifficulty.
+		Query().
+		Where(chunithmmusicdifficulty.MusicIDIn(slices.Collect(maps.Keys(musicMap))...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	order, err := s.loadVersionOrder(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diffMap := pickDifficulties(diffRows, req.Version, order)
+	rated := ratePlays(plays, req.Version, musicMap, diffMap, order)
+
+	type chartKey struct{ musicID, difficulty int }
+	scores := make(map[chartKey]int, len(plays))
+	for _, p := range plays {
+		scores[chartKey{p.MusicID, p.Difficulty}] = p.Score
+	}
+	inFrame := make(map[chartKey]int)
+	for _, item := range append(slices.Clone(rated.Best), rated.New...) {
+		inFrame[chartKey{item.MusicID, item.Difficulty}] = playRating(*item.Const, item.Score)
+	}
+	bestFloor, newFloor := frameFloor(rated.Best, BestFrameSize), frameFloor(rated.New, NewFrameSize)
+	// The overall rating is the frame total over all frame slots, so the
+	// target is reached once the total grows by gain.
+	gain := -1
+	var targetGain *float64
+	if req.TargetRating != nil {
+		target := int(math.Round(*req.TargetRating * 100))
+		gain = max(target*(BestFrameSize+NewFrameSize)-(frameTotal(rated.Best)+frameTotal(rated.New)), 0)
+		g := float64(gain) / 100
+		targetGain = &g
+	}
+
+	items := []RecommendationSchema{}
+	for id, m := range candidateMap {
+		consts := difficultyConsts(diffMap[id])
+		for d, constant := range consts {
+			if constant == nil || (req.Difficulty != nil && *req.Difficulty != d) {
+				continue
+			}
+			k := chartKey{id, d}
+			frame, floor := FrameBest, bestFloor
+			if isNewMusic(m, req.Version, order) {
+				frame, floor = FrameNew, newFloor
+			}
+			// A chart already in its frame only has to beat itself; any
+			// other chart has to beat the lowest entry of the frame.
+			replaced := floor
+			if r, ok := inFrame[k]; ok {
+				replaced = r
+			}
+			needed := replaced + 1
+			maxRating := playRating(*constant, MaxScore)
+			if maxRating < needed {
+				continue
+			}
+			item := RecommendationSchema{
+				MusicID:    id,
+				Difficulty: d,
+				Title:      m.Title,
+				Category:   m.Category,
+				Version:    m.Version,
+				Const:      *constant,
+				Frame:      frame,
+				MinScore:   scoreForRating(*constant, needed),
+				MaxGain:    float64(maxRating-needed+1) / float64((BestFrameSize+NewFrameSize)*100),
+			}
+			if score, ok := scores[k]; ok {
+				current := float64(playRating(*constant, score)) / 100
+				item.CurrentScore = &score
+				item.CurrentRating = &current
+			}
+			if required := max(replaced+gain, needed); gain >= 0 && maxRating >= required {
+				targetScore := scoreForRating(*constant, required)
+				item.TargetScore = &targetScore
+			}
+			items = append(items, item)
+		}
+	}
+	slices.SortFunc(items, func(a, b RecommendationSchema) int {
+		if (a.TargetScore == nil) != (b.TargetScore == nil) {
+			if a.TargetScore != nil {
+				return -1
+			}
+			return 1
+		}
+		if a.TargetScore != nil {
+			if c := cmp.Compare(*a.TargetScore, *b.TargetScore); c != 0 {
+				return c
+			}
+		}
+		if c := cmp.Compare(a.MinScore, b.MinScore); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.MaxGain, a.MaxGain); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.MusicID, b.MusicID); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Difficulty, b.Difficulty)
+	})
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultRecommendLimit
+	}
+	return &RecommendResult{
+		Version:      req.Version,
+		Rating:       rated.Rating,
+		TargetRating: req.TargetRating,
+		TargetGain:   targetGain,
+		BestFloor:    float64(bestFloor) / 100,
+		NewFloor:     float64(newFloor) / 100,
+		Items:        items[:min(len(items), limit)],
+	}, nil
+}
+
+func validateRatingRequest(req *RatingRequest) error {
+	if req.Version == "" {
+		return fmt.Errorf("version required")
+	}
+	if len(req.Plays) == 0 {
+		return fmt.Errorf("plays required")
+	}
+	return validatePlays(req.Plays)
+}
+
+func validatePlays(plays []RatingPlay) error {
+	if len(plays) > MaxRatingPlays {
+		return fmt.Errorf("too many plays, max %d", MaxRatingPlays)
+	}
+	for i, p := range plays {
+		if p.MusicID <= 0 {
+			return fmt.Errorf("plays[%d]: invalid music_id", i)
+		}
+		if p.Difficulty < 0 || p.Difficulty > MaxDifficultyIndex {
+			return fmt.Errorf("plays[%d]: invalid difficulty", i)
+		}
+		if p.Score < 0 || p.Score > MaxScore {
+			return fmt.Errorf("plays[%d]: invalid score", i)
+		}
+	}
+	return nil
+}
+
+// playRating applies the official score-to-rating formula. The result is in
+// hundredths and truncated the same way the game does.
+func playRating(constant float64, score int) int {
+	c := int(math.Round(constant * 100))
+	var r int
+	switch {
+	case score >= 1009000:
+		r = c + 215
+	case score >= 1007500:
+		r = c + 200 + (score-1007500)/100
+	case score >= 1005000:
+		r = c + 150 + (score-1005000)/50
+	case score >= 1000000:
+		r = c + 100 + (score-1000000)/100
+	case score >= 975000:
+		r = c + (score-975000)/250
+	case score >= 925000:
+		r = c - 300 + (score-925000)*300/50000
+	case score >= 900000:
+		r = c - 500 + (score-900000)*200/25000
+	case score >= 800000:
+		half := (c - 500) / 2
+		r = half + (score-800000)*half/100000
+	case score >= 500000:
+		r = (c - 500) / 2 * (score - 500000) / 300000
+	}
+	return max(r, 0)
+}
+
+func validateRecommendRequest(req *RecommendRequest) error {
+	if req.Version == "" {
+		return fmt.Errorf("version required")
+	}
+	if err := validatePlays(req.Plays); err != nil {
+		return err
+	}
+	if req.TargetRating != nil && (*req.TargetRating <= 0 || *req.TargetRating > MaxChartConstant+2.15) {
+		return fmt.Errorf("invalid target_rating")
+	}
+	if req.Difficulty != nil && (*req.Difficulty < 0 || *req.Difficulty > MaxDifficultyIndex) {
+		return fmt.Errorf("invalid difficulty")
+	}
+	if req.Limit < 0 || req.Limit > MaxRecommendLimit {
+		return fmt.Errorf("invalid limit, max %d", MaxRecommendLimit)
+	}
+	return nil
+}
+
+// scoreForRating returns the lowest score whose play rating on a chart of the
+// given constant reaches rating (in hundredths). playRating is monotonic in
+// score, so a binary search over the score range is enough.
+func scoreForRating(constant float64, rating int) int {
+	lo, hi := 0, MaxScore
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if playRating(constant, mid) >= rating {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
+
+// frameFloor returns the lowest rating (in hundredths) a play must beat to
+// enter a frame, which is zero while the frame still has free slots.
+func frameFloor(frame []RatingPlayResult, size int) int {
+	if len(frame) < size {
+		return 0
+	}
+	return int(math.Round(frame[len(frame)-1].Rating * 100))
+}
+
+func dedupePlays(plays []RatingPlay) []RatingPlay {
+	type chartKey struct{ musicID, difficulty int }
+	index := make(map[chartKey]int, len(plays))
+	result := make([]RatingPlay, 0, len(plays))
+	for _, p := range plays {
+		k := chartKey{p.MusicID, p.Difficulty}
+		if i, ok := index[k]; ok {
+			if p.Score > result[i].Score {
+				result[i].Score = p.Score
+			}
+			continue
+		}
+		index[k] = len(result)
+		result = append(result, p)
+	}
+	return result
+}
+
+func compareRatingPlays(a, b RatingPlayResult) int {
+	if c := cmp.Compare(b.Rating, a.Rating); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.MusicID, b.MusicID); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Difficulty, b.Difficulty)
+}
+
+func frameTotal(items []RatingPlayResult) int {
+	total := 0
+	for _, item := range items {
+		total += int(math.Round(item.Rating * 100))
+	}
+	return total
+}
+
+func registerRatingRoutes(r fiber.Router, client *entchuniMusic.Client, mainClient *entchuniMain.Client, redisClient *redis.Client) {
+	svc := NewMusicService(client, mainClient, redisClient)
+	h := NewRatingHandler(svc)
+	apiGroup := r.Group("/rating")
+
+	apiGroup.Post("/calc", h.CalculateRating)
+	apiGroup.Post("/recommend", h.RecommendCharts)
+}
@@ -4,14 +4,19 @@ import (
 	"haruki-database/database/schema/chunithm/maindb"
 	"haruki-database/database/schema/chunithm/music"
 	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/redis/go-redis/v9"
 )
 
-func RegisterChunithmRoutes(app fiber.Router, mainClient *maindb.Client, musicClient *music.Client, redisClient *redis.Client, usersClient *users.Client) {
+func RegisterChunithmRoutes(app fiber.Router, mainClient *maindb.Client, musicClient *music.Client, redisClient *redis.Client, usersClient *users.Client,
+	policy utils.SharedBindingPolicy, publisher *events.Publisher) {
 	group := app.Group("/chunithm")
 	registerAliasRoutes(group, mainClient, redisClient)
-	registerBindingRoutes(group, mainClient, redisClient, usersClient)
-	registerMusicRoutes(group, musicClient, redisClient)
+	registerBindingRoutes(group, mainClient, redisClient, usersClient, policy, publisher)
+	registerMusicRoutes(group, musicClient, mainClient, redisClient)
+	registerRatingRoutes(group, musicClient, mainClient, redisClient)
+	registerChartRoutes(group, musicClient, mainClient, redisClient)
 }
@@ -4,7 +4,10 @@ import (
 	entchuniMain "haruki-database/database/schema/chunithm/maindb"
 	entchuniMusic "haruki-database/database/schema/chunithm/music"
 	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
 	"haruki-database/utils/types"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 )
@@ -14,14 +17,49 @@ import (
 type AliasToMusicIDResponse = types.AliasToIDResponse
 type AllAliasesResponse = types.AliasListResponse
 type AliasRequest = types.AliasRequest
+type RejectRequest = types.RejectRequest
+type PendingAlias = types.ChunithmPendingAlias
 
 type MusicInfoSchema = types.ChunithmMusicInfo
+type AvailabilitySchema = types.ChunithmMusicAvailability
 type MusicDifficultySchema = types.ChunithmMusicDifficulty
 type ChartDataSchema = types.ChunithmChartData
+type ChartSummarySchema = types.ChunithmChartSummary
+type ChartDetailSchema = types.ChunithmChartDetail
+type ChartComparisonSchema = types.ChunithmChartComparison
+type LevelDistributionSchema = types.ChunithmLevelDistribution
+type ConstantCountSchema = types.ChunithmConstantCount
+type CreatorStatSchema = types.ChunithmCreatorStat
+type NoteCountsSchema = types.ChunithmNoteCounts
+type NoteCompositionSchema = types.ChunithmNoteComposition
+type VersionSchema = types.ChunithmVersion
+type DifficultyChangeSchema = types.ChunithmDifficultyChange
+type DifficultyHistoryEntry = types.ChunithmDifficultyHistoryEntry
+type DifficultyHistorySchema = types.ChunithmDifficultyHistory
 type MusicBatchItemSchema = types.ChunithmMusicBatchItem
+type MusicSearchResponse = types.ChunithmMusicSearchResponse
+type QueryBatchRequest = types.ChunithmQueryBatchRequest
+type QueryBatchResponse = types.ChunithmQueryBatchResponse
+type MusicDataset = types.ChunithmMusicDataset
+type DatasetMusic = types.ChunithmDatasetMusic
+type IngestReport = types.ChunithmIngestReport
+type ChartRef = types.ChunithmChartRef
+type RatingPlay = types.ChunithmRatingPlay
+type RatingRequest = types.ChunithmRatingRequest
+type RatingPlayResult = types.ChunithmRatingPlayResult
+type RatingResult = types.ChunithmRatingResult
+type RecommendRequest = types.ChunithmRecommendRequest
+type RecommendationSchema = types.ChunithmRecommendation
+type RecommendResult = types.ChunithmRecommendResult
 
 type DefaultServerSchema = types.ChunithmDefaultServer
 type BindingSchema = types.ChunithmBinding
+type BindingListResponse = types.ChunithmBindingListResponse
+type AddBindingRequest = types.ChunithmAddBindingRequest
+type UpdateBindingRequest = types.ChunithmUpdateBindingRequest
+type ReorderBindingsRequest = types.ChunithmReorderBindingsRequest
+type AddBindingResponse = types.ChunithmAddBindingResponse
+type BindingOwnersResponse = types.ChunithmBindingOwnersResponse
 
 type MusicAliasSchema = types.ChunithmMusicAlias
 
@@ -33,6 +71,99 @@ const (
 	CacheNSMusic   = "hdb:chunithm:music"
 )
 
+// ================= Music Search Constants =================
+
+const (
+	DefaultSearchPageSize = 20
+	MaxSearchPageSize     = 100
+	MaxDifficultyIndex    = 4
+)
+
+// ================= Query Batch Constants =================
+
+const DefaultQueryBatchMaxSize = 200
+
+// ================= Music Ingestion Constants =================
+
+const (
+	MaxVersionLength  = 10
+	MaxTitleLength    = 255
+	MaxCategoryLength = 50
+	MaxCreatorLength  = 100
+	MaxChartConstant  = 20.0
+)
+
+// ================= Binding Constants =================
+
+const MaxBindingLabelLength = 50
+
+// ================= Chart Statistics Constants =================
+
+const (
+	DefaultChartStatsLimit = 20
+	MaxChartStatsLimit     = 100
+	PlusLevelThreshold     = 7
+)
+
+// ================= Rating Constants =================
+
+const (
+	MaxRatingPlays = 5000
+	MaxScore       = 1010000
+	BestFrameSize  = 30
+	NewFrameSize   = 20
+
+	DefaultRecommendLimit = 20
+	MaxRecommendLimit     = 100
+	FrameBest             = "best"
+	FrameNew              = "new"
+)
+
+// ================= Version Ordering =================
+
+// versionOrder compares game versions by their release order in the versions
+// table, falling back to a numeric comparison of dot-separated segments for
+// versions the table does not know about.
+type versionOrder struct {
+	ranks map[string]int
+	info  map[string]*entchuniMusic.ChunithmVersion
+}
+
+// chartEntry pairs a chart row with its released song and the constant of
+// the requested version.
+type chartEntry struct {
+	chart    *entchuniMusic.ChunithmChartData
+	music    *entchuniMusic.ChunithmMusic
+	constant *float64
+}
+
+// ================= Parameter Structs =================
+
+type GroupAliasParams struct {
+	Platform string
+	GroupID  string
+	MusicID  int
+	AliasStr string
+}
+
+type MusicSearchParams struct {
+	Keyword           string
+	Category          string
+	Version           string
+	IsDeleted         *bool
+	ReleaseFrom       *time.Time
+	ReleaseTo         *time.Time
+	Difficulty        *int
+	ConstMin          *float64
+	ConstMax          *float64
+	ConstVersion      string
+	IncludeUnreleased bool
+	Sort              string
+	Order             string
+	Page              int
+	PageSize          int
+}
+
 // ================= Service Structs =================
 
 type AliasService struct {
@@ -44,13 +175,21 @@ type BindingService struct {
 	client      *entchuniMain.Client
 	redisClient *redis.Client
 	usersClient *users.Client
+	policy      utils.SharedBindingPolicy
+	publisher   *events.Publisher
 }
 
 type MusicService struct {
 	client      *entchuniMusic.Client
+	mainClient  *entchuniMain.Client
 	redisClient *redis.Client
 }
 
+// bindingStore serves Chunithm bindings to the generic game binding API.
+type bindingStore struct {
+	svc *BindingService
+}
+
 // ================= Handler Structs =================
 
 type AliasHandler struct {
@@ -64,3 +203,11 @@ type BindingHandler struct {
 type MusicHandler struct {
 	svc *MusicService
 }
+
+type RatingHandler struct {
+	svc *MusicService
+}
+
+type ChartHandler struct {
+	svc *MusicService
+}
@@ -0,0 +1,447 @@
+package gamebinding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/api"
+	"haruki-database/config"
+	"haruki-database/database/schema/users"
+	"haruki-database/database/schema/users/gamebinding"
+	"haruki-database/database/schema/users/gamebindinglock"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
+	"regexp"
+	"slices"
+	"time"
+)
+
+var (
+	ErrBindingExists   = errors.New(api.ErrAlreadyExists)
+	ErrBindingNotFound = errors.New(api.ErrBindingNotFound)
+	ErrBindingShared   = errors.New(api.ErrBindingShared)
+	ErrBindingLimit    = errors.New("binding limit of this server reached")
+	ErrInvalidOrder    = errors.New("binding_ids must list every binding of the server exactly once")
+)
+
+// ================= Registry =================
+
+func NewRegistry() *Registry {
+	return &Registry{games: make(map[string]*Game)}
+}
+
+func NewHandler(registry *Registry) *Handler {
+	return &Handler{registry: registry}
+}
+
+// Register adds a game. Games are listed in registration order.
+func (r *Registry) Register(game Game) error {
+	if game.Name == "" || len(game.Servers) == 0 || game.Store == nil || game.ValidateAccountID == nil {
+		return fmt.Errorf("incomplete binding game declaration: %q", game.Name)
+	}
+	if _, ok := r.games[game.Name]; ok {
+		return fmt.Errorf("binding game already registered: %s", game.Name)
+	}
+	r.games[game.Name] = &game
+	r.names = append(r.names, game.Name)
+	return nil
+}
+
+func (r *Registry) Lookup(name string) (*Game, bool) {
+	g, ok := r.games[name]
+	return g, ok
+}
+
+func (r *Registry) Games() []GameInfo {
+	out := make([]GameInfo, len(r.names))
+	for i, name := range r.names {
+		out[i] = GameInfo{Game: name, Servers: r.games[name].Servers}
+	}
+	return out
+}
+
+// RegisterConfiguredGames registers every game of the game_bindings config,
+// backed by the generic binding table of the users DB.
+func RegisterConfiguredGames(r *Registry, client *users.Client, games []config.GameBindingConfig, publisher *events.Publisher) error {
+	for _, g := range games {
+		pattern, err := regexp.Compile(g.AccountIDPattern)
+		if err != nil {
+			return fmt.Errorf("invalid account_id_pattern of %s: %w", g.Name, err)
+		}
+		policy, err := utils.ParseSharedBindingPolicy(g.SharedBindingPolicy)
+		if err != nil {
+			return fmt.Errorf("invalid shared_binding_policy of %s: %w", g.Name, err)
+		}
+		err = r.Register(Game{
+			Name:              g.Name,
+			Servers:           g.Servers,
+			ValidateAccountID: patternValidator(pattern),
+			Store: &tableStore{
+				client:       client,
+				game:         g.Name,
+				policy:       policy,
+				maxPerServer: g.MaxBindingsPerServer,
+				publisher:    publisher,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func patternValidator(pattern *regexp.Regexp) func(string, string) error {
+	return func(_ string, accountID string) error {
+		if accountID == "" || !pattern.MatchString(accountID) {
+			return fmt.Errorf("account_id must match %s", pattern)
+		}
+		return nil
+	}
+}
+
+// ================= Validation =================
+
+func (g *Game) validateServer(server string) error {
+	if !slices.Contains(g.Servers, server) {
+		return &api.ValidationError{Code: api.ErrCodeInvalidServer, Message: fmt.Sprintf("invalid %s server: %s", g.Name, server)}
+	}
+	return nil
+}
+
+func validateLabel(label *string) error {
+	if label != nil && !api.ValidateStringLength(*label, MaxBindingLabelLength) {
+		return &api.ValidationError{Code: api.ErrCodeInvalidLabel, Message: "invalid label"}
+	}
+	return nil
+}
+
+func (g *Game) validateAddBinding(req *AddBindingRequest) error {
+	if err := g.validateServer(req.Server); err != nil {
+		return err
+	}
+	if !api.ValidateStringLength(req.AccountID, MaxAccountIDLength) {
+		return &api.ValidationError{Code: api.ErrCodeInvalidAccountID, Message: "account_id too long"}
+	}
+	if err := g.ValidateAccountID(req.Server, req.AccountID); err != nil {
+		return &api.ValidationError{Code: api.ErrCodeInvalidAccountID, Message: err.Error()}
+	}
+	return validateLabel(req.Label)
+}
+
+// ================= Table Store =================
+
+func (s *tableStore) List(ctx context.Context, harukiUserID int, server string) ([]Binding, error) {
+	q := s.client.GameBinding.Query().
+		Where(gamebinding.GameEQ(s.game), gamebinding.HarukiUserIDEQ(harukiUserID))
+	if server != "" {
+		q = q.Where(gamebinding.ServerEQ(server))
+	}
+	rows, err := q.
+		Order(
+			users.Asc(gamebinding.FieldServer),
+			users.Asc(gamebinding.FieldSortOrder),
+			users.Asc(gamebinding.FieldID),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Binding, len(rows))
+	for i, r := range rows {
+		out[i] = toBinding(r)
+	}
+	return out, nil
+}
+
+// Add appends the binding after the user's other bindings on the server. The
+// first binding of a server always becomes its default.
+func (s *tableStore) Add(ctx context.Context, harukiUserID int, req *AddBindingRequest) (int, bool, error) {
+	shared, err := s.checkShared(ctx, harukiUserID, req.Server, req.AccountID)
+	if err != nil {
+		return 0, shared, err
+	}
+	var id int
+	err = s.withTx(ctx, func(tx *users.Tx) error {
+		// The upserted lock row stays locked until the transaction ends, so
+		// concurrent adds of the user wait here and then count the rows
+		// committed meanwhile instead of both passing the limit check.
+		if err := tx.GameBindingLock.Create().
+			SetGame(s.game).
+			SetHarukiUserID(harukiUserID).
+			SetLockedAt(time.Now()).
+			OnConflictColumns(gamebindinglock.FieldGame, gamebindinglock.FieldHarukiUserID).
+			UpdateLockedAt().
+			Exec(ctx); err != nil {
+			return err
+		}
+		rows, err := tx.GameBinding.Query().
+			Where(gamebinding.GameEQ(s.game), gamebinding.HarukiUserIDEQ(harukiUserID), gamebinding.ServerEQ(req.Server)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		sortOrder := 0
+		for _, r := range rows {
+			if r.AccountID == req.AccountID {
+				return ErrBindingExists
+			}
+			sortOrder = max(sortOrder, r.SortOrder+1)
+		}
+		if s.maxPerServer > 0 && len(rows) >= s.maxPerServer {
+			return ErrBindingLimit
+		}
+		isDefault := len(rows) == 0 || req.IsDefault
+		if isDefault {
+			if err := s.clearDefault(ctx, tx, harukiUserID, req.Server); err != nil {
+				return err
+			}
+		}
+		visible := true
+		if req.Visible != nil {
+			visible = *req.Visible
+		}
+		row, err := tx.GameBinding.Create().
+			SetGame(s.game).
+			SetHarukiUserID(harukiUserID).
+			SetServer(req.Server).
+			SetAccountID(req.AccountID).
+			SetNillableLabel(emptyToNil(req.Label)).
+			SetVisible(visible).
+			SetIsDefault(isDefault).
+			SetSortOrder(sortOrder).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		id = row.ID
+		return nil
+	})
+	if err != nil {
+		return 0, shared, err
+	}
+	s.publish(ctx, utils.EventBindingCreated, harukiUserID, req.Server, id)
+	return id, shared, nil
+}
+
+func (s *tableStore) Update(ctx context.Context, harukiUserID int, bindingID int, req *UpdateBindingRequest) error {
+	row, err := s.client.GameBinding.Query().
+		Where(gamebinding.GameEQ(s.game), gamebinding.HarukiUserIDEQ(harukiUserID), gamebinding.IDEQ(bindingID)).
+		Only(ctx)
+	if users.IsNotFound(err) {
+		return ErrBindingNotFound
+	}
+	if err != nil {
+		return err
+	}
+	upd := row.Update().SetNillableVisible(req.Visible)
+	if req.Label != nil {
+		if *req.Label == "" {
+			upd.ClearLabel()
+		} else {
+			upd.SetLabel(*req.Label)
+		}
+	}
+	if err := upd.Exec(ctx); err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventBindingUpdated, harukiUserID, row.Server, row.ID)
+	return nil
+}
+
+func (s *tableStore) SetDefault(ctx context.Context, harukiUserID int, bindingID int) error {
+	var server string
+	err := s.withTx(ctx, func(tx *users.Tx) error {
+		row, err := s.getOwned(ctx, tx, harukiUserID, bindingID)
+		if err != nil {
+			return err
+		}
+		server = row.Server
+		if err := s.clearDefault(ctx, tx, harukiUserID, row.Server); err != nil {
+			return err
+		}
+		return tx.GameBinding.UpdateOneID(row.ID).SetIsDefault(true).Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventDefaultBindingChanged, harukiUserID, server, bindingID)
+	return nil
+}
+
+func (s *tableStore) Reorder(ctx context.Context, harukiUserID int, req *ReorderBindingsRequest) error {
+	err := s.withTx(ctx, func(tx *users.Tx) error {
+		ids, err := tx.GameBinding.Query().
+			Where(gamebinding.GameEQ(s.game), gamebinding.HarukiUserIDEQ(harukiUserID), gamebinding.ServerEQ(req.Server)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 || len(ids) != len(req.BindingIDs) {
+			return ErrInvalidOrder
+		}
+		owned := make(map[int]bool, len(ids))
+		for _, id := range ids {
+			owned[id] = true
+		}
+		for i, id := range req.BindingIDs {
+			if !owned[id] {
+				return ErrInvalidOrder
+			}
+			delete(owned, id)
+			if err := tx.GameBinding.UpdateOneID(id).SetSortOrder(i).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventBindingReordered, harukiUserID, req.Server, 0)
+	return nil
+}
+
+// Remove deletes the binding and, when it was the default, promotes the next
+// binding of the server in display order.
+func (s *tableStore) Remove(ctx context.Context, harukiUserID int, bindingID int) error {
+	var server string
+	err := s.withTx(ctx, func(tx *users.Tx) error {
+		row, err := s.getOwned(ctx, tx, harukiUserID, bindingID)
+		if err != nil {
+			return err
+		}
+		server = row.Server
+		if err := tx.GameBinding.DeleteOneID(row.ID).Exec(ctx); err != nil {
+			return err
+		}
+		if !row.IsDefault {
+			return nil
+		}
+		next, err := tx.GameBinding.Query().
+			Where(gamebinding.GameEQ(s.game), gamebinding.HarukiUserIDEQ(harukiUserID), gamebinding.ServerEQ(row.Server)).
+			Order(users.Asc(gamebinding.FieldSortOrder), users.Asc(gamebinding.FieldID)).
+			First(ctx)
+		if users.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return next.Update().SetIsDefault(true).Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventBindingDeleted, harukiUserID, server, bindingID)
+	return nil
+}
+
+// ClearCache is a no-op: responses of configured games are not cached.
+func (s *tableStore) ClearCache(context.Context, int) {}
+
+// publish sends a change event for the user's bindings of the game. Server
+// and bindingID are left out of the event when empty.
+func (s *tableStore) publish(ctx context.Context, eventType utils.EventType, harukiUserID int, server string, bindingID int) {
+	s.publisher.Publish(ctx, eventType, events.Event{
+		Game:         s.game,
+		HarukiUserID: harukiUserID,
+		Server:       server,
+		BindingID:    bindingID,
+	})
+}
+
+// checkShared reports whether another Haruki user has bound the account,
+// returning ErrBindingShared when the shared binding policy forbids it.
+func (s *tableStore) checkShared(ctx context.Context, harukiUserID int, server, accountID string) (bool, error) {
+	if s.policy == utils.SharedBindingPolicyAllow {
+		return false, nil
+	}
+	shared, err := s.client.GameBinding.Query().
+		Where(
+			gamebinding.GameEQ(s.game),
+			gamebinding.ServerEQ(server),
+			gamebinding.AccountIDEQ(accountID),
+			gamebinding.HarukiUserIDNEQ(harukiUserID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+	if shared && s.policy == utils.SharedBindingPolicyForbid {
+		return true, ErrBindingShared
+	}
+	return shared, nil
+}
+
+func (s *tableStore) getOwned(ctx context.Context, tx *users.Tx, harukiUserID int, bindingID int) (*users.GameBinding, error) {
+	row, err := tx.GameBinding.Query().
+		Where(gamebinding.GameEQ(s.game), gamebinding.HarukiUserIDEQ(harukiUserID), gamebinding.IDEQ(bindingID)).
+		Only(ctx)
+	if users.IsNotFound(err) {
+		return nil, ErrBindingNotFound
+	}
+	return row, err
+}
+
+func (s *tableStore) clearDefault(ctx context.Context, tx *users.Tx, harukiUserID int, server string) error {
+	return tx.GameBinding.Update().
+		Where(
+			gamebinding.GameEQ(s.game),
+			gamebinding.HarukiUserIDEQ(harukiUserID),
+			gamebinding.ServerEQ(server),
+			gamebinding.IsDefault(true),
+		).
+		SetIsDefault(false).
+		Exec(ctx)
+}
+
+func (s *tableStore) withTx(ctx context.Context, fn func(tx *users.Tx) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func toBinding(r *users.GameBinding) Binding {
+	return Binding{
+		ID:           r.ID,
+		Game:         r.Game,
+		HarukiUserID: r.HarukiUserID,
+		Server:       r.Server,
+		AccountID:    r.AccountID,
+		Label:        r.Label,
+		Visible:      r.Visible,
+		IsDefault:    r.IsDefault,
+		SortOrder:    r.SortOrder,
+	}
+}
+
+// thirdPartyView drops the hidden bindings and strips the rest down to their
+// public fields, the same way the PJSK binding endpoints do: the id and label
+// are dropped and the account id is masked.
+func thirdPartyView(rows []Binding) []Binding {
+	out := make([]Binding, 0, len(rows))
+	for _, b := range rows {
+		if !b.Visible {
+			continue
+		}
+		b.ID = 0
+		b.Label = nil
+		b.AccountID = api.MaskAccountID(b.AccountID)
+		out = append(out, b)
+	}
+	return out
+}
+
+func emptyToNil(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
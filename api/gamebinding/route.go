@@ -0,0 +1,187 @@
+package gamebinding
+
+import (
+	"context"
+	"errors"
+	"haruki-database/api"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// ================= Game Middleware =================
+
+// requireGame resolves the :game path parameter against the registry.
+func requireGame(registry *Registry) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		game, ok := registry.Lookup(c.Params("game"))
+		if !ok {
+			return api.ErrorCodeResponse(c, fiber.StatusNotFound, api.ErrCodeUnknownGame, "unknown game: "+c.Params("game"))
+		}
+		c.Locals(gameKey, game)
+		return c.Next()
+	}
+}
+
+func getGame(c fiber.Ctx) *Game {
+	if g, ok := c.Locals(gameKey).(*Game); ok {
+		return g
+	}
+	return nil
+}
+
+// storeErrorResponse maps the errors of a Store to responses.
+func storeErrorResponse(c fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, ErrBindingNotFound):
+		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
+	case errors.Is(err, ErrBindingExists):
+		return api.JSONResponse(c, fiber.StatusConflict, api.ErrAlreadyExists)
+	case errors.Is(err, ErrBindingShared):
+		return api.ErrorCodeResponse(c, fiber.StatusConflict, api.ErrCodeBindingShared, err.Error())
+	case errors.Is(err, ErrBindingLimit):
+		return api.ErrorCodeResponse(c, fiber.StatusConflict, api.ErrCodeBindingLimit, err.Error())
+	case errors.Is(err, ErrInvalidOrder):
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidOrder, err.Error())
+	default:
+		return api.InternalError(c)
+	}
+}
+
+// ================= Binding Handlers =================
+
+func (h *Handler) ListGames(c fiber.Ctx) error {
+	return api.JSONResponse(c, fiber.StatusOK, "ok", GameListResponse{Games: h.registry.Games()})
+}
+
+func (h *Handler) ListBindings(c fiber.Ctx) error {
+	ctx := context.Background()
+	game := getGame(c)
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+	server := c.Query("server")
+	if server != "" {
+		if err := game.validateServer(server); err != nil {
+			return api.ValidationErrorResponse(c, err)
+		}
+	}
+	thirdParty, err := api.IsThirdPartyViewer(c, harukiUserID)
+	if err != nil {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidRequest, err.Error())
+	}
+	rows, err := game.Store.List(ctx, harukiUserID, server)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if thirdParty {
+		rows = thirdPartyView(rows)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "ok", BindingListResponse{Game: game.Name, Bindings: rows})
+}
+
+func (h *Handler) AddBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	game := getGame(c)
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+	var req AddBindingRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidRequest, api.ErrInvalidRequest)
+	}
+	if err := game.validateAddBinding(&req); err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+	id, shared, err := game.Store.Add(ctx, harukiUserID, &req)
+	if err != nil {
+		return storeErrorResponse(c, err)
+	}
+	game.Store.ClearCache(ctx, harukiUserID)
+	return api.JSONResponse(c, fiber.StatusCreated, "ok", AddBindingResponse{BindingID: id, Shared: shared})
+}
+
+func (h *Handler) UpdateBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	game := getGame(c)
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+	var req UpdateBindingRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidRequest, api.ErrInvalidRequest)
+	}
+	if err := validateLabel(req.Label); err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+	if err := game.Store.Update(ctx, harukiUserID, fiber.Params[int](c, "binding_id", 0), &req); err != nil {
+		return storeErrorResponse(c, err)
+	}
+	game.Store.ClearCache(ctx, harukiUserID)
+	return api.JSONResponse(c, fiber.StatusOK, "Binding updated")
+}
+
+func (h *Handler) SetDefaultBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	game := getGame(c)
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+	if err := game.Store.SetDefault(ctx, harukiUserID, fiber.Params[int](c, "binding_id", 0)); err != nil {
+		return storeErrorResponse(c, err)
+	}
+	game.Store.ClearCache(ctx, harukiUserID)
+	return api.JSONResponse(c, fiber.StatusOK, "Default binding set")
+}
+
+func (h *Handler) ReorderBindings(c fiber.Ctx) error {
+	ctx := context.Background()
+	game := getGame(c)
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+	var req ReorderBindingsRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidRequest, api.ErrInvalidRequest)
+	}
+	if err := game.validateServer(req.Server); err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+	if err := game.Store.Reorder(ctx, harukiUserID, &req); err != nil {
+		return storeErrorResponse(c, err)
+	}
+	game.Store.ClearCache(ctx, harukiUserID)
+	return api.JSONResponse(c, fiber.StatusOK, "Bindings reordered")
+}
+
+func (h *Handler) RemoveBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	game := getGame(c)
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidHarukiUserID, api.ErrInvalidHarukiUserID)
+	}
+	if err := game.Store.Remove(ctx, harukiUserID, fiber.Params[int](c, "binding_id", 0)); err != nil {
+		return storeErrorResponse(c, err)
+	}
+	game.Store.ClearCache(ctx, harukiUserID)
+	return api.JSONResponse(c, fiber.StatusOK, "Binding deleted")
+}
+
+// ================= Route Registration =================
+
+func RegisterGameBindingRoutes(app *fiber.App, registry *Registry) {
+	h := NewHandler(registry)
+	app.Get("/binding/games", api.VerifyAPIAuthorization(), h.ListGames)
+	r := app.Group("/binding/:game/user/:haruki_user_id", api.VerifyAPIAuthorization(), requireGame(registry))
+	r.Get("/", h.ListBindings)
+	r.Post("/", h.AddBinding)
+	r.Put("/order", h.ReorderBindings)
+	r.Patch("/:binding_id", h.UpdateBinding)
+	r.Put("/:binding_id/default", h.SetDefaultBinding)
+	r.Delete("/:binding_id", h.RemoveBinding)
+}
@@ -0,0 +1,88 @@
+package gamebinding
+
+import (
+	"context"
+	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
+	"haruki-database/utils/types"
+)
+
+// ================= Type Aliases =================
+
+type Binding = types.GameBinding
+type BindingListResponse = types.GameBindingListResponse
+type AddBindingRequest = types.AddGameBindingRequest
+type AddBindingResponse = types.AddGameBindingResponse
+type UpdateBindingRequest = types.UpdateGameBindingRequest
+type ReorderBindingsRequest = types.ReorderGameBindingsRequest
+type GameInfo = types.GameInfo
+type GameListResponse = types.GameListResponse
+
+// ================= Context Keys =================
+
+const gameKey = "binding_game"
+
+// ================= Binding Constants =================
+
+const MaxBindingLabelLength = 50
+
+const MaxAccountIDLength = 100
+
+// ================= Store Interface =================
+
+// Store persists the bindings of one game. Implementations keep at most one
+// default binding per user and server and report failures with the errors of
+// this package, so every game shares the same HTTP error mapping.
+type Store interface {
+	// List returns the user's bindings ordered by server, sort order and id,
+	// limited to server when it is not empty.
+	List(ctx context.Context, harukiUserID int, server string) ([]Binding, error)
+	// Add creates a binding and reports whether another user has bound the
+	// same account. It returns ErrBindingExists, ErrBindingShared or
+	// ErrBindingLimit when the binding cannot be created.
+	Add(ctx context.Context, harukiUserID int, req *AddBindingRequest) (int, bool, error)
+	// Update changes the label and visibility of a binding. An empty label
+	// clears it.
+	Update(ctx context.Context, harukiUserID int, bindingID int, req *UpdateBindingRequest) error
+	// SetDefault makes the binding the default of its server.
+	SetDefault(ctx context.Context, harukiUserID int, bindingID int) error
+	// Reorder sets the display order of the user's bindings on a server.
+	Reorder(ctx context.Context, harukiUserID int, req *ReorderBindingsRequest) error
+	// Remove deletes a binding.
+	Remove(ctx context.Context, harukiUserID int, bindingID int) error
+	// ClearCache drops the game's cached binding responses of the user.
+	ClearCache(ctx context.Context, harukiUserID int)
+}
+
+// ================= Game Registry =================
+
+// Game declares a game to the binding subsystem: its server enum, how its
+// account IDs are validated and where its bindings are stored.
+type Game struct {
+	Name              string
+	Servers           []string
+	ValidateAccountID func(server string, accountID string) error
+	Store             Store
+}
+
+type Registry struct {
+	games map[string]*Game
+	names []string
+}
+
+// tableStore keeps the bindings of a configured game in the generic
+// game_bindings table of the users DB.
+type tableStore struct {
+	client       *users.Client
+	game         string
+	policy       utils.SharedBindingPolicy
+	maxPerServer int
+	publisher    *events.Publisher
+}
+
+// ================= Handler =================
+
+type Handler struct {
+	registry *Registry
+}
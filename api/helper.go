@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"haruki-database/config"
 	harukiRedis "haruki-database/utils/redis"
 	"strconv"
@@ -35,6 +36,26 @@ func ErrorResponse(c fiber.Ctx, status int, message string) error {
 	return JSONResponse(c, status, message)
 }
 
+// ErrorCodeResponse writes an error response with a machine-readable code.
+func ErrorCodeResponse(c fiber.Ctx, status int, code string, message string) error {
+	return c.Status(status).JSON(fiber.Map{
+		"status":     status,
+		"error_code": code,
+		"message":    message,
+		"data":       nil,
+	})
+}
+
+// ValidationErrorResponse writes a 400 response for err, using its code when
+// err is a *ValidationError.
+func ValidationErrorResponse(c fiber.Ctx, err error) error {
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		return ErrorCodeResponse(c, fiber.StatusBadRequest, ve.Code, ve.Message)
+	}
+	return ErrorCodeResponse(c, fiber.StatusBadRequest, ErrCodeInvalidRequest, err.Error())
+}
+
 func InternalError(c fiber.Ctx) error {
 	return JSONResponse(c, fiber.StatusInternalServerError, ErrInternalServer)
 }
@@ -85,6 +106,15 @@ func CacheQuery(ctx context.Context, c fiber.Ctx, redisClient *redis.Client, nam
 	return key, nil, false, nil
 }
 
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func IsValidationError(err error) bool {
+	var ve *ValidationError
+	return errors.As(err, &ve)
+}
+
 // ================= User ID Extraction =================
 
 func GetHarukiUserIDFromPath(c fiber.Ctx) int {
@@ -100,6 +130,33 @@ func GetHarukiUserIDFromQuery(c fiber.Ctx) int {
 	return id
 }
 
+// ================= Third-Party View =================
+
+// IsThirdPartyViewer reports whether the caller must get the third-party
+// view of harukiUserID's bindings. Only a viewer_haruki_user_id equal to the
+// owner unlocks the owner view; without it the caller is a third party.
+func IsThirdPartyViewer(c fiber.Ctx, harukiUserID int) (bool, error) {
+	raw := c.Query("viewer_haruki_user_id")
+	if raw == "" {
+		return true, nil
+	}
+	viewer, err := strconv.Atoi(raw)
+	if err != nil || viewer <= 0 {
+		return false, errors.New("invalid viewer_haruki_user_id")
+	}
+	return viewer != harukiUserID, nil
+}
+
+// MaskAccountID keeps only the first and last two characters of a game
+// account id shown to a third party.
+func MaskAccountID(id string) string {
+	r := []rune(id)
+	if len(r) <= 4 {
+		return strings.Repeat("*", len(r))
+	}
+	return string(r[:2]) + strings.Repeat("*", len(r)-4) + string(r[len(r)-2:])
+}
+
 // ================= Validation Functions =================
 
 func ValidateStringLength(s string, maxLen int) bool {
@@ -0,0 +1,106 @@
+package pjsk
+
+import (
+	"context"
+	"errors"
+	"haruki-database/api"
+	"haruki-database/api/gamebinding"
+	"haruki-database/database/schema/pjsk"
+	"haruki-database/database/schema/pjsk/userbinding"
+	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ================= Game Binding Adapter =================
+
+// NewBindingGame declares PJSK to the generic game binding API. Bindings stay
+// in the PJSK database; per-server defaults map to the server entries of the
+// default binding table and the global default is left untouched. Every
+// operation goes through BindingService, shared with the /pjsk/user
+// endpoints.
+func NewBindingGame(client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client,
+	policy utils.SharedBindingPolicy, publisher *events.Publisher) gamebinding.Game {
+	servers := make([]string, len(utils.BindingServers))
+	for i, s := range utils.BindingServers {
+		servers[i] = string(s)
+	}
+	return gamebinding.Game{
+		Name:              string(utils.GamePJSK),
+		Servers:           servers,
+		ValidateAccountID: validateUserID,
+		Store:             &bindingStore{svc: NewBindingService(client, redisClient, usersClient, policy, publisher)},
+	}
+}
+
+func validateUserID(_ string, userID string) error {
+	if userID == "" || !api.ValidateStringLength(userID, api.MaxUserIDLength) {
+		return errors.New(api.ErrInvalidUserID)
+	}
+	return nil
+}
+
+func (s *bindingStore) List(ctx context.Context, harukiUserID int, server string) ([]gamebinding.Binding, error) {
+	rows, err := s.svc.ListBindings(ctx, harukiUserID, server)
+	if err != nil {
+		return nil, err
+	}
+	serverDefaults, _, err := s.svc.defaultBindingIDs(ctx, harukiUserID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]gamebinding.Binding, len(rows))
+	for i, r := range rows {
+		out[i] = gamebinding.Binding{
+			ID:           r.ID,
+			Game:         string(utils.GamePJSK),
+			HarukiUserID: r.HarukiUserID,
+			Server:       r.Server,
+			AccountID:    r.UserID,
+			Label:        r.Label,
+			Visible:      r.Visible,
+			IsDefault:    serverDefaults[r.ID],
+			SortOrder:    r.SortOrder,
+		}
+	}
+	return out, nil
+}
+
+func (s *bindingStore) Add(ctx context.Context, harukiUserID int, req *gamebinding.AddBindingRequest) (int, bool, error) {
+	row, shared, err := s.svc.AddBinding(ctx, harukiUserID, req.Server, req.AccountID, req.Label, req.Visible, req.IsDefault)
+	if err != nil {
+		return 0, shared, err
+	}
+	return row.ID, shared, nil
+}
+
+func (s *bindingStore) Update(ctx context.Context, harukiUserID int, bindingID int, req *gamebinding.UpdateBindingRequest) error {
+	return s.svc.UpdateBinding(ctx, harukiUserID, bindingID, &UpdateBindingRequest{Label: req.Label, Visible: req.Visible})
+}
+
+func (s *bindingStore) SetDefault(ctx context.Context, harukiUserID int, bindingID int) error {
+	row, err := s.svc.client.UserBinding.Query().
+		Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.IDEQ(bindingID)).
+		Only(ctx)
+	if pjsk.IsNotFound(err) {
+		return ErrBindingNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return s.svc.SetDefaultBinding(ctx, harukiUserID, utils.DefaultBindingServer(row.Server), row.ID)
+}
+
+func (s *bindingStore) Reorder(ctx context.Context, harukiUserID int, req *gamebinding.ReorderBindingsRequest) error {
+	return s.svc.ReorderBindings(ctx, harukiUserID, &ReorderBindingsRequest{Server: req.Server, BindingIDs: req.BindingIDs})
+}
+
+func (s *bindingStore) Remove(ctx context.Context, harukiUserID int, bindingID int) error {
+	return s.svc.DeleteBinding(ctx, harukiUserID, bindingID)
+}
+
+func (s *bindingStore) ClearCache(ctx context.Context, harukiUserID int) {
+	s.svc.ClearBindingCache(ctx, harukiUserID)
+}
@@ -2,13 +2,19 @@ package pjsk
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"haruki-database/api"
 	"haruki-database/config"
 	"haruki-database/database/schema/pjsk"
+	"haruki-database/database/schema/pjsk/predicate"
 	"haruki-database/database/schema/pjsk/userbinding"
 	"haruki-database/database/schema/pjsk/userdefaultbinding"
 	"haruki-database/database/schema/users"
 	"haruki-database/utils"
+	"haruki-database/utils/events"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/redis/go-redis/v9"
@@ -28,6 +34,10 @@ func (h *BindingHandler) GetBindings(c fiber.Ctx) error {
 			return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
 		}
 	}
+	thirdParty, err := api.IsThirdPartyViewer(c, harukiUserID)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
 	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSBinding)
 	if err != nil {
 		return api.InternalError(c)
@@ -35,20 +45,31 @@ func (h *BindingHandler) GetBindings(c fiber.Ctx) error {
 	if hit {
 		return c.Status(fiber.StatusOK).JSON(cached)
 	}
-	q := h.svc.client.UserBinding.Query().Where(userbinding.HarukiUserIDEQ(harukiUserID))
-	if server != "" {
-		q = q.Where(userbinding.ServerEQ(server))
+	var filters []predicate.UserBinding
+	if fiber.Query[bool](c, "verified", false) {
+		filters = append(filters, userbinding.Verified(true))
+	}
+	if thirdParty {
+		filters = append(filters, userbinding.Visible(true))
 	}
-	rows, err := q.All(ctx)
+	rows, err := h.svc.ListBindings(ctx, harukiUserID, server, filters...)
 	if err != nil {
 		return api.InternalError(c)
 	}
 	if len(rows) == 0 {
 		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
 	}
+	serverDefaults, globalDefault, err := h.svc.defaultBindingIDs(ctx, harukiUserID)
+	if err != nil {
+		return api.InternalError(c)
+	}
 	out := make([]BindingSchema, len(rows))
 	for i, r := range rows {
-		out[i] = BindingSchema{ID: r.ID, HarukiUserID: r.HarukiUserID, Server: r.Server, UserID: r.UserID, Visible: r.Visible}
+		out[i] = toBindingSchema(r)
+		markDefaults(&out[i], serverDefaults, globalDefault)
+		if thirdParty {
+			maskBinding(&out[i])
+		}
 	}
 	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", BindingResponse{Bindings: out})
 }
@@ -60,9 +81,10 @@ func (h *BindingHandler) CreateBinding(c fiber.Ctx) error {
 		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
 	}
 	var body struct {
-		Server  string `json:"server"`
-		UserID  string `json:"user_id"`
-		Visible bool   `json:"visible"`
+		Server  string  `json:"server"`
+		UserID  string  `json:"user_id"`
+		Visible *bool   `json:"visible"`
+		Label   *string `json:"label"`
 	}
 	if err := c.Bind().Body(&body); err != nil {
 		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
@@ -73,23 +95,24 @@ func (h *BindingHandler) CreateBinding(c fiber.Ctx) error {
 	if _, err := utils.ParseBindingServer(body.Server); err != nil {
 		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
 	}
-	exists, _ := h.svc.client.UserBinding.Query().
-		Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.ServerEQ(body.Server), userbinding.UserIDEQ(body.UserID)).
-		Exist(ctx)
-	if exists {
+	if body.Label != nil && !api.ValidateStringLength(*body.Label, MaxBindingLabelLength) {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid label")
+	}
+	newBind, shared, err := h.svc.AddBinding(ctx, harukiUserID, body.Server, body.UserID, body.Label, body.Visible, false)
+	if errors.Is(err, ErrBindingExists) {
 		return api.JSONResponse(c, fiber.StatusConflict, api.ErrAlreadyExists)
 	}
-	newBind, err := h.svc.client.UserBinding.Create().
-		SetHarukiUserID(harukiUserID).
-		SetServer(body.Server).
-		SetUserID(body.UserID).
-		SetVisible(body.Visible).
-		Save(ctx)
+	if errors.Is(err, ErrBindingShared) {
+		return api.ErrorCodeResponse(c, fiber.StatusConflict, api.ErrCodeBindingShared, err.Error())
+	}
+	if errors.Is(err, ErrBindingLimit) {
+		return api.ErrorCodeResponse(c, fiber.StatusConflict, api.ErrCodeBindingLimit, err.Error())
+	}
 	if err != nil {
 		return api.InternalError(c)
 	}
 	h.svc.ClearBindingCache(ctx, harukiUserID)
-	return api.JSONResponse(c, fiber.StatusCreated, "ok", AddBindingSuccessResponse{BindingID: newBind.ID})
+	return api.JSONResponse(c, fiber.StatusCreated, "ok", AddBindingSuccessResponse{BindingID: newBind.ID, Shared: shared})
 }
 
 func (h *BindingHandler) GetDefaultBinding(c fiber.Ctx) error {
@@ -102,6 +125,10 @@ func (h *BindingHandler) GetDefaultBinding(c fiber.Ctx) error {
 	if _, err := utils.ParseDefaultBindingServer(server); err != nil {
 		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
 	}
+	thirdParty, err := api.IsThirdPartyViewer(c, harukiUserID)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
 	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSBinding)
 	if err != nil {
 		return api.InternalError(c)
@@ -113,19 +140,65 @@ func (h *BindingHandler) GetDefaultBinding(c fiber.Ctx) error {
 		Where(userdefaultbinding.HarukiUserIDEQ(harukiUserID), userdefaultbinding.ServerEQ(server)).
 		WithBinding().
 		First(ctx)
-	if err != nil || row.Edges.Binding == nil {
+	verifiedOnly := fiber.Query[bool](c, "verified", false)
+	if err != nil || row.Edges.Binding == nil || (thirdParty && !row.Edges.Binding.Visible) ||
+		(verifiedOnly && !row.Edges.Binding.Verified) {
 		msg := "No global default set"
 		if server != "default" {
 			msg = "No default for server '" + server + "'"
 		}
 		return api.JSONResponse(c, fiber.StatusNotFound, msg)
 	}
-	b := row.Edges.Binding
+	serverDefaults, globalDefault, err := h.svc.defaultBindingIDs(ctx, harukiUserID)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	b := toBindingSchema(row.Edges.Binding)
+	markDefaults(&b, serverDefaults, globalDefault)
+	if thirdParty {
+		maskBinding(&b)
+	}
 	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", BindingResponse{
-		Binding: &BindingSchema{ID: b.ID, HarukiUserID: b.HarukiUserID, Server: b.Server, UserID: b.UserID, Visible: b.Visible},
+		Binding: &b,
 	})
 }
 
+// ResolveBinding returns the binding a bot should use for a server, applying
+// the fallback chain of BindingService.ResolveBinding.
+func (h *BindingHandler) ResolveBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+	}
+	server := c.Query("server")
+	if _, err := utils.ParseBindingServer(server); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	thirdParty, err := api.IsThirdPartyViewer(c, harukiUserID)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSBinding)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	resolved, err := h.svc.ResolveBinding(ctx, harukiUserID, server, fiber.Query[bool](c, "verified", false))
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if resolved == nil || (thirdParty && !resolved.Binding.Visible) {
+		return api.JSONResponse(c, fiber.StatusNotFound, "No binding for server '"+server+"'")
+	}
+	if thirdParty {
+		maskBinding(&resolved.Binding)
+	}
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", resolved)
+}
+
 func (h *BindingHandler) SetDefaultBinding(c fiber.Ctx) error {
 	ctx := context.Background()
 	harukiUserID := api.GetHarukiUserIDFromPath(c)
@@ -143,23 +216,14 @@ func (h *BindingHandler) SetDefaultBinding(c fiber.Ctx) error {
 	if err != nil {
 		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
 	}
-	binding, err := h.svc.client.UserBinding.Query().
-		Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.IDEQ(body.BindingID)).
-		First(ctx)
-	if err != nil {
+	err = h.svc.SetDefaultBinding(ctx, harukiUserID, dfs, body.BindingID)
+	if pjsk.IsNotFound(err) {
 		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
 	}
-	if dfs != utils.DefaultBindingServerDefault && binding.Server != body.Server {
-		return api.JSONResponse(c, fiber.StatusBadRequest, "Binding server mismatch")
+	if errors.Is(err, ErrServerMismatch) {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
 	}
-	_, _ = h.svc.client.UserDefaultBinding.Delete().
-		Where(userdefaultbinding.HarukiUserIDEQ(harukiUserID), userdefaultbinding.ServerEQ(body.Server)).
-		Exec(ctx)
-	if _, err = h.svc.client.UserDefaultBinding.Create().
-		SetHarukiUserID(harukiUserID).
-		SetServer(body.Server).
-		SetBindingID(body.BindingID).
-		Save(ctx); err != nil {
+	if err != nil {
 		return api.InternalError(c)
 	}
 	h.svc.ClearBindingCache(ctx, harukiUserID)
@@ -179,38 +243,62 @@ func (h *BindingHandler) DeleteDefaultBinding(c fiber.Ctx) error {
 	if _, err := utils.ParseDefaultBindingServer(body.Server); err != nil {
 		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
 	}
-	if _, err := h.svc.client.UserDefaultBinding.Delete().
-		Where(userdefaultbinding.HarukiUserIDEQ(harukiUserID), userdefaultbinding.ServerEQ(body.Server)).
-		Exec(ctx); err != nil {
+	if err := h.svc.ClearDefaultBinding(ctx, harukiUserID, utils.DefaultBindingServer(body.Server)); err != nil {
 		return api.InternalError(c)
 	}
 	h.svc.ClearBindingCache(ctx, harukiUserID)
 	return api.JSONResponse(c, fiber.StatusOK, "Default binding deleted for "+body.Server)
 }
 
-func (h *BindingHandler) UpdateVisibility(c fiber.Ctx) error {
+// UpdateBinding changes the visibility and label of a binding. An empty
+// label clears it.
+func (h *BindingHandler) UpdateBinding(c fiber.Ctx) error {
 	ctx := context.Background()
 	harukiUserID := api.GetHarukiUserIDFromPath(c)
 	if harukiUserID <= 0 {
 		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
 	}
-	bindingID := fiber.Params[int](c, "binding_id", 0)
-	var body struct{ Visible bool }
+	var body UpdateBindingRequest
 	if err := c.Bind().Body(&body); err != nil {
 		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
 	}
-	if _, err := h.svc.client.UserBinding.Query().
-		Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.IDEQ(bindingID)).
-		First(ctx); err != nil {
+	if body.Label != nil && !api.ValidateStringLength(*body.Label, MaxBindingLabelLength) {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "invalid label")
+	}
+	err := h.svc.UpdateBinding(ctx, harukiUserID, fiber.Params[int](c, "binding_id", 0), &body)
+	if errors.Is(err, ErrBindingNotFound) {
 		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
 	}
-	if _, err := h.svc.client.UserBinding.UpdateOneID(bindingID).
-		SetVisible(body.Visible).
-		Save(ctx); err != nil {
+	if err != nil {
+		return api.InternalError(c)
+	}
+	h.svc.ClearBindingCache(ctx, harukiUserID)
+	return api.JSONResponse(c, fiber.StatusOK, "Binding updated")
+}
+
+// ReorderBindings sets the display order of the user's bindings on a server.
+func (h *BindingHandler) ReorderBindings(c fiber.Ctx) error {
+	ctx := context.Background()
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+	}
+	var body ReorderBindingsRequest
+	if err := c.Bind().Body(&body); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	if _, err := utils.ParseBindingServer(body.Server); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	err := h.svc.ReorderBindings(ctx, harukiUserID, &body)
+	if errors.Is(err, ErrInvalidOrder) {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeInvalidOrder, err.Error())
+	}
+	if err != nil {
 		return api.InternalError(c)
 	}
 	h.svc.ClearBindingCache(ctx, harukiUserID)
-	return api.JSONResponse(c, fiber.StatusOK, "Visibility updated")
+	return api.JSONResponse(c, fiber.StatusOK, "Bindings reordered")
 }
 
 func (h *BindingHandler) DeleteBinding(c fiber.Ctx) error {
@@ -220,22 +308,141 @@ func (h *BindingHandler) DeleteBinding(c fiber.Ctx) error {
 		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
 	}
 	bindingID := fiber.Params[int](c, "binding_id", 0)
-	_, _ = h.svc.client.UserDefaultBinding.Delete().
-		Where(userdefaultbinding.HarukiUserIDEQ(harukiUserID), userdefaultbinding.BindingIDEQ(bindingID)).
-		Exec(ctx)
-	if _, err := h.svc.client.UserBinding.Delete().
-		Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.IDEQ(bindingID)).
-		Exec(ctx); err != nil {
+	err := h.svc.DeleteBinding(ctx, harukiUserID, bindingID)
+	if errors.Is(err, ErrBindingNotFound) {
+		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
+	}
+	if err != nil {
 		return api.InternalError(c)
 	}
 	h.svc.ClearBindingCache(ctx, harukiUserID)
 	return api.JSONResponse(c, fiber.StatusOK, "Binding deleted")
 }
 
+// ================= Verification Handlers =================
+
+// IssueChallenge creates a challenge code that the player must put into the
+// game profile to prove they own the bound account. Issuing again replaces
+// the previous code.
+func (h *BindingHandler) IssueChallenge(c fiber.Ctx) error {
+	ctx := context.Background()
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+	}
+	binding, err := h.svc.getOwnedBinding(ctx, c, harukiUserID)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
+	}
+	if binding.Verified {
+		return api.JSONResponse(c, fiber.StatusConflict, ErrBindingVerified)
+	}
+	code := generateChallengeCode()
+	ttl := time.Duration(BindingChallengeTTLMinutes) * time.Minute
+	if err := h.svc.redisClient.Set(ctx, fmt.Sprintf(RedisKeyBindingChallenge, binding.ID), code, ttl).Err(); err != nil {
+		return api.InternalError(c)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "ok", BindingChallengeResponse{Code: code, ExpiresAt: time.Now().Add(ttl)})
+}
+
+// VerifyBinding checks the profile text reported by the bot against the
+// active challenge code and marks the binding as verified on a match.
+func (h *BindingHandler) VerifyBinding(c fiber.Ctx) error {
+	ctx := context.Background()
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+	}
+	var req VerifyBindingRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	if !api.ValidateStringLength(req.ProfileText, MaxChallengeProfileText) {
+		return api.JSONResponse(c, fiber.StatusBadRequest, ErrProfileTextTooLong)
+	}
+	binding, err := h.svc.getOwnedBinding(ctx, c, harukiUserID)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrBindingNotFound)
+	}
+	if binding.Verified {
+		return api.JSONResponse(c, fiber.StatusConflict, ErrBindingVerified)
+	}
+	key := fmt.Sprintf(RedisKeyBindingChallenge, binding.ID)
+	code, err := h.svc.redisClient.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeChallengeNotFound, ErrChallengeNotFound)
+	}
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if !strings.Contains(req.ProfileText, code) {
+		return api.ErrorCodeResponse(c, fiber.StatusBadRequest, api.ErrCodeChallengeMismatch, ErrChallengeMismatch)
+	}
+	if err := h.svc.VerifyBinding(ctx, binding); err != nil {
+		return api.InternalError(c)
+	}
+	_ = h.svc.redisClient.Del(ctx, key).Err()
+	h.svc.ClearBindingCache(ctx, harukiUserID)
+	return api.JSONResponse(c, fiber.StatusOK, "Binding verified")
+}
+
+// ================= Batch Handlers =================
+
+// BatchBindings resolves the binding of many users on one server, e.g. every
+// member of a chat group. Users whose binding is missing or hidden are
+// listed in missing.
+func (h *BindingHandler) BatchBindings(c fiber.Ctx) error {
+	ctx := context.Background()
+	var req BatchBindingRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	if _, err := utils.ParseBindingServer(req.Server); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	ids, err := normalizeBatchIDs(req.HarukiUserIDs)
+	if err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	result, err := h.svc.BatchResolveBindings(ctx, ids, req.Server, fiber.Query[bool](c, "verified", false))
+	if err != nil {
+		return api.InternalError(c)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "ok", result)
+}
+
+// ================= Admin Handlers =================
+
+// GetBindingOwners lists every Haruki user bound to a game account.
+func (h *BindingHandler) GetBindingOwners(c fiber.Ctx) error {
+	ctx := context.Background()
+	server := c.Query("server")
+	if _, err := utils.ParseBindingServer(server); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	userID := c.Query("user_id")
+	if userID == "" || !api.ValidateStringLength(userID, api.MaxUserIDLength) {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidUserID)
+	}
+	rows, err := h.svc.client.UserBinding.Query().
+		Where(userbinding.ServerEQ(server), userbinding.UserIDEQ(userID)).
+		Order(pjsk.Asc(userbinding.FieldHarukiUserID)).
+		All(ctx)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	out := make([]BindingSchema, len(rows))
+	for i, r := range rows {
+		out[i] = toBindingSchema(r)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "ok", BindingOwnersResponse{Server: server, UserID: userID, Bindings: out})
+}
+
 // ================= Route Registration =================
 
-func registerBindingRoutes(router fiber.Router, client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client) {
-	svc := NewBindingService(client, redisClient, usersClient)
+func registerBindingRoutes(router fiber.Router, client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client,
+	policy utils.SharedBindingPolicy, publisher *events.Publisher) {
+	svc := NewBindingService(client, redisClient, usersClient, policy, publisher)
 	h := NewBindingHandler(svc)
 
 	r := router.Group("/user/:haruki_user_id/binding", api.VerifyAPIAuthorization())
@@ -245,6 +452,13 @@ func registerBindingRoutes(router fiber.Router, client *pjsk.Client, redisClient
 	r.Get("/default", h.GetDefaultBinding)
 	r.Put("/default", h.SetDefaultBinding)
 	r.Delete("/default", h.DeleteDefaultBinding)
-	r.Patch("/:binding_id", h.UpdateVisibility)
+	r.Get("/resolve", h.ResolveBinding)
+	r.Put("/order", h.ReorderBindings)
+	r.Patch("/:binding_id", h.UpdateBinding)
 	r.Delete("/:binding_id", h.DeleteBinding)
+	r.Post("/:binding_id/challenge", h.IssueChallenge)
+	r.Post("/:binding_id/verify", h.VerifyBinding)
+
+	router.Post("/binding/batch", api.VerifyAPIAuthorization(), h.BatchBindings)
+	router.Get("/binding/by-game-id", api.VerifyAPIAuthorization(), requireBindingAdmin(svc), h.GetBindingOwners)
 }
@@ -2,18 +2,48 @@ package pjsk
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
 	"haruki-database/api"
+	"haruki-database/api/gamebinding"
+	"haruki-database/api/preference"
+	"haruki-database/config"
 	"haruki-database/database/schema/pjsk"
+	"haruki-database/database/schema/pjsk/alias"
 	"haruki-database/database/schema/pjsk/aliasadmin"
+	"haruki-database/database/schema/pjsk/migrate"
+	"haruki-database/database/schema/pjsk/pendingalias"
+	"haruki-database/database/schema/pjsk/predicate"
+	"haruki-database/database/schema/pjsk/userbinding"
+	"haruki-database/database/schema/pjsk/userbindinglock"
+	"haruki-database/database/schema/pjsk/userdefaultbinding"
+	"haruki-database/database/schema/pjsk/userpreference"
 	"haruki-database/database/schema/users"
+	userpref "haruki-database/database/schema/users/preference"
 	"haruki-database/utils"
+	"haruki-database/utils/events"
 	harukiRedis "haruki-database/utils/redis"
+	"math/big"
+	"strconv"
+	"time"
 
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/bytedance/sonic"
 	"github.com/gofiber/fiber/v3"
 	"github.com/redis/go-redis/v9"
 )
 
+var (
+	ErrBindingExists   = gamebinding.ErrBindingExists
+	ErrBindingShared   = gamebinding.ErrBindingShared
+	ErrBindingLimit    = gamebinding.ErrBindingLimit
+	ErrInvalidOrder    = gamebinding.ErrInvalidOrder
+	ErrServerMismatch  = errors.New("Binding server mismatch")
+	ErrBindingNotFound = gamebinding.ErrBindingNotFound
+)
+
 // ================= Context Keys =================
 
 const (
@@ -27,12 +57,25 @@ func NewAliasService(client *pjsk.Client, redisClient *redis.Client, usersClient
 	return &AliasService{client: client, redisClient: redisClient, usersClient: usersClient}
 }
 
-func NewBindingService(client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client) *BindingService {
-	return &BindingService{client: client, redisClient: redisClient, usersClient: usersClient}
+func NewBindingService(client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client,
+	policy utils.SharedBindingPolicy, publisher *events.Publisher) *BindingService {
+	return &BindingService{
+		client:      client,
+		redisClient: redisClient,
+		usersClient: usersClient,
+		preferences: preference.NewService(usersClient, redisClient, publisher),
+		policy:      policy,
+		publisher:   publisher,
+	}
 }
 
-func NewPreferenceService(client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client) *PreferenceService {
-	return &PreferenceService{client: client, redisClient: redisClient, usersClient: usersClient}
+func NewPreferenceService(client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client, publisher *events.Publisher) *PreferenceService {
+	return &PreferenceService{
+		client:      client,
+		redisClient: redisClient,
+		usersClient: usersClient,
+		store:       preference.NewService(usersClient, redisClient, publisher),
+	}
 }
 
 // ================= Handler Constructors =================
@@ -52,7 +95,13 @@ func NewPreferenceHandler(svc *PreferenceService) *PreferenceHandler {
 // ================= AliasService Methods =================
 
 func (s *AliasService) IsAdmin(ctx context.Context, harukiUserID int) (bool, error) {
-	return s.client.AliasAdmin.Query().
+	return isPJSKAdmin(ctx, s.client, harukiUserID)
+}
+
+// isPJSKAdmin reports whether the user is listed as a PJSK admin. The alias
+// admin table doubles as the admin list for binding lookups.
+func isPJSKAdmin(ctx context.Context, client *pjsk.Client, harukiUserID int) (bool, error) {
+	return client.AliasAdmin.Query().
 		Where(aliasadmin.HarukiUserIDEQ(harukiUserID)).
 		Exist(ctx)
 }
@@ -78,17 +127,644 @@ func (s *AliasService) ClearStatusCache(ctx context.Context, pendingID int64) {
 func (s *BindingService) ClearBindingCache(ctx context.Context, harukiUserID int) {
 	_ = harukiRedis.ClearAllCacheForPath(ctx, s.redisClient, CacheNSBinding, fmt.Sprintf("/pjsk/user/%d/binding", harukiUserID))
 	_ = harukiRedis.ClearAllCacheForPath(ctx, s.redisClient, CacheNSBinding, fmt.Sprintf("/pjsk/user/%d/binding/default", harukiUserID))
+	_ = harukiRedis.ClearAllCacheForPath(ctx, s.redisClient, CacheNSBinding, fmt.Sprintf("/pjsk/user/%d/binding/resolve", harukiUserID))
+	keys := make([]string, 0, 2*len(utils.BindingServers))
+	for _, server := range utils.BindingServers {
+		keys = append(keys, batchBindingCacheKey(harukiUserID, string(server), false), batchBindingCacheKey(harukiUserID, string(server), true))
+	}
+	_ = s.redisClient.Del(ctx, keys...).Err()
+}
+
+// checkSharedBinding reports whether another Haruki user has bound the game
+// account, returning ErrBindingShared when the shared binding policy forbids it.
+func (s *BindingService) checkSharedBinding(ctx context.Context, client *pjsk.Client, harukiUserID int, server, userID string) (bool, error) {
+	if s.policy == utils.SharedBindingPolicyAllow {
+		return false, nil
+	}
+	shared, err := client.UserBinding.Query().
+		Where(
+			userbinding.ServerEQ(server),
+			userbinding.UserIDEQ(userID),
+			userbinding.HarukiUserIDNEQ(harukiUserID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+	if shared && s.policy == utils.SharedBindingPolicyForbid {
+		return true, ErrBindingShared
+	}
+	return shared, nil
+}
+
+// ResolveBinding picks the binding to use for server by trying, in order: the
+// server's default binding, the only binding on the server, the global
+// default when it is on the server, and the most recently created binding on
+// the server. With verifiedOnly, unverified bindings are skipped at every
+// step. It returns nil when the user has no binding on the server.
+func (s *BindingService) ResolveBinding(ctx context.Context, harukiUserID int, server string, verifiedOnly bool) (*ResolvedBindingResponse, error) {
+	resolved, err := s.resolveBindings(ctx, []int{harukiUserID}, server, verifiedOnly)
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := resolved[harukiUserID]; ok {
+		return &r, nil
+	}
+	return nil, nil
+}
+
+// BatchResolveBindings resolves the binding of every user on server like
+// ResolveBinding, leaving out hidden bindings. Results, including misses, are
+// cached per user.
+func (s *BindingService) BatchResolveBindings(ctx context.Context, harukiUserIDs []int, server string, verifiedOnly bool) (*BatchBindingResponse, error) {
+	keys := make([]string, len(harukiUserIDs))
+	for i, id := range harukiUserIDs {
+		keys[i] = batchBindingCacheKey(id, server, verifiedOnly)
+	}
+	cached, err := harukiRedis.GetCacheMulti(ctx, s.redisClient, keys)
+	if err != nil {
+		return nil, err
+	}
+	result := &BatchBindingResponse{
+		Bindings: make(map[int]ResolvedBindingResponse, len(harukiUserIDs)),
+		Missing:  []int{},
+	}
+	var misses []int
+	for i, id := range harukiUserIDs {
+		data, ok := cached[keys[i]]
+		if !ok {
+			misses = append(misses, id)
+			continue
+		}
+		var item *ResolvedBindingResponse
+		if err := sonic.Unmarshal(data, &item); err != nil {
+			misses = append(misses, id)
+		} else if item == nil {
+			result.Missing = append(result.Missing, id)
+		} else {
+			result.Bindings[id] = *item
+		}
+	}
+	if len(misses) > 0 {
+		resolved, err := s.resolveBindings(ctx, misses, server, verifiedOnly)
+		if err != nil {
+			return nil, err
+		}
+		toCache := make(map[string]interface{}, len(misses))
+		for _, id := range misses {
+			item, ok := resolved[id]
+			if !ok || !item.Binding.Visible {
+				result.Missing = append(result.Missing, id)
+				toCache[batchBindingCacheKey(id, server, verifiedOnly)] = nil
+				continue
+			}
+			result.Bindings[id] = item
+			toCache[batchBindingCacheKey(id, server, verifiedOnly)] = item
+		}
+		_ = harukiRedis.SetCacheMulti(ctx, s.redisClient, toCache, config.Cfg.Backend.APICacheTTL)
+	}
+	return result, nil
+}
+
+// resolveBindings applies the resolution chain of ResolveBinding to every
+// user with two queries. Users without a binding on server are left out.
+func (s *BindingService) resolveBindings(ctx context.Context, harukiUserIDs []int, server string, verifiedOnly bool) (map[int]ResolvedBindingResponse, error) {
+	defaults, err := s.client.UserDefaultBinding.Query().
+		Where(
+			userdefaultbinding.HarukiUserIDIn(harukiUserIDs...),
+			userdefaultbinding.ServerIn(server, string(utils.DefaultBindingServerDefault)),
+		).
+		WithBinding().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	q := s.client.UserBinding.Query().
+		Where(userbinding.HarukiUserIDIn(harukiUserIDs...), userbinding.ServerEQ(server))
+	if verifiedOnly {
+		q = q.Where(userbinding.Verified(true))
+	}
+	rows, err := q.Order(pjsk.Desc(userbinding.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	serverDefaults := make(map[int]*pjsk.UserBinding)
+	globalDefaults := make(map[int]*pjsk.UserBinding)
+	for _, d := range defaults {
+		if d.Edges.Binding == nil || (verifiedOnly && !d.Edges.Binding.Verified) {
+			continue
+		}
+		if d.Server == server {
+			serverDefaults[d.HarukiUserID] = d.Edges.Binding
+		} else {
+			globalDefaults[d.HarukiUserID] = d.Edges.Binding
+		}
+	}
+	byUser := make(map[int][]*pjsk.UserBinding)
+	for _, r := range rows {
+		byUser[r.HarukiUserID] = append(byUser[r.HarukiUserID], r)
+	}
+	result := make(map[int]ResolvedBindingResponse, len(harukiUserIDs))
+	for _, id := range harukiUserIDs {
+		binding, rule := pickBinding(server, serverDefaults[id], globalDefaults[id], byUser[id])
+		if binding == nil {
+			continue
+		}
+		b := toBindingSchema(binding)
+		b.IsDefault = serverDefaults[id] != nil && serverDefaults[id].ID == b.ID
+		b.IsGlobalDefault = globalDefaults[id] != nil && globalDefaults[id].ID == b.ID
+		result[id] = ResolvedBindingResponse{Binding: b, Rule: rule}
+	}
+	return result, nil
+}
+
+// defaultVisibility returns the visibility of new bindings from the
+// binding_default_visible preference. Bindings stay hidden unless the user
+// opted in, as they were before the preference existed.
+func (s *BindingService) defaultVisibility(ctx context.Context, harukiUserID int) (*bool, error) {
+	hidden := false
+	e, found, err := s.preferences.Resolve(ctx, harukiUserID, utils.GamePJSK, "", PreferenceBindingDefaultVisible)
+	if err != nil || !found {
+		return &hidden, err
+	}
+	visible, err := strconv.ParseBool(e.Value)
+	if err != nil {
+		return &hidden, nil
+	}
+	return &visible, nil
+}
+
+// AddBinding appends a binding after the user's other bindings on server.
+// Without an explicit visibility the binding_default_visible preference
+// applies. It returns ErrBindingExists, ErrBindingShared or ErrBindingLimit
+// when the binding cannot be created, and whether the account is shared.
+// With isDefault the binding also becomes the default of its server in the
+// same transaction.
+func (s *BindingService) AddBinding(ctx context.Context, harukiUserID int, server, userID string, label *string, visible *bool, isDefault bool) (*pjsk.UserBinding, bool, error) {
+	if visible == nil {
+		var err error
+		if visible, err = s.defaultVisibility(ctx, harukiUserID); err != nil {
+			return nil, false, err
+		}
+	}
+	var row *pjsk.UserBinding
+	var shared bool
+	err := withTx(ctx, s.client, func(tx *pjsk.Tx) error {
+		var err error
+		row, shared, err = s.addBinding(ctx, tx.Client(), harukiUserID, server, userID, label, visible)
+		if err != nil || !isDefault {
+			return err
+		}
+		return upsertDefaultBinding(ctx, tx, harukiUserID, utils.DefaultBindingServer(server), row.ID)
+	})
+	if err != nil {
+		return nil, shared, err
+	}
+	s.publish(ctx, utils.EventBindingCreated, harukiUserID, row.Server, row.ID)
+	if isDefault {
+		s.publish(ctx, utils.EventDefaultBindingChanged, harukiUserID, row.Server, row.ID)
+	}
+	return row, shared, nil
+}
+
+// addBinding runs the checks and the insert of AddBinding on client, which
+// must be bound to a transaction. A transaction alone does not stop two adds
+// from both passing the limit check under read committed or repeatable read,
+// so the user's lock row is upserted first: the upsert holds its row lock
+// until the transaction ends, making concurrent adds of the user wait and
+// then count the rows committed meanwhile.
+func (s *BindingService) addBinding(ctx context.Context, client *pjsk.Client, harukiUserID int, server, userID string, label *string, visible *bool) (*pjsk.UserBinding, bool, error) {
+	if err := client.UserBindingLock.Create().
+		SetHarukiUserID(harukiUserID).
+		SetLockedAt(time.Now()).
+		OnConflictColumns(userbindinglock.FieldHarukiUserID).
+		UpdateLockedAt().
+		Exec(ctx); err != nil {
+		return nil, false, err
+	}
+	exists, err := client.UserBinding.Query().
+		Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.ServerEQ(server), userbinding.UserIDEQ(userID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if exists {
+		return nil, false, ErrBindingExists
+	}
+	shared, err := s.checkSharedBinding(ctx, client, harukiUserID, server, userID)
+	if err != nil {
+		return nil, shared, err
+	}
+	count, sortOrder, err := serverBindingStats(ctx, client, harukiUserID, server)
+	if err != nil {
+		return nil, shared, err
+	}
+	if limit := maxBindings(server); limit > 0 && count >= limit {
+		return nil, shared, ErrBindingLimit
+	}
+	row, err := client.UserBinding.Create().
+		SetHarukiUserID(harukiUserID).
+		SetServer(server).
+		SetUserID(userID).
+		SetNillableVisible(visible).
+		SetNillableLabel(emptyToNil(label)).
+		SetSortOrder(sortOrder).
+		Save(ctx)
+	if err != nil {
+		return nil, shared, err
+	}
+	return row, shared, nil
+}
+
+// UpdateBinding changes the visibility and label of a binding. An empty
+// label clears it.
+func (s *BindingService) UpdateBinding(ctx context.Context, harukiUserID, bindingID int, req *UpdateBindingRequest) error {
+	binding, err := s.client.UserBinding.Query().
+		Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.IDEQ(bindingID)).
+		Only(ctx)
+	if pjsk.IsNotFound(err) {
+		return ErrBindingNotFound
+	}
+	if err != nil {
+		return err
+	}
+	upd := binding.Update().SetNillableVisible(req.Visible)
+	if req.Label != nil {
+		if *req.Label == "" {
+			upd.ClearLabel()
+		} else {
+			upd.SetLabel(*req.Label)
+		}
+	}
+	if err := upd.Exec(ctx); err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventBindingUpdated, harukiUserID, binding.Server, binding.ID)
+	return nil
+}
+
+// VerifyBinding marks a binding whose challenge code was found as verified.
+func (s *BindingService) VerifyBinding(ctx context.Context, binding *pjsk.UserBinding) error {
+	if err := binding.Update().SetVerified(true).SetVerifiedAt(time.Now()).Exec(ctx); err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventBindingVerified, binding.HarukiUserID, binding.Server, binding.ID)
+	return nil
+}
+
+// ListBindings returns the user's bindings in display order, limited to server
+// when it is not empty and to the rows matching every extra predicate.
+func (s *BindingService) ListBindings(ctx context.Context, harukiUserID int, server string, ps ...predicate.UserBinding) ([]*pjsk.UserBinding, error) {
+	q := s.client.UserBinding.Query().Where(userbinding.HarukiUserIDEQ(harukiUserID))
+	if server != "" {
+		q = q.Where(userbinding.ServerEQ(server))
+	}
+	return q.
+		Where(ps...).
+		Order(pjsk.Asc(userbinding.FieldServer), pjsk.Asc(userbinding.FieldSortOrder), pjsk.Asc(userbinding.FieldID)).
+		All(ctx)
+}
+
+// serverBindingStats returns how many bindings the user has on server and the
+// sort position for a new binding appended after them.
+func serverBindingStats(ctx context.Context, client *pjsk.Client, harukiUserID int, server string) (int, int, error) {
+	rows, err := client.UserBinding.Query().
+		Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.ServerEQ(server)).
+		All(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	next := 0
+	for _, r := range rows {
+		next = max(next, r.SortOrder+1)
+	}
+	return len(rows), next, nil
+}
+
+// defaultBindingIDs returns the ids of the user's per-server default bindings
+// and the id of the global default binding, or 0 when none is set.
+func (s *BindingService) defaultBindingIDs(ctx context.Context, harukiUserID int) (map[int]bool, int, error) {
+	rows, err := s.client.UserDefaultBinding.Query().
+		Where(userdefaultbinding.HarukiUserIDEQ(harukiUserID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	serverDefaults := make(map[int]bool, len(rows))
+	globalDefault := 0
+	for _, r := range rows {
+		if r.Server == string(utils.DefaultBindingServerDefault) {
+			globalDefault = r.BindingID
+		} else {
+			serverDefaults[r.BindingID] = true
+		}
+	}
+	return serverDefaults, globalDefault, nil
+}
+
+func (s *BindingService) ReorderBindings(ctx context.Context, harukiUserID int, req *ReorderBindingsRequest) error {
+	err := withTx(ctx, s.client, func(tx *pjsk.Tx) error {
+		ids, err := tx.UserBinding.Query().
+			Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.ServerEQ(req.Server)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 || len(ids) != len(req.BindingIDs) {
+			return ErrInvalidOrder
+		}
+		owned := make(map[int]bool, len(ids))
+		for _, id := range ids {
+			owned[id] = true
+		}
+		for i, id := range req.BindingIDs {
+			if !owned[id] {
+				return ErrInvalidOrder
+			}
+			delete(owned, id)
+			if err := tx.UserBinding.UpdateOneID(id).SetSortOrder(i).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventBindingReordered, harukiUserID, req.Server, 0)
+	return nil
+}
+
+// SetDefaultBinding points the user's default of server at the binding,
+// replacing any previous default in place. The server default must name a
+// binding of the same server; the global "default" may name any binding.
+func (s *BindingService) SetDefaultBinding(ctx context.Context, harukiUserID int, server utils.DefaultBindingServer, bindingID int) error {
+	err := withTx(ctx, s.client, func(tx *pjsk.Tx) error {
+		binding, err := tx.UserBinding.Query().
+			Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.IDEQ(bindingID)).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if server != utils.DefaultBindingServerDefault && binding.Server != string(server) {
+			return ErrServerMismatch
+		}
+		return upsertDefaultBinding(ctx, tx, harukiUserID, server, bindingID)
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventDefaultBindingChanged, harukiUserID, string(server), bindingID)
+	return nil
+}
+
+// ClearDefaultBinding removes the user's default of server, if any. An event
+// is only published when a default was removed.
+func (s *BindingService) ClearDefaultBinding(ctx context.Context, harukiUserID int, server utils.DefaultBindingServer) error {
+	count, err := s.client.UserDefaultBinding.Delete().
+		Where(userdefaultbinding.HarukiUserIDEQ(harukiUserID), userdefaultbinding.ServerEQ(string(server))).
+		Exec(ctx)
+	if err != nil || count == 0 {
+		return err
+	}
+	s.publish(ctx, utils.EventDefaultBindingChanged, harukiUserID, string(server), 0)
+	return nil
+}
+
+// DeleteBinding removes a binding together with the defaults pointing at it.
+// The foreign key cascades the defaults as well; they are deleted explicitly
+// so databases without enforced foreign keys stay consistent.
+func (s *BindingService) DeleteBinding(ctx context.Context, harukiUserID, bindingID int) error {
+	var server string
+	err := withTx(ctx, s.client, func(tx *pjsk.Tx) error {
+		binding, err := tx.UserBinding.Query().
+			Where(userbinding.HarukiUserIDEQ(harukiUserID), userbinding.IDEQ(bindingID)).
+			Only(ctx)
+		if pjsk.IsNotFound(err) {
+			return ErrBindingNotFound
+		}
+		if err != nil {
+			return err
+		}
+		server = binding.Server
+		if _, err := tx.UserDefaultBinding.Delete().
+			Where(userdefaultbinding.HarukiUserIDEQ(harukiUserID), userdefaultbinding.BindingIDEQ(bindingID)).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return tx.UserBinding.DeleteOneID(bindingID).Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(ctx, utils.EventBindingDeleted, harukiUserID, server, bindingID)
+	return nil
+}
+
+// publish sends a change event for the PJSK bindings of the user. Server and
+// bindingID are left out of the event when empty.
+func (s *BindingService) publish(ctx context.Context, eventType utils.EventType, harukiUserID int, server string, bindingID int) {
+	s.publisher.Publish(ctx, eventType, events.Event{
+		Game:         string(utils.GamePJSK),
+		HarukiUserID: harukiUserID,
+		Server:       server,
+		BindingID:    bindingID,
+	})
+}
+
+// upsertDefaultBinding points the user's default of server at bindingID,
+// replacing any previous default in place.
+func upsertDefaultBinding(ctx context.Context, tx *pjsk.Tx, harukiUserID int, server utils.DefaultBindingServer, bindingID int) error {
+	return tx.UserDefaultBinding.Create().
+		SetHarukiUserID(harukiUserID).
+		SetServer(string(server)).
+		SetBindingID(bindingID).
+		OnConflictColumns(userdefaultbinding.FieldHarukiUserID, userdefaultbinding.FieldServer).
+		UpdateBindingID().
+		Exec(ctx)
+}
+
+func withTx(ctx context.Context, client *pjsk.Client, fn func(tx *pjsk.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// maxBindings returns the configured binding limit of server, falling back to
+// the "default" entry. Zero means unlimited.
+func maxBindings(server string) int {
+	limits := config.Cfg.PJSK.MaxBindingsPerServer
+	if limit, ok := limits[server]; ok {
+		return limit
+	}
+	return limits[string(utils.DefaultBindingServerDefault)]
+}
+
+func (s *BindingService) getOwnedBinding(ctx context.Context, c fiber.Ctx, harukiUserID int) (*pjsk.UserBinding, error) {
+	return s.client.UserBinding.Query().
+		Where(
+			userbinding.HarukiUserIDEQ(harukiUserID),
+			userbinding.IDEQ(fiber.Params[int](c, "binding_id", 0)),
+		).
+		Only(ctx)
 }
 
 // ================= PreferenceService Methods =================
 
-func (s *PreferenceService) ClearCache(ctx context.Context, harukiUserID int, option string) {
-	_ = harukiRedis.ClearAllCacheForPath(ctx, s.redisClient, CacheNSPreference, fmt.Sprintf("/pjsk/user/%d/preference", harukiUserID))
-	if option != "" {
-		_ = harukiRedis.ClearAllCacheForPath(ctx, s.redisClient, CacheNSPreference, fmt.Sprintf("/pjsk/user/%d/preference/%s", harukiUserID, option))
+func toUserPreference(e preference.Effective) UserPreferenceSchema {
+	return UserPreferenceSchema{
+		Option:    e.Option,
+		Value:     e.Value,
+		IsDefault: e.Source == preference.SourceDefault,
+		Source:    e.Source,
 	}
 }
 
+// List returns the effective preferences of the user for PJSK, resolved
+// through group, when non-empty, the pjsk game scope and the global scope.
+func (s *PreferenceService) List(ctx context.Context, harukiUserID int, group string) ([]UserPreferenceSchema, error) {
+	rows, err := s.store.ResolveAll(ctx, harukiUserID, utils.GamePJSK, group)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]UserPreferenceSchema, len(rows))
+	for i, r := range rows {
+		out[i] = toUserPreference(r)
+	}
+	return out, nil
+}
+
+// CreateSchema creates or upgrades the PJSK schema. The alias and pending
+// alias tables only got their unique (alias_type, alias_type_id, alias)
+// indexes later, so rows duplicated before then would make adding them fail.
+// The tables are created without those indexes first, duplicates are removed
+// keeping the oldest row, and the full schema is applied last.
+func CreateSchema(ctx context.Context, client *pjsk.Client) error {
+	tables := make([]*schema.Table, len(migrate.Tables))
+	for i, t := range migrate.Tables {
+		if t == migrate.AliasTable || t == migrate.PendingAliasTable {
+			withoutIndexes := *t
+			withoutIndexes.Indexes = nil
+			t = &withoutIndexes
+		}
+		tables[i] = t
+	}
+	if err := migrate.Create(ctx, client.Schema, tables); err != nil {
+		return err
+	}
+	if err := dedupeAliases(ctx, client); err != nil {
+		return err
+	}
+	return client.Schema.Create(ctx)
+}
+
+// aliasGroup is one (alias_type, alias_type_id, alias) group of an alias
+// table with its row count and oldest id.
+type aliasGroup struct {
+	AliasType   string `json:"alias_type"`
+	AliasTypeID int    `json:"alias_type_id"`
+	Alias       string `json:"alias"`
+	Count       int    `json:"count"`
+	KeepID      int64  `json:"keep_id"`
+}
+
+func aliasGroupAggregates(s *entsql.Selector) string {
+	return entsql.As(entsql.Count("*"), "count") + ", " + entsql.As(entsql.Min(s.C("id")), "keep_id")
+}
+
+// dedupeAliases deletes every row of the alias and pending alias tables but
+// the oldest of its group. Groups are formed by the database, so its
+// collation decides which aliases are equal, as it does for the index.
+func dedupeAliases(ctx context.Context, client *pjsk.Client) error {
+	var groups []aliasGroup
+	if err := client.Alias.Query().
+		GroupBy(alias.FieldAliasType, alias.FieldAliasTypeID, alias.FieldAlias).
+		Aggregate(aliasGroupAggregates).
+		Scan(ctx, &groups); err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if g.Count <= 1 {
+			continue
+		}
+		if _, err := client.Alias.Delete().
+			Where(
+				alias.AliasTypeEQ(g.AliasType),
+				alias.AliasTypeIDEQ(g.AliasTypeID),
+				alias.AliasEQ(g.Alias),
+				alias.IDNEQ(g.KeepID),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	groups = nil
+	if err := client.PendingAlias.Query().
+		GroupBy(pendingalias.FieldAliasType, pendingalias.FieldAliasTypeID, pendingalias.FieldAlias).
+		Aggregate(aliasGroupAggregates).
+		Scan(ctx, &groups); err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if g.Count <= 1 {
+			continue
+		}
+		if _, err := client.PendingAlias.Delete().
+			Where(
+				pendingalias.AliasTypeEQ(g.AliasType),
+				pendingalias.AliasTypeIDEQ(g.AliasTypeID),
+				pendingalias.AliasEQ(g.Alias),
+				pendingalias.IDNEQ(g.KeepID),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MigratePreferences moves the preferences stored in the PJSK database into
+// the pjsk game scope of the shared preference store. Values already present
+// in the store win, and migrated rows are removed so later runs are no-ops.
+// Legacy option names are then renamed by preference.MigrateLegacyOptions.
+func MigratePreferences(ctx context.Context, client *pjsk.Client, usersClient *users.Client) error {
+	rows, err := client.UserPreference.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return preference.MigrateLegacyOptions(ctx, usersClient)
+	}
+	scope := preference.GameScope(utils.GamePJSK)
+	builders := make([]*users.PreferenceCreate, len(rows))
+	ids := make([]int, len(rows))
+	for i, r := range rows {
+		builders[i] = usersClient.Preference.Create().
+			SetHarukiUserID(r.HarukiUserID).
+			SetScope(string(scope.Kind)).
+			SetScopeKey(scope.Key).
+			SetOption(r.Option).
+			SetValue(r.Value)
+		ids[i] = r.ID
+	}
+	if err := usersClient.Preference.CreateBulk(builders...).
+		OnConflictColumns(userpref.FieldHarukiUserID, userpref.FieldScope, userpref.FieldScopeKey, userpref.FieldOption).
+		DoNothing().
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := client.UserPreference.Delete().Where(userpreference.IDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	return preference.MigrateLegacyOptions(ctx, usersClient)
+}
+
 // ================= Alias Middleware =================
 
 func parseAliasParams(requireID bool, requireAlias bool) fiber.Handler {
@@ -160,6 +836,23 @@ func requireAliasAdmin(svc *AliasService) fiber.Handler {
 	}
 }
 
+func requireBindingAdmin(svc *BindingService) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		harukiUserID := api.GetHarukiUserIDFromQuery(c)
+		if harukiUserID <= 0 {
+			return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid or missing haruki_user_id")
+		}
+		ok, err := isPJSKAdmin(context.Background(), svc.client, harukiUserID)
+		if err != nil {
+			return api.InternalError(c)
+		}
+		if !ok {
+			return api.JSONResponse(c, fiber.StatusForbidden, api.ErrPermissionDenied)
+		}
+		return c.Next()
+	}
+}
+
 // ================= Context Getters =================
 
 func getAliasParams(c fiber.Ctx) *AliasParams {
@@ -176,6 +869,108 @@ func getGroupAliasParams(c fiber.Ctx) *GroupAliasParams {
 	return nil
 }
 
+// ================= Binding Helpers =================
+
+func toBindingSchema(r *pjsk.UserBinding) BindingSchema {
+	return BindingSchema{
+		ID:           r.ID,
+		HarukiUserID: r.HarukiUserID,
+		Server:       r.Server,
+		UserID:       r.UserID,
+		Visible:      r.Visible,
+		Verified:     r.Verified,
+		VerifiedAt:   r.VerifiedAt,
+		Label:        r.Label,
+		SortOrder:    r.SortOrder,
+	}
+}
+
+// pickBinding applies the resolution chain to one user. rows must hold the
+// user's bindings on server, newest first.
+func pickBinding(server string, serverDefault, globalDefault *pjsk.UserBinding, rows []*pjsk.UserBinding) (*pjsk.UserBinding, string) {
+	switch {
+	case serverDefault != nil:
+		return serverDefault, ResolveRuleServerDefault
+	case len(rows) == 0:
+		return nil, ""
+	case len(rows) == 1:
+		return rows[0], ResolveRuleOnlyBinding
+	case globalDefault != nil && globalDefault.Server == server:
+		return globalDefault, ResolveRuleGlobalDefault
+	default:
+		return rows[0], ResolveRuleLatestBinding
+	}
+}
+
+func normalizeBatchIDs(ids []int) ([]int, error) {
+	maxSize := config.Cfg.PJSK.BindingBatchMaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultBindingBatchMaxSize
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("haruki_user_ids required")
+	}
+	if len(ids) > maxSize {
+		return nil, fmt.Errorf("too many haruki_user_ids, max %d", maxSize)
+	}
+	seen := make(map[int]bool, len(ids))
+	result := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, fmt.Errorf("invalid haruki_user_id: %d", id)
+		}
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+// batchBindingCacheKey keys the batch result of one user on one server.
+func batchBindingCacheKey(harukiUserID int, server string, verifiedOnly bool) string {
+	key := fmt.Sprintf("%s:batch:%d:%s", CacheNSBinding, harukiUserID, server)
+	if verifiedOnly {
+		key += ":verified"
+	}
+	return key
+}
+
+// maskBinding strips a binding shown to a third party down to its public
+// fields: the id, label and verification time are dropped and the game
+// user_id keeps only its first and last two characters. Verified stays, as
+// it only tells whether the account is proven to be the owner's.
+func maskBinding(b *BindingSchema) {
+	b.ID = 0
+	b.Label = nil
+	b.VerifiedAt = nil
+	b.UserID = api.MaskAccountID(b.UserID)
+}
+
+// markDefaults sets the default flags of b from the ids returned by
+// BindingService.defaultBindingIDs.
+func markDefaults(b *BindingSchema, serverDefaults map[int]bool, globalDefault int) {
+	b.IsDefault = serverDefaults[b.ID]
+	b.IsGlobalDefault = b.ID == globalDefault
+}
+
+func emptyToNil(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
+
+func generateChallengeCode() string {
+	const charset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	code := make([]byte, BindingChallengeLength)
+	for i := range code {
+		n, _ := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+		code[i] = charset[n.Int64()]
+	}
+	return BindingChallengePrefix + string(code)
+}
+
 // ================= Extract Helpers =================
 
 func extractAliasTypeIDs(rows []*pjsk.GroupAlias) []int {
@@ -2,11 +2,14 @@ package pjsk
 
 import (
 	"context"
+	"fmt"
 	"haruki-database/api"
+	"haruki-database/api/preference"
 	"haruki-database/config"
 	"haruki-database/database/schema/pjsk"
-	"haruki-database/database/schema/pjsk/userpreference"
 	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/redis/go-redis/v9"
@@ -14,12 +17,28 @@ import (
 
 // ================= Preference Handlers =================
 
+// parseGroupQuery reads the optional group whose scope reads resolve through.
+func parseGroupQuery(c fiber.Ctx) (string, error) {
+	group := c.Query("group")
+	if group == "" {
+		return "", nil
+	}
+	if _, err := preference.GroupScope(group); err != nil {
+		return "", err
+	}
+	return group, nil
+}
+
 func (h *PreferenceHandler) GetAll(c fiber.Ctx) error {
 	ctx := context.Background()
 	harukiUserID := api.GetHarukiUserIDFromPath(c)
 	if harukiUserID <= 0 {
 		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
 	}
+	group, err := parseGroupQuery(c)
+	if err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
 	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSPreference)
 	if err != nil {
 		return api.InternalError(c)
@@ -27,19 +46,13 @@ func (h *PreferenceHandler) GetAll(c fiber.Ctx) error {
 	if hit {
 		return c.Status(fiber.StatusOK).JSON(cached)
 	}
-	rows, err := h.svc.client.UserPreference.Query().
-		Where(userpreference.HarukiUserIDEQ(harukiUserID)).
-		All(ctx)
+	out, err := h.svc.List(ctx, harukiUserID, group)
 	if err != nil {
 		return api.InternalError(c)
 	}
-	if len(rows) == 0 {
+	if len(out) == 0 {
 		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrPreferenceNotFound)
 	}
-	out := make([]UserPreferenceSchema, len(rows))
-	for i, r := range rows {
-		out[i] = UserPreferenceSchema{Option: r.Option, Value: r.Value}
-	}
 	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", UserPreferenceResponse{Options: out})
 }
 func (h *PreferenceHandler) Get(c fiber.Ctx) error {
@@ -49,6 +62,10 @@ func (h *PreferenceHandler) Get(c fiber.Ctx) error {
 		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
 	}
 	option := c.Params("option")
+	group, err := parseGroupQuery(c)
+	if err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
 	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNSPreference)
 	if err != nil {
 		return api.InternalError(c)
@@ -56,14 +73,16 @@ func (h *PreferenceHandler) Get(c fiber.Ctx) error {
 	if hit {
 		return c.Status(fiber.StatusOK).JSON(cached)
 	}
-	row, err := h.svc.client.UserPreference.Query().
-		Where(userpreference.HarukiUserIDEQ(harukiUserID), userpreference.OptionEQ(option)).
-		First(ctx)
+	e, found, err := h.svc.store.Resolve(ctx, harukiUserID, utils.GamePJSK, group, option)
 	if err != nil {
+		return api.InternalError(c)
+	}
+	if !found {
 		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrPreferenceNotFound)
 	}
+	pref := toUserPreference(e)
 	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", UserPreferenceResponse{
-		Option: &UserPreferenceSchema{Option: row.Option, Value: row.Value},
+		Option: &pref,
 	})
 }
 func (h *PreferenceHandler) Update(c fiber.Ctx) error {
@@ -77,24 +96,46 @@ func (h *PreferenceHandler) Update(c fiber.Ctx) error {
 	if err := c.Bind().Body(&body); err != nil {
 		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
 	}
-	rows, err := h.svc.client.UserPreference.Update().
-		Where(userpreference.HarukiUserIDEQ(harukiUserID), userpreference.OptionEQ(option)).
-		SetValue(body.Value).
-		Save(ctx)
-	if err != nil {
+	if err := h.svc.store.Set(ctx, harukiUserID, preference.GameScope(utils.GamePJSK), option, body.Value); err != nil {
+		if api.IsValidationError(err) {
+			return api.ValidationErrorResponse(c, err)
+		}
 		return api.InternalError(c)
 	}
-	if rows == 0 {
-		if _, err := h.svc.client.UserPreference.Create().
-			SetHarukiUserID(harukiUserID).
-			SetOption(option).
-			SetValue(body.Value).
-			Save(ctx); err != nil {
-			return api.InternalError(c)
+	return api.JSONResponse(c, fiber.StatusOK, "Preference updated")
+}
+
+// BulkUpdate applies a map of option to value in one transaction; a null
+// value deletes the option. It returns the full updated preference set.
+func (h *PreferenceHandler) BulkUpdate(c fiber.Ctx) error {
+	ctx := context.Background()
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
+	}
+	var body BulkPreferenceRequest
+	if err := c.Bind().Body(&body); err != nil || len(body) == 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	if len(body) > preference.MaxBulkSize {
+		return api.JSONResponse(c, fiber.StatusBadRequest, fmt.Sprintf("at most %d options per request", preference.MaxBulkSize))
+	}
+	if err := h.svc.store.Apply(ctx, harukiUserID, preference.GameScope(utils.GamePJSK), body); err != nil {
+		if api.IsValidationError(err) {
+			return api.ValidationErrorResponse(c, err)
 		}
+		return api.InternalError(c)
 	}
-	h.svc.ClearCache(ctx, harukiUserID, option)
-	return api.JSONResponse(c, fiber.StatusOK, "Preference updated")
+	out, err := h.svc.List(ctx, harukiUserID, "")
+	if err != nil {
+		return api.InternalError(c)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "Preferences updated", UserPreferenceResponse{Options: out})
+}
+
+// Schema lists the registered preference options and the free-form namespace.
+func (h *PreferenceHandler) Schema(c fiber.Ctx) error {
+	return api.JSONResponse(c, fiber.StatusOK, "ok", preference.Schema())
 }
 func (h *PreferenceHandler) Delete(c fiber.Ctx) error {
 	ctx := context.Background()
@@ -103,22 +144,21 @@ func (h *PreferenceHandler) Delete(c fiber.Ctx) error {
 		return api.JSONResponse(c, fiber.StatusBadRequest, "Invalid haruki_user_id")
 	}
 	option := c.Params("option")
-	if _, err := h.svc.client.UserPreference.Delete().
-		Where(userpreference.HarukiUserIDEQ(harukiUserID), userpreference.OptionEQ(option)).
-		Exec(ctx); err != nil {
+	if err := h.svc.store.Delete(ctx, harukiUserID, preference.GameScope(utils.GamePJSK), option); err != nil {
 		return api.InternalError(c)
 	}
-	h.svc.ClearCache(ctx, harukiUserID, option)
 	return api.JSONResponse(c, fiber.StatusOK, "Preference deleted")
 }
 
 // ================= Route Registration =================
-func registerPreferenceRoutes(router fiber.Router, client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client) {
-	svc := NewPreferenceService(client, redisClient, usersClient)
+func registerPreferenceRoutes(router fiber.Router, client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client, publisher *events.Publisher) {
+	svc := NewPreferenceService(client, redisClient, usersClient, publisher)
 	h := NewPreferenceHandler(svc)
 	r := router.Group("/user/:haruki_user_id/preference", api.VerifyAPIAuthorization())
 	r.Get("/", h.GetAll)
+	r.Patch("/", h.BulkUpdate)
 	r.Get("/:option", h.Get)
 	r.Put("/:option", h.Update)
 	r.Delete("/:option", h.Delete)
+	router.Get("/preference/schema", api.VerifyAPIAuthorization(), h.Schema)
 }
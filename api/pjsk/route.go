@@ -3,14 +3,17 @@ package pjsk
 import (
 	"haruki-database/database/schema/pjsk"
 	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/redis/go-redis/v9"
 )
 
-func RegisterPJSKRoutes(app *fiber.App, client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client) {
+func RegisterPJSKRoutes(app *fiber.App, client *pjsk.Client, redisClient *redis.Client, usersClient *users.Client,
+	policy utils.SharedBindingPolicy, publisher *events.Publisher) {
 	group := app.Group("/pjsk")
 	registerAliasRoutes(group, client, redisClient, usersClient)
-	registerPreferenceRoutes(group, client, redisClient, usersClient)
-	registerBindingRoutes(group, client, redisClient, usersClient)
+	registerPreferenceRoutes(group, client, redisClient, usersClient, publisher)
+	registerBindingRoutes(group, client, redisClient, usersClient, policy, publisher)
 }
@@ -1,8 +1,11 @@
 package pjsk
 
 import (
+	"haruki-database/api/preference"
 	"haruki-database/database/schema/pjsk"
 	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
 	"haruki-database/utils/types"
 
 	"github.com/redis/go-redis/v9"
@@ -18,17 +21,61 @@ type PendingAlias = types.PJSKPendingAlias
 
 type UserPreferenceSchema = types.PJSKPreference
 type UserPreferenceResponse = types.PJSKPreferenceResponse
+type BulkPreferenceRequest = types.BulkPreferenceRequest
 
 type BindingSchema = types.PJSKBinding
 type BindingResponse = types.PJSKBindingResponse
 type AddBindingSuccessResponse = types.PJSKAddBindingResponse
+type BindingOwnersResponse = types.PJSKBindingOwnersResponse
+type BindingChallengeResponse = types.PJSKBindingChallengeResponse
+type VerifyBindingRequest = types.PJSKVerifyBindingRequest
+type UpdateBindingRequest = types.PJSKUpdateBindingRequest
+type ResolvedBindingResponse = types.PJSKResolvedBindingResponse
+type BatchBindingRequest = types.PJSKBatchBindingRequest
+type BatchBindingResponse = types.PJSKBatchBindingResponse
+type ReorderBindingsRequest = types.PJSKReorderBindingsRequest
 
 // ================= Cache Namespace Constants =================
 
 const (
 	CacheNSAlias      = "hdb:pjsk:alias"
 	CacheNSBinding    = "hdb:pjsk:binding"
-	CacheNSPreference = "hdb:pjsk:preference"
+	CacheNSPreference = preference.CacheNS
+)
+
+// ================= Binding Constants =================
+
+const MaxBindingLabelLength = 50
+
+// PreferenceBindingDefaultVisible is the preference option holding the
+// visibility ("true" or "false") of bindings created without one.
+const PreferenceBindingDefaultVisible = preference.OptionBindingDefaultVisible
+
+const DefaultBindingBatchMaxSize = 200
+
+// Rules of the default binding resolution chain, in the order they are tried.
+const (
+	ResolveRuleServerDefault = "server_default"
+	ResolveRuleOnlyBinding   = "only_binding"
+	ResolveRuleGlobalDefault = "global_default"
+	ResolveRuleLatestBinding = "latest_binding"
+)
+
+// ================= Binding Verification =================
+
+const (
+	RedisKeyBindingChallenge   = "hdb:pjsk:binding_challenge:%d"
+	BindingChallengeTTLMinutes = 30
+	BindingChallengeLength     = 8
+	BindingChallengePrefix     = "HARUKI-"
+	MaxChallengeProfileText    = 1000
+)
+
+const (
+	ErrChallengeNotFound  = "challenge code not found or expired"
+	ErrChallengeMismatch  = "challenge code not found in profile"
+	ErrBindingVerified    = "binding already verified"
+	ErrProfileTextTooLong = "profile_text is too long"
 )
 
 // ================= Parameter Structs =================
@@ -57,12 +104,23 @@ type BindingService struct {
 	client      *pjsk.Client
 	redisClient *redis.Client
 	usersClient *users.Client
+	preferences *preference.Service
+	policy      utils.SharedBindingPolicy
+	publisher   *events.Publisher
 }
 
+// PreferenceService serves the PJSK preference endpoints from the shared
+// preference store, scoped to the pjsk game.
 type PreferenceService struct {
 	client      *pjsk.Client
 	redisClient *redis.Client
 	usersClient *users.Client
+	store       *preference.Service
+}
+
+// bindingStore serves PJSK bindings to the generic game binding API.
+type bindingStore struct {
+	svc *BindingService
 }
 
 // ================= Handler Structs =================
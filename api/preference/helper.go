@@ -0,0 +1,372 @@
+package preference
+
+import (
+	"context"
+	"fmt"
+	"haruki-database/api"
+	"haruki-database/database/schema/users"
+	"haruki-database/database/schema/users/predicate"
+	userpref "haruki-database/database/schema/users/preference"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
+	harukiRedis "haruki-database/utils/redis"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func NewService(client *users.Client, redisClient *redis.Client, publisher *events.Publisher) *Service {
+	return &Service{client: client, redisClient: redisClient, publisher: publisher}
+}
+
+func NewHandler(svc *Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ================= Scopes =================
+
+func GlobalScope() Scope {
+	return Scope{Kind: utils.PreferenceScopeGlobal}
+}
+
+func GameScope(game utils.Game) Scope {
+	return Scope{Kind: utils.PreferenceScopeGame, Key: string(game)}
+}
+
+// GroupScope validates group as platform:group_id and returns its scope.
+func GroupScope(group string) (Scope, error) {
+	platform, groupID, ok := strings.Cut(group, ":")
+	if !ok || platform == "" || groupID == "" ||
+		!api.ValidateStringLength(platform, api.MaxPlatformLength) ||
+		!api.ValidateStringLength(group, MaxGroupKeyLength) {
+		return Scope{}, &api.ValidationError{Code: api.ErrCodeInvalidScope, Message: "group must be platform:group_id"}
+	}
+	return Scope{Kind: utils.PreferenceScopeGroup, Key: group}, nil
+}
+
+// ParseScope builds the scope named by kind, taking its key from game or
+// group as the kind requires.
+func ParseScope(kind string, game string, group string) (Scope, error) {
+	scope, err := utils.ParsePreferenceScope(kind)
+	if err != nil {
+		return Scope{}, &api.ValidationError{Code: api.ErrCodeInvalidScope, Message: err.Error()}
+	}
+	switch scope {
+	case utils.PreferenceScopeGame:
+		g, err := utils.ParseGame(game)
+		if err != nil {
+			return Scope{}, &api.ValidationError{Code: api.ErrCodeInvalidScope, Message: err.Error()}
+		}
+		return GameScope(g), nil
+	case utils.PreferenceScopeGroup:
+		return GroupScope(group)
+	default:
+		return GlobalScope(), nil
+	}
+}
+
+// scopeRank orders scopes by resolution priority, highest first.
+func scopeRank(scope utils.PreferenceScope) int {
+	switch scope {
+	case utils.PreferenceScopeGroup:
+		return 3
+	case utils.PreferenceScopeGame:
+		return 2
+	case utils.PreferenceScopeGlobal:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ================= Registry =================
+
+func Lookup(option string) (Definition, bool) {
+	for _, def := range Registry {
+		if def.Name == option {
+			return def, true
+		}
+	}
+	return Definition{}, false
+}
+
+func isFreeForm(option string) bool {
+	return strings.HasPrefix(option, FreeFormPrefix) && len(option) > len(FreeFormPrefix)
+}
+
+// Validate checks value against the registered definition of option.
+// Free-form options only have their lengths checked.
+func Validate(option string, value string) error {
+	if !api.ValidateStringLength(option, api.MaxOptionLength) {
+		return &api.ValidationError{Code: api.ErrCodeUnknownPreference, Message: "invalid option"}
+	}
+	if !api.ValidateStringLength(value, api.MaxValueLength) {
+		return &api.ValidationError{Code: api.ErrCodeInvalidPreference, Message: fmt.Sprintf("value of %s must be at most %d characters", option, api.MaxValueLength)}
+	}
+	if isFreeForm(option) {
+		return nil
+	}
+	def, ok := Lookup(option)
+	if !ok {
+		return &api.ValidationError{
+			Code:    api.ErrCodeUnknownPreference,
+			Message: fmt.Sprintf("unknown option %s, experimental options must start with %s", option, FreeFormPrefix),
+		}
+	}
+	invalid := func(format string, args ...any) error {
+		return &api.ValidationError{Code: api.ErrCodeInvalidPreference, Message: "value of " + option + " " + fmt.Sprintf(format, args...)}
+	}
+	switch utils.PreferenceType(def.Type) {
+	case utils.PreferenceTypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return invalid("must be true or false")
+		}
+	case utils.PreferenceTypeInt:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return invalid("must be an integer")
+		}
+		if def.Min != nil && n < *def.Min {
+			return invalid("must be at least %d", *def.Min)
+		}
+		if def.Max != nil && n > *def.Max {
+			return invalid("must be at most %d", *def.Max)
+		}
+	case utils.PreferenceTypeEnum:
+		for _, allowed := range def.AllowedValues {
+			if value == allowed {
+				return nil
+			}
+		}
+		return invalid("must be one of %s", strings.Join(def.AllowedValues, ", "))
+	case utils.PreferenceTypeString:
+		if def.MaxLength > 0 && !api.ValidateStringLength(value, def.MaxLength) {
+			return invalid("must be at most %d characters", def.MaxLength)
+		}
+	}
+	return nil
+}
+
+// normalizeLegacy maps an option stored before the registry existed to the
+// registered option and value it stands for. Options or values that still do
+// not validate move to the free-form namespace under their old name. ok is
+// false when not even that name is valid.
+func normalizeLegacy(option string, value string) (string, string, bool) {
+	name := strings.ToLower(strings.TrimSpace(option))
+	if canonical, found := LegacyOptions[name]; found {
+		name = canonical
+	}
+	if def, found := Lookup(name); found {
+		v := value
+		switch utils.PreferenceType(def.Type) {
+		case utils.PreferenceTypeBool, utils.PreferenceTypeEnum:
+			v = strings.ToLower(strings.TrimSpace(value))
+		}
+		if Validate(name, v) == nil {
+			return name, v, true
+		}
+	}
+	name = FreeFormPrefix + option
+	return name, value, Validate(name, value) == nil
+}
+
+// MigrateLegacyOptions rewrites stored options that are neither registered
+// nor free-form under the names normalizeLegacy gives them, so they can be
+// updated again. When the target already exists it wins and the legacy row
+// is dropped; among several legacy rows the newest wins.
+func MigrateLegacyOptions(ctx context.Context, client *users.Client) error {
+	registered := make([]string, len(Registry))
+	for i, def := range Registry {
+		registered[i] = def.Name
+	}
+	rows, err := client.Preference.Query().
+		Where(userpref.OptionNotIn(registered...), userpref.Not(userpref.OptionHasPrefix(FreeFormPrefix))).
+		Order(users.Desc(userpref.FieldID)).
+		All(ctx)
+	if err != nil || len(rows) == 0 {
+		return err
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range rows {
+		option, value, ok := normalizeLegacy(r.Option, r.Value)
+		if !ok {
+			continue
+		}
+		exists, err := tx.Preference.Query().
+			Where(
+				userpref.HarukiUserIDEQ(r.HarukiUserID),
+				userpref.ScopeEQ(r.Scope),
+				userpref.ScopeKeyEQ(r.ScopeKey),
+				userpref.OptionEQ(option),
+			).
+			Exist(ctx)
+		if err == nil {
+			if exists {
+				err = tx.Preference.DeleteOneID(r.ID).Exec(ctx)
+			} else {
+				err = tx.Preference.UpdateOneID(r.ID).SetOption(option).SetValue(value).Exec(ctx)
+			}
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func Schema() SchemaResponse {
+	return SchemaResponse{
+		Options:           Registry,
+		FreeFormPrefix:    FreeFormPrefix,
+		FreeFormMaxLength: api.MaxValueLength,
+	}
+}
+
+// ================= Service Methods =================
+
+// ClearCache drops every cached preference response of the user, across the
+// generic and per-game preference endpoints.
+func (s *Service) ClearCache(ctx context.Context, harukiUserID int) {
+	_ = harukiRedis.ClearAllCacheForPath(ctx, s.redisClient, CacheNS, fmt.Sprintf("*/user/%d/preference*", harukiUserID))
+}
+
+// Resolve returns the effective value of option for the user, looking at the
+// group scope, then the game scope, then the global scope and finally the
+// registry default. Empty game or group skip their scope. The second return
+// is false when the option has no value anywhere.
+func (s *Service) Resolve(ctx context.Context, harukiUserID int, game utils.Game, group string, option string) (Effective, bool, error) {
+	out, err := s.resolve(ctx, harukiUserID, game, group, option)
+	if err != nil || len(out) == 0 {
+		return Effective{}, false, err
+	}
+	return out[0], true, nil
+}
+
+// ResolveAll returns the effective value of every option the user has set in
+// an applicable scope, plus the defaults of the remaining registered options.
+// Registered options come first in registry order, then the others by name.
+func (s *Service) ResolveAll(ctx context.Context, harukiUserID int, game utils.Game, group string) ([]Effective, error) {
+	return s.resolve(ctx, harukiUserID, game, group, "")
+}
+
+func (s *Service) resolve(ctx context.Context, harukiUserID int, game utils.Game, group string, option string) ([]Effective, error) {
+	scopes := []predicate.Preference{
+		userpref.And(userpref.ScopeEQ(string(utils.PreferenceScopeGlobal)), userpref.ScopeKeyEQ("")),
+	}
+	if game != "" {
+		scopes = append(scopes, userpref.And(userpref.ScopeEQ(string(utils.PreferenceScopeGame)), userpref.ScopeKeyEQ(string(game))))
+	}
+	if group != "" {
+		scopes = append(scopes, userpref.And(userpref.ScopeEQ(string(utils.PreferenceScopeGroup)), userpref.ScopeKeyEQ(group)))
+	}
+	q := s.client.Preference.Query().
+		Where(userpref.HarukiUserIDEQ(harukiUserID), userpref.Or(scopes...))
+	if option != "" {
+		q = q.Where(userpref.OptionEQ(option))
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	best := make(map[string]Effective, len(rows))
+	for _, r := range rows {
+		cur, ok := best[r.Option]
+		if ok && scopeRank(utils.PreferenceScope(cur.Source)) >= scopeRank(utils.PreferenceScope(r.Scope)) {
+			continue
+		}
+		best[r.Option] = Effective{Option: r.Option, Value: r.Value, Source: r.Scope, ScopeKey: r.ScopeKey}
+	}
+	out := make([]Effective, 0, len(best)+len(Registry))
+	for _, def := range Registry {
+		if option != "" && def.Name != option {
+			continue
+		}
+		if e, ok := best[def.Name]; ok {
+			out = append(out, e)
+			delete(best, def.Name)
+			continue
+		}
+		out = append(out, Effective{Option: def.Name, Value: def.Default, Source: SourceDefault})
+	}
+	rest := make([]Effective, 0, len(best))
+	for _, e := range best {
+		rest = append(rest, e)
+	}
+	sort.Slice(rest, func(i, j int) bool { return rest[i].Option < rest[j].Option })
+	return append(out, rest...), nil
+}
+
+// Set stores value for option in scope.
+func (s *Service) Set(ctx context.Context, harukiUserID int, scope Scope, option string, value string) error {
+	return s.Apply(ctx, harukiUserID, scope, BulkRequest{option: &value})
+}
+
+// Delete removes option from scope.
+func (s *Service) Delete(ctx context.Context, harukiUserID int, scope Scope, option string) error {
+	return s.Apply(ctx, harukiUserID, scope, BulkRequest{option: nil})
+}
+
+// Apply writes changes to scope in a single transaction, deleting options
+// mapped to nil and upserting the rest. Nothing is written if any change is
+// invalid. A committed change publishes one event listing every option.
+func (s *Service) Apply(ctx context.Context, harukiUserID int, scope Scope, changes BulkRequest) error {
+	options := make([]string, 0, len(changes))
+	for option, value := range changes {
+		if value != nil {
+			if err := Validate(option, *value); err != nil {
+				return err
+			}
+		}
+		options = append(options, option)
+	}
+	sort.Strings(options)
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	for _, option := range options {
+		if err := applyChange(ctx, tx, harukiUserID, scope, option, changes[option]); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.ClearCache(ctx, harukiUserID)
+	event := events.Event{HarukiUserID: harukiUserID, Scope: string(scope.Kind), ScopeKey: scope.Key, Options: options}
+	if scope.Kind == utils.PreferenceScopeGame {
+		event.Game = scope.Key
+	}
+	s.publisher.Publish(ctx, utils.EventPreferenceChanged, event)
+	return nil
+}
+
+func applyChange(ctx context.Context, tx *users.Tx, harukiUserID int, scope Scope, option string, value *string) error {
+	if value == nil {
+		_, err := tx.Preference.Delete().
+			Where(
+				userpref.HarukiUserIDEQ(harukiUserID),
+				userpref.ScopeEQ(string(scope.Kind)),
+				userpref.ScopeKeyEQ(scope.Key),
+				userpref.OptionEQ(option),
+			).
+			Exec(ctx)
+		return err
+	}
+	return tx.Preference.Create().
+		SetHarukiUserID(harukiUserID).
+		SetScope(string(scope.Kind)).
+		SetScopeKey(scope.Key).
+		SetOption(option).
+		SetValue(*value).
+		OnConflictColumns(userpref.FieldHarukiUserID, userpref.FieldScope, userpref.FieldScopeKey, userpref.FieldOption).
+		UpdateValue().
+		Exec(ctx)
+}
@@ -0,0 +1,173 @@
+package preference
+
+import (
+	"context"
+	"fmt"
+	"haruki-database/api"
+	"haruki-database/config"
+	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/redis/go-redis/v9"
+)
+
+// ================= Preference Handlers =================
+
+// parseResolveQuery reads the optional game and group a read resolves with.
+func parseResolveQuery(c fiber.Ctx) (utils.Game, string, error) {
+	var game utils.Game
+	if g := c.Query("game"); g != "" {
+		parsed, err := utils.ParseGame(g)
+		if err != nil {
+			return "", "", &api.ValidationError{Code: api.ErrCodeInvalidScope, Message: err.Error()}
+		}
+		game = parsed
+	}
+	group := c.Query("group")
+	if group != "" {
+		if _, err := GroupScope(group); err != nil {
+			return "", "", err
+		}
+	}
+	return game, group, nil
+}
+
+func parseScopeQuery(c fiber.Ctx) (Scope, error) {
+	return ParseScope(c.Query("scope"), c.Query("game"), c.Query("group"))
+}
+
+func (h *Handler) GetAll(c fiber.Ctx) error {
+	ctx := context.Background()
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidHarukiUserID)
+	}
+	game, group, err := parseResolveQuery(c)
+	if err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNS)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	out, err := h.svc.ResolveAll(ctx, harukiUserID, game, group)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", EffectiveResponse{Options: out})
+}
+
+func (h *Handler) Get(c fiber.Ctx) error {
+	ctx := context.Background()
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidHarukiUserID)
+	}
+	game, group, err := parseResolveQuery(c)
+	if err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+	key, cached, hit, err := api.CacheQuery(ctx, c, h.svc.redisClient, CacheNS)
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if hit {
+		return c.Status(fiber.StatusOK).JSON(cached)
+	}
+	e, found, err := h.svc.Resolve(ctx, harukiUserID, game, group, c.Params("option"))
+	if err != nil {
+		return api.InternalError(c)
+	}
+	if !found {
+		return api.JSONResponse(c, fiber.StatusNotFound, api.ErrPreferenceNotFound)
+	}
+	return api.CachedJSONResponse(ctx, c, h.svc.redisClient, config.Cfg.Backend.APICacheTTL, key, fiber.StatusOK, "ok", EffectiveResponse{Option: &e})
+}
+
+func (h *Handler) Update(c fiber.Ctx) error {
+	ctx := context.Background()
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidHarukiUserID)
+	}
+	scope, err := parseScopeQuery(c)
+	if err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+	var body ValueRequest
+	if err := c.Bind().Body(&body); err != nil {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	if err := h.svc.Set(ctx, harukiUserID, scope, c.Params("option"), body.Value); err != nil {
+		if api.IsValidationError(err) {
+			return api.ValidationErrorResponse(c, err)
+		}
+		return api.InternalError(c)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "Preference updated")
+}
+
+func (h *Handler) BulkUpdate(c fiber.Ctx) error {
+	ctx := context.Background()
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidHarukiUserID)
+	}
+	scope, err := parseScopeQuery(c)
+	if err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+	var body BulkRequest
+	if err := c.Bind().Body(&body); err != nil || len(body) == 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidRequest)
+	}
+	if len(body) > MaxBulkSize {
+		return api.JSONResponse(c, fiber.StatusBadRequest, fmt.Sprintf("at most %d options per request", MaxBulkSize))
+	}
+	if err := h.svc.Apply(ctx, harukiUserID, scope, body); err != nil {
+		if api.IsValidationError(err) {
+			return api.ValidationErrorResponse(c, err)
+		}
+		return api.InternalError(c)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "Preferences updated")
+}
+
+func (h *Handler) Delete(c fiber.Ctx) error {
+	ctx := context.Background()
+	harukiUserID := api.GetHarukiUserIDFromPath(c)
+	if harukiUserID <= 0 {
+		return api.JSONResponse(c, fiber.StatusBadRequest, api.ErrInvalidHarukiUserID)
+	}
+	scope, err := parseScopeQuery(c)
+	if err != nil {
+		return api.ValidationErrorResponse(c, err)
+	}
+	if err := h.svc.Delete(ctx, harukiUserID, scope, c.Params("option")); err != nil {
+		return api.InternalError(c)
+	}
+	return api.JSONResponse(c, fiber.StatusOK, "Preference deleted")
+}
+
+func (h *Handler) Schema(c fiber.Ctx) error {
+	return api.JSONResponse(c, fiber.StatusOK, "ok", Schema())
+}
+
+// ================= Route Registration =================
+
+func RegisterPreferenceRoutes(app *fiber.App, client *users.Client, redisClient *redis.Client, publisher *events.Publisher) {
+	svc := NewService(client, redisClient, publisher)
+	h := NewHandler(svc)
+	app.Get("/preference/schema", api.VerifyAPIAuthorization(), h.Schema)
+	r := app.Group("/user/:haruki_user_id/preference", api.VerifyAPIAuthorization())
+	r.Get("/", h.GetAll)
+	r.Patch("/", h.BulkUpdate)
+	r.Get("/:option", h.Get)
+	r.Put("/:option", h.Update)
+	r.Delete("/:option", h.Delete)
+}
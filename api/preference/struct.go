@@ -0,0 +1,90 @@
+package preference
+
+import (
+	"haruki-database/database/schema/users"
+	"haruki-database/utils"
+	"haruki-database/utils/events"
+	"haruki-database/utils/types"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ================= Type Aliases =================
+
+type Definition = types.PreferenceDefinition
+type SchemaResponse = types.PreferenceSchemaResponse
+type BulkRequest = types.BulkPreferenceRequest
+type ValueRequest = types.PreferenceValueRequest
+type Effective = types.EffectivePreference
+type EffectiveResponse = types.EffectivePreferenceResponse
+
+// ================= Cache Namespace Constants =================
+
+// CacheNS holds every cached preference response, including those of the
+// per-game preference endpoints, so a single write invalidates all of them.
+const CacheNS = "hdb:preference"
+
+// ================= Preference Constants =================
+
+const MaxBulkSize = 100
+
+const MaxGroupKeyLength = 100
+
+// Source reported for registered options no scope has a value for.
+const SourceDefault = "default"
+
+// OptionBindingDefaultVisible holds the visibility ("true" or "false") of
+// bindings created without one.
+const OptionBindingDefaultVisible = "binding_default_visible"
+
+// OptionTheme holds the color theme bots render images with.
+const OptionTheme = "theme"
+
+// ================= Preference Registry =================
+
+// FreeFormPrefix opts an option out of the registry; such options take any
+// value up to api.MaxValueLength and have no default.
+const FreeFormPrefix = "x-"
+
+// Registry lists the known preference options in schema order. Writes to
+// unregistered options without FreeFormPrefix are rejected.
+var Registry = []Definition{
+	{
+		Name:        OptionBindingDefaultVisible,
+		Type:        string(utils.PreferenceTypeBool),
+		Default:     "false",
+		Description: "Visibility of bindings created without an explicit visible flag",
+	},
+	{
+		Name:          OptionTheme,
+		Type:          string(utils.PreferenceTypeEnum),
+		AllowedValues: []string{"light", "dark", "auto"},
+		Default:       "auto",
+		Description:   "Color theme of rendered images",
+	},
+}
+
+// LegacyOptions maps option names bots wrote before the registry existed to
+// their registered names. Other legacy names are only lowercased.
+var LegacyOptions = map[string]string{
+	"theme_mode": OptionTheme,
+}
+
+// Scope identifies where a preference is stored. Key is empty for the global
+// scope, the game name for a game scope and platform:group_id for a group.
+type Scope struct {
+	Kind utils.PreferenceScope
+	Key  string
+}
+
+// ================= Service & Handler =================
+
+type Service struct {
+	client      *users.Client
+	redisClient *redis.Client
+	publisher   *events.Publisher
+}
+
+type Handler struct {
+	svc *Service
+}
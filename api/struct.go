@@ -55,8 +55,35 @@ const (
 	ErrInternalServer      = utils.ErrInternalServer
 	ErrUserBanned          = "user is banned"
 	ErrMissingPlatformInfo = "platform and platform_user_id are required"
+	ErrBindingShared       = "game account is already bound by another user"
 )
 
+// ================= Error Codes =================
+
+const (
+	ErrCodeInvalidRequest      = utils.ErrCodeInvalidRequest
+	ErrCodeInvalidHarukiUserID = utils.ErrCodeInvalidHarukiUserID
+	ErrCodeInvalidServer       = utils.ErrCodeInvalidServer
+	ErrCodeInvalidAimeID       = utils.ErrCodeInvalidAimeID
+	ErrCodeInvalidLabel        = utils.ErrCodeInvalidLabel
+	ErrCodeInvalidOrder        = utils.ErrCodeInvalidOrder
+	ErrCodeBindingShared       = utils.ErrCodeBindingShared
+	ErrCodeChallengeNotFound   = utils.ErrCodeChallengeNotFound
+	ErrCodeChallengeMismatch   = utils.ErrCodeChallengeMismatch
+	ErrCodeBindingLimit        = utils.ErrCodeBindingLimit
+	ErrCodeUnknownPreference   = utils.ErrCodeUnknownPreference
+	ErrCodeInvalidPreference   = utils.ErrCodeInvalidPreference
+	ErrCodeInvalidScope        = utils.ErrCodeInvalidScope
+	ErrCodeUnknownGame         = utils.ErrCodeUnknownGame
+	ErrCodeInvalidAccountID    = utils.ErrCodeInvalidAccountID
+)
+
+// ValidationError is a client error that carries a machine-readable code.
+type ValidationError struct {
+	Code    string
+	Message string
+}
+
 // ================= Cache Keys =================
 
 const (
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	chunithmAPI "haruki-database/api/chunithm"
+	harukiConfig "haruki-database/config"
+	chunithmMusicDB "haruki-database/database/schema/chunithm/music"
+	harukiLogger "haruki-database/utils/logger"
+	harukiRedis "haruki-database/utils/redis"
+
+	"github.com/bytedance/sonic"
+)
+
+func runCommand(args []string) int {
+	switch args[0] {
+	case "ingest-chunithm":
+		return runChunithmIngest(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n", args[0])
+		fmt.Fprintln(os.Stderr, "available commands: ingest-chunithm")
+		return 2
+	}
+}
+
+func runChunithmIngest(args []string) int {
+	fs := flag.NewFlagSet("ingest-chunithm", flag.ContinueOnError)
+	configPath := fs.String("config", "haruki-db-configs.yaml", "path to the config file")
+	dryRun := fs.Bool("dry-run", false, "validate and report the diff without writing")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: HarukiDatabaseBackend ingest-chunithm [-config path] [-dry-run] <dataset.json>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	harukiConfig.LoadConfig(*configPath)
+	cliLogger := harukiLogger.NewLogger("ChunithmIngest", harukiConfig.Cfg.Backend.LogLevel, os.Stderr)
+
+	data, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		cliLogger.Errorf("Failed to read dataset: %v", err)
+		return 1
+	}
+	var dataset chunithmAPI.MusicDataset
+	if err := sonic.Unmarshal(data, &dataset); err != nil {
+		cliLogger.Errorf("Failed to parse dataset: %v", err)
+		return 1
+	}
+
+	ctx := context.Background()
+	musicClient, err := chunithmMusicDB.Open(harukiConfig.Cfg.Chunithm.MusicDBType, harukiConfig.Cfg.Chunithm.MusicDBURL)
+	if err != nil {
+		cliLogger.Errorf("Failed to connect to Chunithm music DB: %v", err)
+		return 1
+	}
+	defer musicClient.Close()
+	if err := musicClient.Schema.Create(ctx); err != nil {
+		cliLogger.Errorf("Failed to create schema for Chunithm music DB: %v", err)
+		return 1
+	}
+
+	redisClient := harukiRedis.NewRedisClient(harukiConfig.Cfg.Redis)
+	defer redisClient.Close()
+	if err := redisClient.Ping(ctx).Err(); err != nil && !*dryRun {
+		cliLogger.Warnf("Redis unavailable, %s cache will not be invalidated: %v", chunithmAPI.CacheNSMusic, err)
+	}
+
+	// Ingestion only touches the music DB, so no alias client is needed.
+	svc := chunithmAPI.NewMusicService(musicClient, nil, redisClient)
+	report, err := svc.IngestDataset(ctx, &dataset, *dryRun)
+	if err != nil {
+		cliLogger.Errorf("Ingestion failed: %v", err)
+		return 1
+	}
+	out, err := sonic.ConfigDefault.MarshalIndent(report, "", "  ")
+	if err != nil {
+		cliLogger.Errorf("Failed to encode report: %v", err)
+		return 1
+	}
+	fmt.Println(string(out))
+	cliLogger.Infof("Chunithm dataset %s: %d added, %d updated, %d restored, %d deleted",
+		report.Version, len(report.Added), len(report.Updated), len(report.Restored), len(report.Deleted))
+	return 0
+}
@@ -32,12 +32,64 @@ type ChunithmConfig struct {
 	MusicDBURL    string `yaml:"music_db_url"`
 	BindingDBType string `yaml:"binding_db_type"`
 	BindingDBURL  string `yaml:"binding_db_url"`
+	IngestToken   string `yaml:"ingest_token"`
+	BatchMaxSize  int    `yaml:"query_batch_max_size"`
+	// SharedBindingPolicy is one of allow, flag or forbid.
+	SharedBindingPolicy string `yaml:"shared_binding_policy"`
 }
 
 type PJSKConfig struct {
 	Enabled bool   `yaml:"enabled"`
 	DBType  string `yaml:"db_type"`
 	DBURL   string `yaml:"db_url"`
+	// SharedBindingPolicy is one of allow, flag or forbid.
+	SharedBindingPolicy string `yaml:"shared_binding_policy"`
+	// MaxBindingsPerServer limits the bindings a user may create on each
+	// server. Servers without an entry use the "default" entry; a missing or
+	// zero limit means unlimited.
+	MaxBindingsPerServer map[string]int `yaml:"max_bindings_per_server"`
+	BindingBatchMaxSize  int            `yaml:"binding_batch_max_size"`
+}
+
+// GameBindingConfig declares a game whose bindings are kept in the generic
+// binding table of the users DB.
+type GameBindingConfig struct {
+	Name    string   `yaml:"name"`
+	Servers []string `yaml:"servers"`
+	// AccountIDPattern is a regular expression every account ID must match.
+	AccountIDPattern string `yaml:"account_id_pattern"`
+	// SharedBindingPolicy is one of allow, flag or forbid.
+	SharedBindingPolicy string `yaml:"shared_binding_policy"`
+	// MaxBindingsPerServer limits the bindings a user may create on each
+	// server; zero means unlimited.
+	MaxBindingsPerServer int `yaml:"max_bindings_per_server"`
+}
+
+// EventsConfig controls the change events published when bindings or
+// preferences are modified.
+type EventsConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Channel is the Redis pub/sub channel events are published on.
+	Channel  string         `yaml:"channel"`
+	Webhooks WebhooksConfig `yaml:"webhooks"`
+}
+
+// WebhooksConfig controls the delivery of events to HTTP endpoints. Zero
+// values fall back to the defaults of the events package.
+type WebhooksConfig struct {
+	URLs []string `yaml:"urls"`
+	// Secret is the HMAC-SHA256 key every payload is signed with.
+	Secret         string        `yaml:"secret"`
+	Timeout        time.Duration `yaml:"timeout"`
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+	Workers        int           `yaml:"workers"`
+	QueueSize      int           `yaml:"queue_size"`
+	// DeadLetterKey is the Redis list deliveries are pushed to once every
+	// attempt has failed. It keeps the newest DeadLetterMaxLength entries.
+	DeadLetterKey       string `yaml:"dead_letter_key"`
+	DeadLetterMaxLength int64  `yaml:"dead_letter_max_length"`
 }
 
 type CensorConfig struct {
@@ -74,6 +126,9 @@ type Config struct {
 	HarukiBotDB HarukiBotDBConfig `yaml:"haruki_bot"`
 	UsersDB     UsersDBConfig     `yaml:"users_db"`
 	Redis       RedisConfig       `yaml:"redis"`
+	Events      EventsConfig      `yaml:"events"`
+	// GameBindings declares additional games for the generic binding API.
+	GameBindings []GameBindingConfig `yaml:"game_bindings"`
 }
 
 var Cfg Config
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmaliasadmin"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ChunithmAliasAdmin is the model entity for the ChunithmAliasAdmin schema.
+type ChunithmAliasAdmin struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Reference to users table
+	HarukiUserID int `json:"haruki_user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name         string `json:"name,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChunithmAliasAdmin) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chunithmaliasadmin.FieldID, chunithmaliasadmin.FieldHarukiUserID:
+			values[i] = new(sql.NullInt64)
+		case chunithmaliasadmin.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChunithmAliasAdmin fields.
+func (_m *ChunithmAliasAdmin) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chunithmaliasadmin.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case chunithmaliasadmin.FieldHarukiUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field haruki_user_id", values[i])
+			} else if value.Valid {
+				_m.HarukiUserID = int(value.Int64)
+			}
+		case chunithmaliasadmin.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChunithmAliasAdmin.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChunithmAliasAdmin) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ChunithmAliasAdmin.
+// Note that you need to call ChunithmAliasAdmin.Unwrap() before calling this method if this ChunithmAliasAdmin
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChunithmAliasAdmin) Update() *ChunithmAliasAdminUpdateOne {
+	return NewChunithmAliasAdminClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChunithmAliasAdmin entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChunithmAliasAdmin) Unwrap() *ChunithmAliasAdmin {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("maindb: ChunithmAliasAdmin is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChunithmAliasAdmin) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChunithmAliasAdmin(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("haruki_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HarukiUserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChunithmAliasAdmins is a parsable slice of ChunithmAliasAdmin.
+type ChunithmAliasAdmins []*ChunithmAliasAdmin
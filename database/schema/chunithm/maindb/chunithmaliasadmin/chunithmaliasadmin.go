@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chunithmaliasadmin
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the chunithmaliasadmin type in the database.
+	Label = "chunithm_alias_admin"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHarukiUserID holds the string denoting the haruki_user_id field in the database.
+	FieldHarukiUserID = "haruki_user_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// Table holds the table name of the chunithmaliasadmin in the database.
+	Table = "chunithm_alias_admins"
+)
+
+// Columns holds all SQL columns for chunithmaliasadmin fields.
+var Columns = []string{
+	FieldID,
+	FieldHarukiUserID,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ChunithmAliasAdmin queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByHarukiUserID orders the results by the haruki_user_id field.
+func ByHarukiUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHarukiUserID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chunithmaliasadmin
+
+import (
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldLTE(FieldID, id))
+}
+
+// HarukiUserID applies equality check predicate on the "haruki_user_id" field. It's identical to HarukiUserIDEQ.
+func HarukiUserID(v int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldEQ(FieldHarukiUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldEQ(FieldName, v))
+}
+
+// HarukiUserIDEQ applies the EQ predicate on the "haruki_user_id" field.
+func HarukiUserIDEQ(v int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldEQ(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDNEQ applies the NEQ predicate on the "haruki_user_id" field.
+func HarukiUserIDNEQ(v int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldNEQ(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDIn applies the In predicate on the "haruki_user_id" field.
+func HarukiUserIDIn(vs ...int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldIn(FieldHarukiUserID, vs...))
+}
+
+// HarukiUserIDNotIn applies the NotIn predicate on the "haruki_user_id" field.
+func HarukiUserIDNotIn(vs ...int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldNotIn(FieldHarukiUserID, vs...))
+}
+
+// HarukiUserIDGT applies the GT predicate on the "haruki_user_id" field.
+func HarukiUserIDGT(v int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldGT(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDGTE applies the GTE predicate on the "haruki_user_id" field.
+func HarukiUserIDGTE(v int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldGTE(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDLT applies the LT predicate on the "haruki_user_id" field.
+func HarukiUserIDLT(v int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldLT(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDLTE applies the LTE predicate on the "haruki_user_id" field.
+func HarukiUserIDLTE(v int) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldLTE(FieldHarukiUserID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.FieldContainsFold(FieldName, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChunithmAliasAdmin) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChunithmAliasAdmin) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChunithmAliasAdmin) predicate.ChunithmAliasAdmin {
+	return predicate.ChunithmAliasAdmin(sql.NotPredicates(p))
+}
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmaliasadmin"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmAliasAdminCreate is the builder for creating a ChunithmAliasAdmin entity.
+type ChunithmAliasAdminCreate struct {
+	config
+	mutation *ChunithmAliasAdminMutation
+	hooks    []Hook
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_c *ChunithmAliasAdminCreate) SetHarukiUserID(v int) *ChunithmAliasAdminCreate {
+	_c.mutation.SetHarukiUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ChunithmAliasAdminCreate) SetName(v string) *ChunithmAliasAdminCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// Mutation returns the ChunithmAliasAdminMutation object of the builder.
+func (_c *ChunithmAliasAdminCreate) Mutation() *ChunithmAliasAdminMutation {
+	return _c.mutation
+}
+
+// Save creates the ChunithmAliasAdmin in the database.
+func (_c *ChunithmAliasAdminCreate) Save(ctx context.Context) (*ChunithmAliasAdmin, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChunithmAliasAdminCreate) SaveX(ctx context.Context) *ChunithmAliasAdmin {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmAliasAdminCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmAliasAdminCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChunithmAliasAdminCreate) check() error {
+	if _, ok := _c.mutation.HarukiUserID(); !ok {
+		return &ValidationError{Name: "haruki_user_id", err: errors.New(`maindb: missing required field "ChunithmAliasAdmin.haruki_user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`maindb: missing required field "ChunithmAliasAdmin.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := chunithmaliasadmin.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`maindb: validator failed for field "ChunithmAliasAdmin.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ChunithmAliasAdminCreate) sqlSave(ctx context.Context) (*ChunithmAliasAdmin, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChunithmAliasAdminCreate) createSpec() (*ChunithmAliasAdmin, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChunithmAliasAdmin{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chunithmaliasadmin.Table, sqlgraph.NewFieldSpec(chunithmaliasadmin.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.HarukiUserID(); ok {
+		_spec.SetField(chunithmaliasadmin.FieldHarukiUserID, field.TypeInt, value)
+		_node.HarukiUserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(chunithmaliasadmin.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	return _node, _spec
+}
+
+// ChunithmAliasAdminCreateBulk is the builder for creating many ChunithmAliasAdmin entities in bulk.
+type ChunithmAliasAdminCreateBulk struct {
+	config
+	err      error
+	builders []*ChunithmAliasAdminCreate
+}
+
+// Save creates the ChunithmAliasAdmin entities in the database.
+func (_c *ChunithmAliasAdminCreateBulk) Save(ctx context.Context) ([]*ChunithmAliasAdmin, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChunithmAliasAdmin, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChunithmAliasAdminMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChunithmAliasAdminCreateBulk) SaveX(ctx context.Context) []*ChunithmAliasAdmin {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmAliasAdminCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmAliasAdminCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"haruki-database/database/schema/chunithm/maindb/chunithmaliasadmin"
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmAliasAdminDelete is the builder for deleting a ChunithmAliasAdmin entity.
+type ChunithmAliasAdminDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmAliasAdminMutation
+}
+
+// Where appends a list predicates to the ChunithmAliasAdminDelete builder.
+func (_d *ChunithmAliasAdminDelete) Where(ps ...predicate.ChunithmAliasAdmin) *ChunithmAliasAdminDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ChunithmAliasAdminDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ChunithmAliasAdminDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ChunithmAliasAdminDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(chunithmaliasadmin.Table, sqlgraph.NewFieldSpec(chunithmaliasadmin.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ChunithmAliasAdminDeleteOne is the builder for deleting a single ChunithmAliasAdmin entity.
+type ChunithmAliasAdminDeleteOne struct {
+	_d *ChunithmAliasAdminDelete
+}
+
+// Where appends a list predicates to the ChunithmAliasAdminDelete builder.
+func (_d *ChunithmAliasAdminDeleteOne) Where(ps ...predicate.ChunithmAliasAdmin) *ChunithmAliasAdminDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ChunithmAliasAdminDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{chunithmaliasadmin.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ChunithmAliasAdminDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmaliasadmin"
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmAliasAdminUpdate is the builder for updating ChunithmAliasAdmin entities.
+type ChunithmAliasAdminUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmAliasAdminMutation
+}
+
+// Where appends a list predicates to the ChunithmAliasAdminUpdate builder.
+func (_u *ChunithmAliasAdminUpdate) Where(ps ...predicate.ChunithmAliasAdmin) *ChunithmAliasAdminUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_u *ChunithmAliasAdminUpdate) SetHarukiUserID(v int) *ChunithmAliasAdminUpdate {
+	_u.mutation.ResetHarukiUserID()
+	_u.mutation.SetHarukiUserID(v)
+	return _u
+}
+
+// SetNillableHarukiUserID sets the "haruki_user_id" field if the given value is not nil.
+func (_u *ChunithmAliasAdminUpdate) SetNillableHarukiUserID(v *int) *ChunithmAliasAdminUpdate {
+	if v != nil {
+		_u.SetHarukiUserID(*v)
+	}
+	return _u
+}
+
+// AddHarukiUserID adds value to the "haruki_user_id" field.
+func (_u *ChunithmAliasAdminUpdate) AddHarukiUserID(v int) *ChunithmAliasAdminUpdate {
+	_u.mutation.AddHarukiUserID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ChunithmAliasAdminUpdate) SetName(v string) *ChunithmAliasAdminUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ChunithmAliasAdminUpdate) SetNillableName(v *string) *ChunithmAliasAdminUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ChunithmAliasAdminMutation object of the builder.
+func (_u *ChunithmAliasAdminUpdate) Mutation() *ChunithmAliasAdminMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChunithmAliasAdminUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmAliasAdminUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChunithmAliasAdminUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmAliasAdminUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmAliasAdminUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := chunithmaliasadmin.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`maindb: validator failed for field "ChunithmAliasAdmin.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmAliasAdminUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmaliasadmin.Table, chunithmaliasadmin.Columns, sqlgraph.NewFieldSpec(chunithmaliasadmin.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HarukiUserID(); ok {
+		_spec.SetField(chunithmaliasadmin.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHarukiUserID(); ok {
+		_spec.AddField(chunithmaliasadmin.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(chunithmaliasadmin.FieldName, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmaliasadmin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChunithmAliasAdminUpdateOne is the builder for updating a single ChunithmAliasAdmin entity.
+type ChunithmAliasAdminUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChunithmAliasAdminMutation
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_u *ChunithmAliasAdminUpdateOne) SetHarukiUserID(v int) *ChunithmAliasAdminUpdateOne {
+	_u.mutation.ResetHarukiUserID()
+	_u.mutation.SetHarukiUserID(v)
+	return _u
+}
+
+// SetNillableHarukiUserID sets the "haruki_user_id" field if the given value is not nil.
+func (_u *ChunithmAliasAdminUpdateOne) SetNillableHarukiUserID(v *int) *ChunithmAliasAdminUpdateOne {
+	if v != nil {
+		_u.SetHarukiUserID(*v)
+	}
+	return _u
+}
+
+// AddHarukiUserID adds value to the "haruki_user_id" field.
+func (_u *ChunithmAliasAdminUpdateOne) AddHarukiUserID(v int) *ChunithmAliasAdminUpdateOne {
+	_u.mutation.AddHarukiUserID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ChunithmAliasAdminUpdateOne) SetName(v string) *ChunithmAliasAdminUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ChunithmAliasAdminUpdateOne) SetNillableName(v *string) *ChunithmAliasAdminUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ChunithmAliasAdminMutation object of the builder.
+func (_u *ChunithmAliasAdminUpdateOne) Mutation() *ChunithmAliasAdminMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChunithmAliasAdminUpdate builder.
+func (_u *ChunithmAliasAdminUpdateOne) Where(ps ...predicate.ChunithmAliasAdmin) *ChunithmAliasAdminUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChunithmAliasAdminUpdateOne) Select(field string, fields ...string) *ChunithmAliasAdminUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChunithmAliasAdmin entity.
+func (_u *ChunithmAliasAdminUpdateOne) Save(ctx context.Context) (*ChunithmAliasAdmin, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmAliasAdminUpdateOne) SaveX(ctx context.Context) *ChunithmAliasAdmin {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChunithmAliasAdminUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmAliasAdminUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmAliasAdminUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := chunithmaliasadmin.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`maindb: validator failed for field "ChunithmAliasAdmin.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmAliasAdminUpdateOne) sqlSave(ctx context.Context) (_node *ChunithmAliasAdmin, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmaliasadmin.Table, chunithmaliasadmin.Columns, sqlgraph.NewFieldSpec(chunithmaliasadmin.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`maindb: missing "ChunithmAliasAdmin.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chunithmaliasadmin.FieldID)
+		for _, f := range fields {
+			if !chunithmaliasadmin.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("maindb: invalid field %q for query", f)}
+			}
+			if f != chunithmaliasadmin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HarukiUserID(); ok {
+		_spec.SetField(chunithmaliasadmin.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHarukiUserID(); ok {
+		_spec.AddField(chunithmaliasadmin.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(chunithmaliasadmin.FieldName, field.TypeString, value)
+	}
+	_node = &ChunithmAliasAdmin{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmaliasadmin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,7 +21,15 @@ type ChunithmBinding struct {
 	// Server holds the value of the "server" field.
 	Server string `json:"server,omitempty"`
 	// AimeID holds the value of the "aime_id" field.
-	AimeID       string `json:"aime_id,omitempty"`
+	AimeID string `json:"aime_id,omitempty"`
+	// Label holds the value of the "label" field.
+	Label *string `json:"label,omitempty"`
+	// Visible holds the value of the "visible" field.
+	Visible bool `json:"visible,omitempty"`
+	// Default card of the user on this server
+	IsDefault bool `json:"is_default,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder    int `json:"sort_order,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -30,9 +38,11 @@ func (*ChunithmBinding) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case chunithmbinding.FieldID, chunithmbinding.FieldHarukiUserID:
+		case chunithmbinding.FieldVisible, chunithmbinding.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case chunithmbinding.FieldID, chunithmbinding.FieldHarukiUserID, chunithmbinding.FieldSortOrder:
 			values[i] = new(sql.NullInt64)
-		case chunithmbinding.FieldServer, chunithmbinding.FieldAimeID:
+		case chunithmbinding.FieldServer, chunithmbinding.FieldAimeID, chunithmbinding.FieldLabel:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -73,6 +83,31 @@ func (_m *ChunithmBinding) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AimeID = value.String
 			}
+		case chunithmbinding.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = new(string)
+				*_m.Label = value.String
+			}
+		case chunithmbinding.FieldVisible:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field visible", values[i])
+			} else if value.Valid {
+				_m.Visible = value.Bool
+			}
+		case chunithmbinding.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				_m.IsDefault = value.Bool
+			}
+		case chunithmbinding.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				_m.SortOrder = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -117,6 +152,20 @@ func (_m *ChunithmBinding) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("aime_id=")
 	builder.WriteString(_m.AimeID)
+	builder.WriteString(", ")
+	if v := _m.Label; v != nil {
+		builder.WriteString("label=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("visible=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visible))
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SortOrder))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -17,6 +17,14 @@ const (
 	FieldServer = "server"
 	// FieldAimeID holds the string denoting the aime_id field in the database.
 	FieldAimeID = "aime_id"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldVisible holds the string denoting the visible field in the database.
+	FieldVisible = "visible"
+	// FieldIsDefault holds the string denoting the is_default field in the database.
+	FieldIsDefault = "is_default"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
 	// Table holds the table name of the chunithmbinding in the database.
 	Table = "chunithm_bindings"
 )
@@ -27,6 +35,10 @@ var Columns = []string{
 	FieldHarukiUserID,
 	FieldServer,
 	FieldAimeID,
+	FieldLabel,
+	FieldVisible,
+	FieldIsDefault,
+	FieldSortOrder,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -44,6 +56,14 @@ var (
 	ServerValidator func(string) error
 	// AimeIDValidator is a validator for the "aime_id" field. It is called by the builders before save.
 	AimeIDValidator func(string) error
+	// LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	LabelValidator func(string) error
+	// DefaultVisible holds the default value on creation for the "visible" field.
+	DefaultVisible bool
+	// DefaultIsDefault holds the default value on creation for the "is_default" field.
+	DefaultIsDefault bool
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder int
 )
 
 // OrderOption defines the ordering options for the ChunithmBinding queries.
@@ -68,3 +88,23 @@ func ByServer(opts ...sql.OrderTermOption) OrderOption {
 func ByAimeID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAimeID, opts...).ToFunc()
 }
+
+// ByLabel orders the results by the label field.
+func ByLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLabel, opts...).ToFunc()
+}
+
+// ByVisible orders the results by the visible field.
+func ByVisible(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisible, opts...).ToFunc()
+}
+
+// ByIsDefault orders the results by the is_default field.
+func ByIsDefault(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDefault, opts...).ToFunc()
+}
+
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
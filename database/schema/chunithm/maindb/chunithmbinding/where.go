@@ -68,6 +68,21 @@ func AimeID(v string) predicate.ChunithmBinding {
 	return predicate.ChunithmBinding(sql.FieldEQ(FieldAimeID, v))
 }
 
+// Visible applies equality check predicate on the "visible" field. It's identical to VisibleEQ.
+func Visible(v bool) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldEQ(FieldVisible, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldEQ(FieldSortOrder, v))
+}
+
 // HarukiUserIDEQ applies the EQ predicate on the "haruki_user_id" field.
 func HarukiUserIDEQ(v int) predicate.ChunithmBinding {
 	return predicate.ChunithmBinding(sql.FieldEQ(FieldHarukiUserID, v))
@@ -238,6 +253,141 @@ func AimeIDContainsFold(v string) predicate.ChunithmBinding {
 	return predicate.ChunithmBinding(sql.FieldContainsFold(FieldAimeID, v))
 }
 
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelIsNil applies the IsNil predicate on the "label" field.
+func LabelIsNil() predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldIsNull(FieldLabel))
+}
+
+// LabelNotNil applies the NotNil predicate on the "label" field.
+func LabelNotNil() predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldNotNull(FieldLabel))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// VisibleEQ applies the EQ predicate on the "visible" field.
+func VisibleEQ(v bool) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldEQ(FieldVisible, v))
+}
+
+// VisibleNEQ applies the NEQ predicate on the "visible" field.
+func VisibleNEQ(v bool) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldNEQ(FieldVisible, v))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.ChunithmBinding {
+	return predicate.ChunithmBinding(sql.FieldLTE(FieldSortOrder, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ChunithmBinding) predicate.ChunithmBinding {
 	return predicate.ChunithmBinding(sql.AndPredicates(predicates...))
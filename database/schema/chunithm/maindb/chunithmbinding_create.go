@@ -37,6 +37,62 @@ func (_c *ChunithmBindingCreate) SetAimeID(v string) *ChunithmBindingCreate {
 	return _c
 }
 
+// SetLabel sets the "label" field.
+func (_c *ChunithmBindingCreate) SetLabel(v string) *ChunithmBindingCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_c *ChunithmBindingCreate) SetNillableLabel(v *string) *ChunithmBindingCreate {
+	if v != nil {
+		_c.SetLabel(*v)
+	}
+	return _c
+}
+
+// SetVisible sets the "visible" field.
+func (_c *ChunithmBindingCreate) SetVisible(v bool) *ChunithmBindingCreate {
+	_c.mutation.SetVisible(v)
+	return _c
+}
+
+// SetNillableVisible sets the "visible" field if the given value is not nil.
+func (_c *ChunithmBindingCreate) SetNillableVisible(v *bool) *ChunithmBindingCreate {
+	if v != nil {
+		_c.SetVisible(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *ChunithmBindingCreate) SetIsDefault(v bool) *ChunithmBindingCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *ChunithmBindingCreate) SetNillableIsDefault(v *bool) *ChunithmBindingCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *ChunithmBindingCreate) SetSortOrder(v int) *ChunithmBindingCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_c *ChunithmBindingCreate) SetNillableSortOrder(v *int) *ChunithmBindingCreate {
+	if v != nil {
+		_c.SetSortOrder(*v)
+	}
+	return _c
+}
+
 // Mutation returns the ChunithmBindingMutation object of the builder.
 func (_c *ChunithmBindingCreate) Mutation() *ChunithmBindingMutation {
 	return _c.mutation
@@ -44,6 +100,7 @@ func (_c *ChunithmBindingCreate) Mutation() *ChunithmBindingMutation {
 
 // Save creates the ChunithmBinding in the database.
 func (_c *ChunithmBindingCreate) Save(ctx context.Context) (*ChunithmBinding, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -69,6 +126,22 @@ func (_c *ChunithmBindingCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *ChunithmBindingCreate) defaults() {
+	if _, ok := _c.mutation.Visible(); !ok {
+		v := chunithmbinding.DefaultVisible
+		_c.mutation.SetVisible(v)
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		v := chunithmbinding.DefaultIsDefault
+		_c.mutation.SetIsDefault(v)
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		v := chunithmbinding.DefaultSortOrder
+		_c.mutation.SetSortOrder(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *ChunithmBindingCreate) check() error {
 	if _, ok := _c.mutation.HarukiUserID(); !ok {
@@ -90,6 +163,20 @@ func (_c *ChunithmBindingCreate) check() error {
 			return &ValidationError{Name: "aime_id", err: fmt.Errorf(`maindb: validator failed for field "ChunithmBinding.aime_id": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Label(); ok {
+		if err := chunithmbinding.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`maindb: validator failed for field "ChunithmBinding.label": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Visible(); !ok {
+		return &ValidationError{Name: "visible", err: errors.New(`maindb: missing required field "ChunithmBinding.visible"`)}
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`maindb: missing required field "ChunithmBinding.is_default"`)}
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`maindb: missing required field "ChunithmBinding.sort_order"`)}
+	}
 	return nil
 }
 
@@ -128,6 +215,22 @@ func (_c *ChunithmBindingCreate) createSpec() (*ChunithmBinding, *sqlgraph.Creat
 		_spec.SetField(chunithmbinding.FieldAimeID, field.TypeString, value)
 		_node.AimeID = value
 	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(chunithmbinding.FieldLabel, field.TypeString, value)
+		_node.Label = &value
+	}
+	if value, ok := _c.mutation.Visible(); ok {
+		_spec.SetField(chunithmbinding.FieldVisible, field.TypeBool, value)
+		_node.Visible = value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(chunithmbinding.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(chunithmbinding.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
 	return _node, _spec
 }
 
@@ -149,6 +252,7 @@ func (_c *ChunithmBindingCreateBulk) Save(ctx context.Context) ([]*ChunithmBindi
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ChunithmBindingMutation)
 				if !ok {
@@ -76,6 +76,75 @@ func (_u *ChunithmBindingUpdate) SetNillableAimeID(v *string) *ChunithmBindingUp
 	return _u
 }
 
+// SetLabel sets the "label" field.
+func (_u *ChunithmBindingUpdate) SetLabel(v string) *ChunithmBindingUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *ChunithmBindingUpdate) SetNillableLabel(v *string) *ChunithmBindingUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *ChunithmBindingUpdate) ClearLabel() *ChunithmBindingUpdate {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetVisible sets the "visible" field.
+func (_u *ChunithmBindingUpdate) SetVisible(v bool) *ChunithmBindingUpdate {
+	_u.mutation.SetVisible(v)
+	return _u
+}
+
+// SetNillableVisible sets the "visible" field if the given value is not nil.
+func (_u *ChunithmBindingUpdate) SetNillableVisible(v *bool) *ChunithmBindingUpdate {
+	if v != nil {
+		_u.SetVisible(*v)
+	}
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *ChunithmBindingUpdate) SetIsDefault(v bool) *ChunithmBindingUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *ChunithmBindingUpdate) SetNillableIsDefault(v *bool) *ChunithmBindingUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *ChunithmBindingUpdate) SetSortOrder(v int) *ChunithmBindingUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *ChunithmBindingUpdate) SetNillableSortOrder(v *int) *ChunithmBindingUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *ChunithmBindingUpdate) AddSortOrder(v int) *ChunithmBindingUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
 // Mutation returns the ChunithmBindingMutation object of the builder.
 func (_u *ChunithmBindingUpdate) Mutation() *ChunithmBindingMutation {
 	return _u.mutation
@@ -120,6 +189,11 @@ func (_u *ChunithmBindingUpdate) check() error {
 			return &ValidationError{Name: "aime_id", err: fmt.Errorf(`maindb: validator failed for field "ChunithmBinding.aime_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := chunithmbinding.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`maindb: validator failed for field "ChunithmBinding.label": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -147,6 +221,24 @@ func (_u *ChunithmBindingUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.AimeID(); ok {
 		_spec.SetField(chunithmbinding.FieldAimeID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(chunithmbinding.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(chunithmbinding.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Visible(); ok {
+		_spec.SetField(chunithmbinding.FieldVisible, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(chunithmbinding.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(chunithmbinding.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(chunithmbinding.FieldSortOrder, field.TypeInt, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{chunithmbinding.Label}
@@ -216,6 +308,75 @@ func (_u *ChunithmBindingUpdateOne) SetNillableAimeID(v *string) *ChunithmBindin
 	return _u
 }
 
+// SetLabel sets the "label" field.
+func (_u *ChunithmBindingUpdateOne) SetLabel(v string) *ChunithmBindingUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *ChunithmBindingUpdateOne) SetNillableLabel(v *string) *ChunithmBindingUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *ChunithmBindingUpdateOne) ClearLabel() *ChunithmBindingUpdateOne {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetVisible sets the "visible" field.
+func (_u *ChunithmBindingUpdateOne) SetVisible(v bool) *ChunithmBindingUpdateOne {
+	_u.mutation.SetVisible(v)
+	return _u
+}
+
+// SetNillableVisible sets the "visible" field if the given value is not nil.
+func (_u *ChunithmBindingUpdateOne) SetNillableVisible(v *bool) *ChunithmBindingUpdateOne {
+	if v != nil {
+		_u.SetVisible(*v)
+	}
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *ChunithmBindingUpdateOne) SetIsDefault(v bool) *ChunithmBindingUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *ChunithmBindingUpdateOne) SetNillableIsDefault(v *bool) *ChunithmBindingUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *ChunithmBindingUpdateOne) SetSortOrder(v int) *ChunithmBindingUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *ChunithmBindingUpdateOne) SetNillableSortOrder(v *int) *ChunithmBindingUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *ChunithmBindingUpdateOne) AddSortOrder(v int) *ChunithmBindingUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
 // Mutation returns the ChunithmBindingMutation object of the builder.
 func (_u *ChunithmBindingUpdateOne) Mutation() *ChunithmBindingMutation {
 	return _u.mutation
@@ -273,6 +434,11 @@ func (_u *ChunithmBindingUpdateOne) check() error {
 			return &ValidationError{Name: "aime_id", err: fmt.Errorf(`maindb: validator failed for field "ChunithmBinding.aime_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := chunithmbinding.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`maindb: validator failed for field "ChunithmBinding.label": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -317,6 +483,24 @@ func (_u *ChunithmBindingUpdateOne) sqlSave(ctx context.Context) (_node *Chunith
 	if value, ok := _u.mutation.AimeID(); ok {
 		_spec.SetField(chunithmbinding.FieldAimeID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(chunithmbinding.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(chunithmbinding.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Visible(); ok {
+		_spec.SetField(chunithmbinding.FieldVisible, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(chunithmbinding.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(chunithmbinding.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(chunithmbinding.FieldSortOrder, field.TypeInt, value)
+	}
 	_node = &ChunithmBinding{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmgroupalias"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ChunithmGroupAlias is the model entity for the ChunithmGroupAlias schema.
+type ChunithmGroupAlias struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Platform holds the value of the "platform" field.
+	Platform string `json:"platform,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID string `json:"group_id,omitempty"`
+	// MusicID holds the value of the "music_id" field.
+	MusicID int `json:"music_id,omitempty"`
+	// Alias holds the value of the "alias" field.
+	Alias        string `json:"alias,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChunithmGroupAlias) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chunithmgroupalias.FieldID, chunithmgroupalias.FieldMusicID:
+			values[i] = new(sql.NullInt64)
+		case chunithmgroupalias.FieldPlatform, chunithmgroupalias.FieldGroupID, chunithmgroupalias.FieldAlias:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChunithmGroupAlias fields.
+func (_m *ChunithmGroupAlias) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chunithmgroupalias.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case chunithmgroupalias.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				_m.Platform = value.String
+			}
+		case chunithmgroupalias.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = value.String
+			}
+		case chunithmgroupalias.FieldMusicID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field music_id", values[i])
+			} else if value.Valid {
+				_m.MusicID = int(value.Int64)
+			}
+		case chunithmgroupalias.FieldAlias:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alias", values[i])
+			} else if value.Valid {
+				_m.Alias = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChunithmGroupAlias.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChunithmGroupAlias) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ChunithmGroupAlias.
+// Note that you need to call ChunithmGroupAlias.Unwrap() before calling this method if this ChunithmGroupAlias
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChunithmGroupAlias) Update() *ChunithmGroupAliasUpdateOne {
+	return NewChunithmGroupAliasClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChunithmGroupAlias entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChunithmGroupAlias) Unwrap() *ChunithmGroupAlias {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("maindb: ChunithmGroupAlias is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChunithmGroupAlias) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChunithmGroupAlias(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("platform=")
+	builder.WriteString(_m.Platform)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(_m.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("music_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MusicID))
+	builder.WriteString(", ")
+	builder.WriteString("alias=")
+	builder.WriteString(_m.Alias)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChunithmGroupAliasSlice is a parsable slice of ChunithmGroupAlias.
+type ChunithmGroupAliasSlice []*ChunithmGroupAlias
@@ -0,0 +1,80 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chunithmgroupalias
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the chunithmgroupalias type in the database.
+	Label = "chunithm_group_alias"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldMusicID holds the string denoting the music_id field in the database.
+	FieldMusicID = "music_id"
+	// FieldAlias holds the string denoting the alias field in the database.
+	FieldAlias = "alias"
+	// Table holds the table name of the chunithmgroupalias in the database.
+	Table = "chunithm_group_alias"
+)
+
+// Columns holds all SQL columns for chunithmgroupalias fields.
+var Columns = []string{
+	FieldID,
+	FieldPlatform,
+	FieldGroupID,
+	FieldMusicID,
+	FieldAlias,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PlatformValidator is a validator for the "platform" field. It is called by the builders before save.
+	PlatformValidator func(string) error
+	// GroupIDValidator is a validator for the "group_id" field. It is called by the builders before save.
+	GroupIDValidator func(string) error
+	// AliasValidator is a validator for the "alias" field. It is called by the builders before save.
+	AliasValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ChunithmGroupAlias queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByMusicID orders the results by the music_id field.
+func ByMusicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMusicID, opts...).ToFunc()
+}
+
+// ByAlias orders the results by the alias field.
+func ByAlias(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlias, opts...).ToFunc()
+}
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chunithmgroupalias
+
+import (
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldLTE(FieldID, id))
+}
+
+// Platform applies equality check predicate on the "platform" field. It's identical to PlatformEQ.
+func Platform(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEQ(FieldPlatform, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEQ(FieldGroupID, v))
+}
+
+// MusicID applies equality check predicate on the "music_id" field. It's identical to MusicIDEQ.
+func MusicID(v int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEQ(FieldMusicID, v))
+}
+
+// Alias applies equality check predicate on the "alias" field. It's identical to AliasEQ.
+func Alias(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEQ(FieldAlias, v))
+}
+
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEQ(FieldPlatform, v))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldNEQ(FieldPlatform, v))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldIn(FieldPlatform, vs...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldNotIn(FieldPlatform, vs...))
+}
+
+// PlatformGT applies the GT predicate on the "platform" field.
+func PlatformGT(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldGT(FieldPlatform, v))
+}
+
+// PlatformGTE applies the GTE predicate on the "platform" field.
+func PlatformGTE(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldGTE(FieldPlatform, v))
+}
+
+// PlatformLT applies the LT predicate on the "platform" field.
+func PlatformLT(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldLT(FieldPlatform, v))
+}
+
+// PlatformLTE applies the LTE predicate on the "platform" field.
+func PlatformLTE(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldLTE(FieldPlatform, v))
+}
+
+// PlatformContains applies the Contains predicate on the "platform" field.
+func PlatformContains(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldContains(FieldPlatform, v))
+}
+
+// PlatformHasPrefix applies the HasPrefix predicate on the "platform" field.
+func PlatformHasPrefix(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldHasPrefix(FieldPlatform, v))
+}
+
+// PlatformHasSuffix applies the HasSuffix predicate on the "platform" field.
+func PlatformHasSuffix(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldHasSuffix(FieldPlatform, v))
+}
+
+// PlatformEqualFold applies the EqualFold predicate on the "platform" field.
+func PlatformEqualFold(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEqualFold(FieldPlatform, v))
+}
+
+// PlatformContainsFold applies the ContainsFold predicate on the "platform" field.
+func PlatformContainsFold(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldContainsFold(FieldPlatform, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldHasSuffix(FieldGroupID, v))
+}
+
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEqualFold(FieldGroupID, v))
+}
+
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldContainsFold(FieldGroupID, v))
+}
+
+// MusicIDEQ applies the EQ predicate on the "music_id" field.
+func MusicIDEQ(v int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEQ(FieldMusicID, v))
+}
+
+// MusicIDNEQ applies the NEQ predicate on the "music_id" field.
+func MusicIDNEQ(v int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldNEQ(FieldMusicID, v))
+}
+
+// MusicIDIn applies the In predicate on the "music_id" field.
+func MusicIDIn(vs ...int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldIn(FieldMusicID, vs...))
+}
+
+// MusicIDNotIn applies the NotIn predicate on the "music_id" field.
+func MusicIDNotIn(vs ...int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldNotIn(FieldMusicID, vs...))
+}
+
+// MusicIDGT applies the GT predicate on the "music_id" field.
+func MusicIDGT(v int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldGT(FieldMusicID, v))
+}
+
+// MusicIDGTE applies the GTE predicate on the "music_id" field.
+func MusicIDGTE(v int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldGTE(FieldMusicID, v))
+}
+
+// MusicIDLT applies the LT predicate on the "music_id" field.
+func MusicIDLT(v int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldLT(FieldMusicID, v))
+}
+
+// MusicIDLTE applies the LTE predicate on the "music_id" field.
+func MusicIDLTE(v int) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldLTE(FieldMusicID, v))
+}
+
+// AliasEQ applies the EQ predicate on the "alias" field.
+func AliasEQ(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEQ(FieldAlias, v))
+}
+
+// AliasNEQ applies the NEQ predicate on the "alias" field.
+func AliasNEQ(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldNEQ(FieldAlias, v))
+}
+
+// AliasIn applies the In predicate on the "alias" field.
+func AliasIn(vs ...string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldIn(FieldAlias, vs...))
+}
+
+// AliasNotIn applies the NotIn predicate on the "alias" field.
+func AliasNotIn(vs ...string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldNotIn(FieldAlias, vs...))
+}
+
+// AliasGT applies the GT predicate on the "alias" field.
+func AliasGT(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldGT(FieldAlias, v))
+}
+
+// AliasGTE applies the GTE predicate on the "alias" field.
+func AliasGTE(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldGTE(FieldAlias, v))
+}
+
+// AliasLT applies the LT predicate on the "alias" field.
+func AliasLT(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldLT(FieldAlias, v))
+}
+
+// AliasLTE applies the LTE predicate on the "alias" field.
+func AliasLTE(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldLTE(FieldAlias, v))
+}
+
+// AliasContains applies the Contains predicate on the "alias" field.
+func AliasContains(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldContains(FieldAlias, v))
+}
+
+// AliasHasPrefix applies the HasPrefix predicate on the "alias" field.
+func AliasHasPrefix(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldHasPrefix(FieldAlias, v))
+}
+
+// AliasHasSuffix applies the HasSuffix predicate on the "alias" field.
+func AliasHasSuffix(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldHasSuffix(FieldAlias, v))
+}
+
+// AliasEqualFold applies the EqualFold predicate on the "alias" field.
+func AliasEqualFold(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldEqualFold(FieldAlias, v))
+}
+
+// AliasContainsFold applies the ContainsFold predicate on the "alias" field.
+func AliasContainsFold(v string) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.FieldContainsFold(FieldAlias, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChunithmGroupAlias) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChunithmGroupAlias) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChunithmGroupAlias) predicate.ChunithmGroupAlias {
+	return predicate.ChunithmGroupAlias(sql.NotPredicates(p))
+}
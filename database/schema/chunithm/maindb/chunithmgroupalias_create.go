@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmgroupalias"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmGroupAliasCreate is the builder for creating a ChunithmGroupAlias entity.
+type ChunithmGroupAliasCreate struct {
+	config
+	mutation *ChunithmGroupAliasMutation
+	hooks    []Hook
+}
+
+// SetPlatform sets the "platform" field.
+func (_c *ChunithmGroupAliasCreate) SetPlatform(v string) *ChunithmGroupAliasCreate {
+	_c.mutation.SetPlatform(v)
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *ChunithmGroupAliasCreate) SetGroupID(v string) *ChunithmGroupAliasCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetMusicID sets the "music_id" field.
+func (_c *ChunithmGroupAliasCreate) SetMusicID(v int) *ChunithmGroupAliasCreate {
+	_c.mutation.SetMusicID(v)
+	return _c
+}
+
+// SetAlias sets the "alias" field.
+func (_c *ChunithmGroupAliasCreate) SetAlias(v string) *ChunithmGroupAliasCreate {
+	_c.mutation.SetAlias(v)
+	return _c
+}
+
+// Mutation returns the ChunithmGroupAliasMutation object of the builder.
+func (_c *ChunithmGroupAliasCreate) Mutation() *ChunithmGroupAliasMutation {
+	return _c.mutation
+}
+
+// Save creates the ChunithmGroupAlias in the database.
+func (_c *ChunithmGroupAliasCreate) Save(ctx context.Context) (*ChunithmGroupAlias, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChunithmGroupAliasCreate) SaveX(ctx context.Context) *ChunithmGroupAlias {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmGroupAliasCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmGroupAliasCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChunithmGroupAliasCreate) check() error {
+	if _, ok := _c.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`maindb: missing required field "ChunithmGroupAlias.platform"`)}
+	}
+	if v, ok := _c.mutation.Platform(); ok {
+		if err := chunithmgroupalias.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`maindb: validator failed for field "ChunithmGroupAlias.platform": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`maindb: missing required field "ChunithmGroupAlias.group_id"`)}
+	}
+	if v, ok := _c.mutation.GroupID(); ok {
+		if err := chunithmgroupalias.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`maindb: validator failed for field "ChunithmGroupAlias.group_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MusicID(); !ok {
+		return &ValidationError{Name: "music_id", err: errors.New(`maindb: missing required field "ChunithmGroupAlias.music_id"`)}
+	}
+	if _, ok := _c.mutation.Alias(); !ok {
+		return &ValidationError{Name: "alias", err: errors.New(`maindb: missing required field "ChunithmGroupAlias.alias"`)}
+	}
+	if v, ok := _c.mutation.Alias(); ok {
+		if err := chunithmgroupalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`maindb: validator failed for field "ChunithmGroupAlias.alias": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ChunithmGroupAliasCreate) sqlSave(ctx context.Context) (*ChunithmGroupAlias, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChunithmGroupAliasCreate) createSpec() (*ChunithmGroupAlias, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChunithmGroupAlias{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chunithmgroupalias.Table, sqlgraph.NewFieldSpec(chunithmgroupalias.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Platform(); ok {
+		_spec.SetField(chunithmgroupalias.FieldPlatform, field.TypeString, value)
+		_node.Platform = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(chunithmgroupalias.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.MusicID(); ok {
+		_spec.SetField(chunithmgroupalias.FieldMusicID, field.TypeInt, value)
+		_node.MusicID = value
+	}
+	if value, ok := _c.mutation.Alias(); ok {
+		_spec.SetField(chunithmgroupalias.FieldAlias, field.TypeString, value)
+		_node.Alias = value
+	}
+	return _node, _spec
+}
+
+// ChunithmGroupAliasCreateBulk is the builder for creating many ChunithmGroupAlias entities in bulk.
+type ChunithmGroupAliasCreateBulk struct {
+	config
+	err      error
+	builders []*ChunithmGroupAliasCreate
+}
+
+// Save creates the ChunithmGroupAlias entities in the database.
+func (_c *ChunithmGroupAliasCreateBulk) Save(ctx context.Context) ([]*ChunithmGroupAlias, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChunithmGroupAlias, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChunithmGroupAliasMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChunithmGroupAliasCreateBulk) SaveX(ctx context.Context) []*ChunithmGroupAlias {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmGroupAliasCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmGroupAliasCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"haruki-database/database/schema/chunithm/maindb/chunithmgroupalias"
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmGroupAliasDelete is the builder for deleting a ChunithmGroupAlias entity.
+type ChunithmGroupAliasDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmGroupAliasMutation
+}
+
+// Where appends a list predicates to the ChunithmGroupAliasDelete builder.
+func (_d *ChunithmGroupAliasDelete) Where(ps ...predicate.ChunithmGroupAlias) *ChunithmGroupAliasDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ChunithmGroupAliasDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ChunithmGroupAliasDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ChunithmGroupAliasDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(chunithmgroupalias.Table, sqlgraph.NewFieldSpec(chunithmgroupalias.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ChunithmGroupAliasDeleteOne is the builder for deleting a single ChunithmGroupAlias entity.
+type ChunithmGroupAliasDeleteOne struct {
+	_d *ChunithmGroupAliasDelete
+}
+
+// Where appends a list predicates to the ChunithmGroupAliasDelete builder.
+func (_d *ChunithmGroupAliasDeleteOne) Where(ps ...predicate.ChunithmGroupAlias) *ChunithmGroupAliasDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ChunithmGroupAliasDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{chunithmgroupalias.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ChunithmGroupAliasDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmgroupalias"
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmGroupAliasUpdate is the builder for updating ChunithmGroupAlias entities.
+type ChunithmGroupAliasUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmGroupAliasMutation
+}
+
+// Where appends a list predicates to the ChunithmGroupAliasUpdate builder.
+func (_u *ChunithmGroupAliasUpdate) Where(ps ...predicate.ChunithmGroupAlias) *ChunithmGroupAliasUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *ChunithmGroupAliasUpdate) SetPlatform(v string) *ChunithmGroupAliasUpdate {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *ChunithmGroupAliasUpdate) SetNillablePlatform(v *string) *ChunithmGroupAliasUpdate {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ChunithmGroupAliasUpdate) SetGroupID(v string) *ChunithmGroupAliasUpdate {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ChunithmGroupAliasUpdate) SetNillableGroupID(v *string) *ChunithmGroupAliasUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetMusicID sets the "music_id" field.
+func (_u *ChunithmGroupAliasUpdate) SetMusicID(v int) *ChunithmGroupAliasUpdate {
+	_u.mutation.ResetMusicID()
+	_u.mutation.SetMusicID(v)
+	return _u
+}
+
+// SetNillableMusicID sets the "music_id" field if the given value is not nil.
+func (_u *ChunithmGroupAliasUpdate) SetNillableMusicID(v *int) *ChunithmGroupAliasUpdate {
+	if v != nil {
+		_u.SetMusicID(*v)
+	}
+	return _u
+}
+
+// AddMusicID adds value to the "music_id" field.
+func (_u *ChunithmGroupAliasUpdate) AddMusicID(v int) *ChunithmGroupAliasUpdate {
+	_u.mutation.AddMusicID(v)
+	return _u
+}
+
+// SetAlias sets the "alias" field.
+func (_u *ChunithmGroupAliasUpdate) SetAlias(v string) *ChunithmGroupAliasUpdate {
+	_u.mutation.SetAlias(v)
+	return _u
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_u *ChunithmGroupAliasUpdate) SetNillableAlias(v *string) *ChunithmGroupAliasUpdate {
+	if v != nil {
+		_u.SetAlias(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ChunithmGroupAliasMutation object of the builder.
+func (_u *ChunithmGroupAliasUpdate) Mutation() *ChunithmGroupAliasMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChunithmGroupAliasUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmGroupAliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChunithmGroupAliasUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmGroupAliasUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmGroupAliasUpdate) check() error {
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := chunithmgroupalias.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`maindb: validator failed for field "ChunithmGroupAlias.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupID(); ok {
+		if err := chunithmgroupalias.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`maindb: validator failed for field "ChunithmGroupAlias.group_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Alias(); ok {
+		if err := chunithmgroupalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`maindb: validator failed for field "ChunithmGroupAlias.alias": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmGroupAliasUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmgroupalias.Table, chunithmgroupalias.Columns, sqlgraph.NewFieldSpec(chunithmgroupalias.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(chunithmgroupalias.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(chunithmgroupalias.FieldGroupID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MusicID(); ok {
+		_spec.SetField(chunithmgroupalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMusicID(); ok {
+		_spec.AddField(chunithmgroupalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Alias(); ok {
+		_spec.SetField(chunithmgroupalias.FieldAlias, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmgroupalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChunithmGroupAliasUpdateOne is the builder for updating a single ChunithmGroupAlias entity.
+type ChunithmGroupAliasUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChunithmGroupAliasMutation
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *ChunithmGroupAliasUpdateOne) SetPlatform(v string) *ChunithmGroupAliasUpdateOne {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *ChunithmGroupAliasUpdateOne) SetNillablePlatform(v *string) *ChunithmGroupAliasUpdateOne {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *ChunithmGroupAliasUpdateOne) SetGroupID(v string) *ChunithmGroupAliasUpdateOne {
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *ChunithmGroupAliasUpdateOne) SetNillableGroupID(v *string) *ChunithmGroupAliasUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// SetMusicID sets the "music_id" field.
+func (_u *ChunithmGroupAliasUpdateOne) SetMusicID(v int) *ChunithmGroupAliasUpdateOne {
+	_u.mutation.ResetMusicID()
+	_u.mutation.SetMusicID(v)
+	return _u
+}
+
+// SetNillableMusicID sets the "music_id" field if the given value is not nil.
+func (_u *ChunithmGroupAliasUpdateOne) SetNillableMusicID(v *int) *ChunithmGroupAliasUpdateOne {
+	if v != nil {
+		_u.SetMusicID(*v)
+	}
+	return _u
+}
+
+// AddMusicID adds value to the "music_id" field.
+func (_u *ChunithmGroupAliasUpdateOne) AddMusicID(v int) *ChunithmGroupAliasUpdateOne {
+	_u.mutation.AddMusicID(v)
+	return _u
+}
+
+// SetAlias sets the "alias" field.
+func (_u *ChunithmGroupAliasUpdateOne) SetAlias(v string) *ChunithmGroupAliasUpdateOne {
+	_u.mutation.SetAlias(v)
+	return _u
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_u *ChunithmGroupAliasUpdateOne) SetNillableAlias(v *string) *ChunithmGroupAliasUpdateOne {
+	if v != nil {
+		_u.SetAlias(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ChunithmGroupAliasMutation object of the builder.
+func (_u *ChunithmGroupAliasUpdateOne) Mutation() *ChunithmGroupAliasMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChunithmGroupAliasUpdate builder.
+func (_u *ChunithmGroupAliasUpdateOne) Where(ps ...predicate.ChunithmGroupAlias) *ChunithmGroupAliasUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChunithmGroupAliasUpdateOne) Select(field string, fields ...string) *ChunithmGroupAliasUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChunithmGroupAlias entity.
+func (_u *ChunithmGroupAliasUpdateOne) Save(ctx context.Context) (*ChunithmGroupAlias, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmGroupAliasUpdateOne) SaveX(ctx context.Context) *ChunithmGroupAlias {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChunithmGroupAliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmGroupAliasUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmGroupAliasUpdateOne) check() error {
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := chunithmgroupalias.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`maindb: validator failed for field "ChunithmGroupAlias.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GroupID(); ok {
+		if err := chunithmgroupalias.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`maindb: validator failed for field "ChunithmGroupAlias.group_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Alias(); ok {
+		if err := chunithmgroupalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`maindb: validator failed for field "ChunithmGroupAlias.alias": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmGroupAliasUpdateOne) sqlSave(ctx context.Context) (_node *ChunithmGroupAlias, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmgroupalias.Table, chunithmgroupalias.Columns, sqlgraph.NewFieldSpec(chunithmgroupalias.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`maindb: missing "ChunithmGroupAlias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chunithmgroupalias.FieldID)
+		for _, f := range fields {
+			if !chunithmgroupalias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("maindb: invalid field %q for query", f)}
+			}
+			if f != chunithmgroupalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(chunithmgroupalias.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(chunithmgroupalias.FieldGroupID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MusicID(); ok {
+		_spec.SetField(chunithmgroupalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMusicID(); ok {
+		_spec.AddField(chunithmgroupalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Alias(); ok {
+		_spec.SetField(chunithmgroupalias.FieldAlias, field.TypeString, value)
+	}
+	_node = &ChunithmGroupAlias{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmgroupalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmpendingalias"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ChunithmPendingAlias is the model entity for the ChunithmPendingAlias schema.
+type ChunithmPendingAlias struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// MusicID holds the value of the "music_id" field.
+	MusicID int `json:"music_id,omitempty"`
+	// Alias holds the value of the "alias" field.
+	Alias string `json:"alias,omitempty"`
+	// SubmittedBy holds the value of the "submitted_by" field.
+	SubmittedBy string `json:"submitted_by,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt  time.Time `json:"submitted_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChunithmPendingAlias) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chunithmpendingalias.FieldID, chunithmpendingalias.FieldMusicID:
+			values[i] = new(sql.NullInt64)
+		case chunithmpendingalias.FieldAlias, chunithmpendingalias.FieldSubmittedBy:
+			values[i] = new(sql.NullString)
+		case chunithmpendingalias.FieldSubmittedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChunithmPendingAlias fields.
+func (_m *ChunithmPendingAlias) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chunithmpendingalias.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case chunithmpendingalias.FieldMusicID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field music_id", values[i])
+			} else if value.Valid {
+				_m.MusicID = int(value.Int64)
+			}
+		case chunithmpendingalias.FieldAlias:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alias", values[i])
+			} else if value.Valid {
+				_m.Alias = value.String
+			}
+		case chunithmpendingalias.FieldSubmittedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_by", values[i])
+			} else if value.Valid {
+				_m.SubmittedBy = value.String
+			}
+		case chunithmpendingalias.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				_m.SubmittedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChunithmPendingAlias.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChunithmPendingAlias) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ChunithmPendingAlias.
+// Note that you need to call ChunithmPendingAlias.Unwrap() before calling this method if this ChunithmPendingAlias
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChunithmPendingAlias) Update() *ChunithmPendingAliasUpdateOne {
+	return NewChunithmPendingAliasClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChunithmPendingAlias entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChunithmPendingAlias) Unwrap() *ChunithmPendingAlias {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("maindb: ChunithmPendingAlias is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChunithmPendingAlias) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChunithmPendingAlias(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("music_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MusicID))
+	builder.WriteString(", ")
+	builder.WriteString("alias=")
+	builder.WriteString(_m.Alias)
+	builder.WriteString(", ")
+	builder.WriteString("submitted_by=")
+	builder.WriteString(_m.SubmittedBy)
+	builder.WriteString(", ")
+	builder.WriteString("submitted_at=")
+	builder.WriteString(_m.SubmittedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChunithmPendingAliasSlice is a parsable slice of ChunithmPendingAlias.
+type ChunithmPendingAliasSlice []*ChunithmPendingAlias
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chunithmpendingalias
+
+import (
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldLTE(FieldID, id))
+}
+
+// MusicID applies equality check predicate on the "music_id" field. It's identical to MusicIDEQ.
+func MusicID(v int) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEQ(FieldMusicID, v))
+}
+
+// Alias applies equality check predicate on the "alias" field. It's identical to AliasEQ.
+func Alias(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEQ(FieldAlias, v))
+}
+
+// SubmittedBy applies equality check predicate on the "submitted_by" field. It's identical to SubmittedByEQ.
+func SubmittedBy(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEQ(FieldSubmittedBy, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// MusicIDEQ applies the EQ predicate on the "music_id" field.
+func MusicIDEQ(v int) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEQ(FieldMusicID, v))
+}
+
+// MusicIDNEQ applies the NEQ predicate on the "music_id" field.
+func MusicIDNEQ(v int) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldNEQ(FieldMusicID, v))
+}
+
+// MusicIDIn applies the In predicate on the "music_id" field.
+func MusicIDIn(vs ...int) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldIn(FieldMusicID, vs...))
+}
+
+// MusicIDNotIn applies the NotIn predicate on the "music_id" field.
+func MusicIDNotIn(vs ...int) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldNotIn(FieldMusicID, vs...))
+}
+
+// MusicIDGT applies the GT predicate on the "music_id" field.
+func MusicIDGT(v int) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldGT(FieldMusicID, v))
+}
+
+// MusicIDGTE applies the GTE predicate on the "music_id" field.
+func MusicIDGTE(v int) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldGTE(FieldMusicID, v))
+}
+
+// MusicIDLT applies the LT predicate on the "music_id" field.
+func MusicIDLT(v int) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldLT(FieldMusicID, v))
+}
+
+// MusicIDLTE applies the LTE predicate on the "music_id" field.
+func MusicIDLTE(v int) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldLTE(FieldMusicID, v))
+}
+
+// AliasEQ applies the EQ predicate on the "alias" field.
+func AliasEQ(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEQ(FieldAlias, v))
+}
+
+// AliasNEQ applies the NEQ predicate on the "alias" field.
+func AliasNEQ(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldNEQ(FieldAlias, v))
+}
+
+// AliasIn applies the In predicate on the "alias" field.
+func AliasIn(vs ...string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldIn(FieldAlias, vs...))
+}
+
+// AliasNotIn applies the NotIn predicate on the "alias" field.
+func AliasNotIn(vs ...string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldNotIn(FieldAlias, vs...))
+}
+
+// AliasGT applies the GT predicate on the "alias" field.
+func AliasGT(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldGT(FieldAlias, v))
+}
+
+// AliasGTE applies the GTE predicate on the "alias" field.
+func AliasGTE(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldGTE(FieldAlias, v))
+}
+
+// AliasLT applies the LT predicate on the "alias" field.
+func AliasLT(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldLT(FieldAlias, v))
+}
+
+// AliasLTE applies the LTE predicate on the "alias" field.
+func AliasLTE(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldLTE(FieldAlias, v))
+}
+
+// AliasContains applies the Contains predicate on the "alias" field.
+func AliasContains(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldContains(FieldAlias, v))
+}
+
+// AliasHasPrefix applies the HasPrefix predicate on the "alias" field.
+func AliasHasPrefix(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldHasPrefix(FieldAlias, v))
+}
+
+// AliasHasSuffix applies the HasSuffix predicate on the "alias" field.
+func AliasHasSuffix(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldHasSuffix(FieldAlias, v))
+}
+
+// AliasEqualFold applies the EqualFold predicate on the "alias" field.
+func AliasEqualFold(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEqualFold(FieldAlias, v))
+}
+
+// AliasContainsFold applies the ContainsFold predicate on the "alias" field.
+func AliasContainsFold(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldContainsFold(FieldAlias, v))
+}
+
+// SubmittedByEQ applies the EQ predicate on the "submitted_by" field.
+func SubmittedByEQ(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEQ(FieldSubmittedBy, v))
+}
+
+// SubmittedByNEQ applies the NEQ predicate on the "submitted_by" field.
+func SubmittedByNEQ(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldNEQ(FieldSubmittedBy, v))
+}
+
+// SubmittedByIn applies the In predicate on the "submitted_by" field.
+func SubmittedByIn(vs ...string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldIn(FieldSubmittedBy, vs...))
+}
+
+// SubmittedByNotIn applies the NotIn predicate on the "submitted_by" field.
+func SubmittedByNotIn(vs ...string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldNotIn(FieldSubmittedBy, vs...))
+}
+
+// SubmittedByGT applies the GT predicate on the "submitted_by" field.
+func SubmittedByGT(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldGT(FieldSubmittedBy, v))
+}
+
+// SubmittedByGTE applies the GTE predicate on the "submitted_by" field.
+func SubmittedByGTE(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldGTE(FieldSubmittedBy, v))
+}
+
+// SubmittedByLT applies the LT predicate on the "submitted_by" field.
+func SubmittedByLT(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldLT(FieldSubmittedBy, v))
+}
+
+// SubmittedByLTE applies the LTE predicate on the "submitted_by" field.
+func SubmittedByLTE(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldLTE(FieldSubmittedBy, v))
+}
+
+// SubmittedByContains applies the Contains predicate on the "submitted_by" field.
+func SubmittedByContains(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldContains(FieldSubmittedBy, v))
+}
+
+// SubmittedByHasPrefix applies the HasPrefix predicate on the "submitted_by" field.
+func SubmittedByHasPrefix(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldHasPrefix(FieldSubmittedBy, v))
+}
+
+// SubmittedByHasSuffix applies the HasSuffix predicate on the "submitted_by" field.
+func SubmittedByHasSuffix(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldHasSuffix(FieldSubmittedBy, v))
+}
+
+// SubmittedByEqualFold applies the EqualFold predicate on the "submitted_by" field.
+func SubmittedByEqualFold(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEqualFold(FieldSubmittedBy, v))
+}
+
+// SubmittedByContainsFold applies the ContainsFold predicate on the "submitted_by" field.
+func SubmittedByContainsFold(v string) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldContainsFold(FieldSubmittedBy, v))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChunithmPendingAlias) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChunithmPendingAlias) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChunithmPendingAlias) predicate.ChunithmPendingAlias {
+	return predicate.ChunithmPendingAlias(sql.NotPredicates(p))
+}
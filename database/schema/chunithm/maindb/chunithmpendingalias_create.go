@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmpendingalias"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmPendingAliasCreate is the builder for creating a ChunithmPendingAlias entity.
+type ChunithmPendingAliasCreate struct {
+	config
+	mutation *ChunithmPendingAliasMutation
+	hooks    []Hook
+}
+
+// SetMusicID sets the "music_id" field.
+func (_c *ChunithmPendingAliasCreate) SetMusicID(v int) *ChunithmPendingAliasCreate {
+	_c.mutation.SetMusicID(v)
+	return _c
+}
+
+// SetAlias sets the "alias" field.
+func (_c *ChunithmPendingAliasCreate) SetAlias(v string) *ChunithmPendingAliasCreate {
+	_c.mutation.SetAlias(v)
+	return _c
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_c *ChunithmPendingAliasCreate) SetSubmittedBy(v string) *ChunithmPendingAliasCreate {
+	_c.mutation.SetSubmittedBy(v)
+	return _c
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_c *ChunithmPendingAliasCreate) SetSubmittedAt(v time.Time) *ChunithmPendingAliasCreate {
+	_c.mutation.SetSubmittedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChunithmPendingAliasCreate) SetID(v int64) *ChunithmPendingAliasCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ChunithmPendingAliasMutation object of the builder.
+func (_c *ChunithmPendingAliasCreate) Mutation() *ChunithmPendingAliasMutation {
+	return _c.mutation
+}
+
+// Save creates the ChunithmPendingAlias in the database.
+func (_c *ChunithmPendingAliasCreate) Save(ctx context.Context) (*ChunithmPendingAlias, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChunithmPendingAliasCreate) SaveX(ctx context.Context) *ChunithmPendingAlias {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmPendingAliasCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmPendingAliasCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChunithmPendingAliasCreate) check() error {
+	if _, ok := _c.mutation.MusicID(); !ok {
+		return &ValidationError{Name: "music_id", err: errors.New(`maindb: missing required field "ChunithmPendingAlias.music_id"`)}
+	}
+	if _, ok := _c.mutation.Alias(); !ok {
+		return &ValidationError{Name: "alias", err: errors.New(`maindb: missing required field "ChunithmPendingAlias.alias"`)}
+	}
+	if v, ok := _c.mutation.Alias(); ok {
+		if err := chunithmpendingalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`maindb: validator failed for field "ChunithmPendingAlias.alias": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SubmittedBy(); !ok {
+		return &ValidationError{Name: "submitted_by", err: errors.New(`maindb: missing required field "ChunithmPendingAlias.submitted_by"`)}
+	}
+	if v, ok := _c.mutation.SubmittedBy(); ok {
+		if err := chunithmpendingalias.SubmittedByValidator(v); err != nil {
+			return &ValidationError{Name: "submitted_by", err: fmt.Errorf(`maindb: validator failed for field "ChunithmPendingAlias.submitted_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SubmittedAt(); !ok {
+		return &ValidationError{Name: "submitted_at", err: errors.New(`maindb: missing required field "ChunithmPendingAlias.submitted_at"`)}
+	}
+	return nil
+}
+
+func (_c *ChunithmPendingAliasCreate) sqlSave(ctx context.Context) (*ChunithmPendingAlias, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChunithmPendingAliasCreate) createSpec() (*ChunithmPendingAlias, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChunithmPendingAlias{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chunithmpendingalias.Table, sqlgraph.NewFieldSpec(chunithmpendingalias.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.MusicID(); ok {
+		_spec.SetField(chunithmpendingalias.FieldMusicID, field.TypeInt, value)
+		_node.MusicID = value
+	}
+	if value, ok := _c.mutation.Alias(); ok {
+		_spec.SetField(chunithmpendingalias.FieldAlias, field.TypeString, value)
+		_node.Alias = value
+	}
+	if value, ok := _c.mutation.SubmittedBy(); ok {
+		_spec.SetField(chunithmpendingalias.FieldSubmittedBy, field.TypeString, value)
+		_node.SubmittedBy = value
+	}
+	if value, ok := _c.mutation.SubmittedAt(); ok {
+		_spec.SetField(chunithmpendingalias.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = value
+	}
+	return _node, _spec
+}
+
+// ChunithmPendingAliasCreateBulk is the builder for creating many ChunithmPendingAlias entities in bulk.
+type ChunithmPendingAliasCreateBulk struct {
+	config
+	err      error
+	builders []*ChunithmPendingAliasCreate
+}
+
+// Save creates the ChunithmPendingAlias entities in the database.
+func (_c *ChunithmPendingAliasCreateBulk) Save(ctx context.Context) ([]*ChunithmPendingAlias, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChunithmPendingAlias, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChunithmPendingAliasMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChunithmPendingAliasCreateBulk) SaveX(ctx context.Context) []*ChunithmPendingAlias {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmPendingAliasCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmPendingAliasCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"haruki-database/database/schema/chunithm/maindb/chunithmpendingalias"
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmPendingAliasDelete is the builder for deleting a ChunithmPendingAlias entity.
+type ChunithmPendingAliasDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmPendingAliasMutation
+}
+
+// Where appends a list predicates to the ChunithmPendingAliasDelete builder.
+func (_d *ChunithmPendingAliasDelete) Where(ps ...predicate.ChunithmPendingAlias) *ChunithmPendingAliasDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ChunithmPendingAliasDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ChunithmPendingAliasDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ChunithmPendingAliasDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(chunithmpendingalias.Table, sqlgraph.NewFieldSpec(chunithmpendingalias.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ChunithmPendingAliasDeleteOne is the builder for deleting a single ChunithmPendingAlias entity.
+type ChunithmPendingAliasDeleteOne struct {
+	_d *ChunithmPendingAliasDelete
+}
+
+// Where appends a list predicates to the ChunithmPendingAliasDelete builder.
+func (_d *ChunithmPendingAliasDeleteOne) Where(ps ...predicate.ChunithmPendingAlias) *ChunithmPendingAliasDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ChunithmPendingAliasDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{chunithmpendingalias.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ChunithmPendingAliasDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmpendingalias"
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmPendingAliasUpdate is the builder for updating ChunithmPendingAlias entities.
+type ChunithmPendingAliasUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmPendingAliasMutation
+}
+
+// Where appends a list predicates to the ChunithmPendingAliasUpdate builder.
+func (_u *ChunithmPendingAliasUpdate) Where(ps ...predicate.ChunithmPendingAlias) *ChunithmPendingAliasUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMusicID sets the "music_id" field.
+func (_u *ChunithmPendingAliasUpdate) SetMusicID(v int) *ChunithmPendingAliasUpdate {
+	_u.mutation.ResetMusicID()
+	_u.mutation.SetMusicID(v)
+	return _u
+}
+
+// SetNillableMusicID sets the "music_id" field if the given value is not nil.
+func (_u *ChunithmPendingAliasUpdate) SetNillableMusicID(v *int) *ChunithmPendingAliasUpdate {
+	if v != nil {
+		_u.SetMusicID(*v)
+	}
+	return _u
+}
+
+// AddMusicID adds value to the "music_id" field.
+func (_u *ChunithmPendingAliasUpdate) AddMusicID(v int) *ChunithmPendingAliasUpdate {
+	_u.mutation.AddMusicID(v)
+	return _u
+}
+
+// SetAlias sets the "alias" field.
+func (_u *ChunithmPendingAliasUpdate) SetAlias(v string) *ChunithmPendingAliasUpdate {
+	_u.mutation.SetAlias(v)
+	return _u
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_u *ChunithmPendingAliasUpdate) SetNillableAlias(v *string) *ChunithmPendingAliasUpdate {
+	if v != nil {
+		_u.SetAlias(*v)
+	}
+	return _u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_u *ChunithmPendingAliasUpdate) SetSubmittedBy(v string) *ChunithmPendingAliasUpdate {
+	_u.mutation.SetSubmittedBy(v)
+	return _u
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_u *ChunithmPendingAliasUpdate) SetNillableSubmittedBy(v *string) *ChunithmPendingAliasUpdate {
+	if v != nil {
+		_u.SetSubmittedBy(*v)
+	}
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *ChunithmPendingAliasUpdate) SetSubmittedAt(v time.Time) *ChunithmPendingAliasUpdate {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *ChunithmPendingAliasUpdate) SetNillableSubmittedAt(v *time.Time) *ChunithmPendingAliasUpdate {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ChunithmPendingAliasMutation object of the builder.
+func (_u *ChunithmPendingAliasUpdate) Mutation() *ChunithmPendingAliasMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChunithmPendingAliasUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmPendingAliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChunithmPendingAliasUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmPendingAliasUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmPendingAliasUpdate) check() error {
+	if v, ok := _u.mutation.Alias(); ok {
+		if err := chunithmpendingalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`maindb: validator failed for field "ChunithmPendingAlias.alias": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubmittedBy(); ok {
+		if err := chunithmpendingalias.SubmittedByValidator(v); err != nil {
+			return &ValidationError{Name: "submitted_by", err: fmt.Errorf(`maindb: validator failed for field "ChunithmPendingAlias.submitted_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmPendingAliasUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmpendingalias.Table, chunithmpendingalias.Columns, sqlgraph.NewFieldSpec(chunithmpendingalias.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MusicID(); ok {
+		_spec.SetField(chunithmpendingalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMusicID(); ok {
+		_spec.AddField(chunithmpendingalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Alias(); ok {
+		_spec.SetField(chunithmpendingalias.FieldAlias, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubmittedBy(); ok {
+		_spec.SetField(chunithmpendingalias.FieldSubmittedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(chunithmpendingalias.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmpendingalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChunithmPendingAliasUpdateOne is the builder for updating a single ChunithmPendingAlias entity.
+type ChunithmPendingAliasUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChunithmPendingAliasMutation
+}
+
+// SetMusicID sets the "music_id" field.
+func (_u *ChunithmPendingAliasUpdateOne) SetMusicID(v int) *ChunithmPendingAliasUpdateOne {
+	_u.mutation.ResetMusicID()
+	_u.mutation.SetMusicID(v)
+	return _u
+}
+
+// SetNillableMusicID sets the "music_id" field if the given value is not nil.
+func (_u *ChunithmPendingAliasUpdateOne) SetNillableMusicID(v *int) *ChunithmPendingAliasUpdateOne {
+	if v != nil {
+		_u.SetMusicID(*v)
+	}
+	return _u
+}
+
+// AddMusicID adds value to the "music_id" field.
+func (_u *ChunithmPendingAliasUpdateOne) AddMusicID(v int) *ChunithmPendingAliasUpdateOne {
+	_u.mutation.AddMusicID(v)
+	return _u
+}
+
+// SetAlias sets the "alias" field.
+func (_u *ChunithmPendingAliasUpdateOne) SetAlias(v string) *ChunithmPendingAliasUpdateOne {
+	_u.mutation.SetAlias(v)
+	return _u
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_u *ChunithmPendingAliasUpdateOne) SetNillableAlias(v *string) *ChunithmPendingAliasUpdateOne {
+	if v != nil {
+		_u.SetAlias(*v)
+	}
+	return _u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_u *ChunithmPendingAliasUpdateOne) SetSubmittedBy(v string) *ChunithmPendingAliasUpdateOne {
+	_u.mutation.SetSubmittedBy(v)
+	return _u
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_u *ChunithmPendingAliasUpdateOne) SetNillableSubmittedBy(v *string) *ChunithmPendingAliasUpdateOne {
+	if v != nil {
+		_u.SetSubmittedBy(*v)
+	}
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *ChunithmPendingAliasUpdateOne) SetSubmittedAt(v time.Time) *ChunithmPendingAliasUpdateOne {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *ChunithmPendingAliasUpdateOne) SetNillableSubmittedAt(v *time.Time) *ChunithmPendingAliasUpdateOne {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ChunithmPendingAliasMutation object of the builder.
+func (_u *ChunithmPendingAliasUpdateOne) Mutation() *ChunithmPendingAliasMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChunithmPendingAliasUpdate builder.
+func (_u *ChunithmPendingAliasUpdateOne) Where(ps ...predicate.ChunithmPendingAlias) *ChunithmPendingAliasUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChunithmPendingAliasUpdateOne) Select(field string, fields ...string) *ChunithmPendingAliasUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChunithmPendingAlias entity.
+func (_u *ChunithmPendingAliasUpdateOne) Save(ctx context.Context) (*ChunithmPendingAlias, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmPendingAliasUpdateOne) SaveX(ctx context.Context) *ChunithmPendingAlias {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChunithmPendingAliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmPendingAliasUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmPendingAliasUpdateOne) check() error {
+	if v, ok := _u.mutation.Alias(); ok {
+		if err := chunithmpendingalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`maindb: validator failed for field "ChunithmPendingAlias.alias": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SubmittedBy(); ok {
+		if err := chunithmpendingalias.SubmittedByValidator(v); err != nil {
+			return &ValidationError{Name: "submitted_by", err: fmt.Errorf(`maindb: validator failed for field "ChunithmPendingAlias.submitted_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmPendingAliasUpdateOne) sqlSave(ctx context.Context) (_node *ChunithmPendingAlias, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmpendingalias.Table, chunithmpendingalias.Columns, sqlgraph.NewFieldSpec(chunithmpendingalias.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`maindb: missing "ChunithmPendingAlias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chunithmpendingalias.FieldID)
+		for _, f := range fields {
+			if !chunithmpendingalias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("maindb: invalid field %q for query", f)}
+			}
+			if f != chunithmpendingalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MusicID(); ok {
+		_spec.SetField(chunithmpendingalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMusicID(); ok {
+		_spec.AddField(chunithmpendingalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Alias(); ok {
+		_spec.SetField(chunithmpendingalias.FieldAlias, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubmittedBy(); ok {
+		_spec.SetField(chunithmpendingalias.FieldSubmittedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(chunithmpendingalias.FieldSubmittedAt, field.TypeTime, value)
+	}
+	_node = &ChunithmPendingAlias{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmpendingalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmrejectedalias"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ChunithmRejectedAlias is the model entity for the ChunithmRejectedAlias schema.
+type ChunithmRejectedAlias struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// MusicID holds the value of the "music_id" field.
+	MusicID int `json:"music_id,omitempty"`
+	// Alias holds the value of the "alias" field.
+	Alias string `json:"alias,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt   time.Time `json:"reviewed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChunithmRejectedAlias) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chunithmrejectedalias.FieldID, chunithmrejectedalias.FieldMusicID:
+			values[i] = new(sql.NullInt64)
+		case chunithmrejectedalias.FieldAlias, chunithmrejectedalias.FieldReviewedBy, chunithmrejectedalias.FieldReason:
+			values[i] = new(sql.NullString)
+		case chunithmrejectedalias.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChunithmRejectedAlias fields.
+func (_m *ChunithmRejectedAlias) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chunithmrejectedalias.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case chunithmrejectedalias.FieldMusicID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field music_id", values[i])
+			} else if value.Valid {
+				_m.MusicID = int(value.Int64)
+			}
+		case chunithmrejectedalias.FieldAlias:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alias", values[i])
+			} else if value.Valid {
+				_m.Alias = value.String
+			}
+		case chunithmrejectedalias.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				_m.ReviewedBy = value.String
+			}
+		case chunithmrejectedalias.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case chunithmrejectedalias.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChunithmRejectedAlias.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChunithmRejectedAlias) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ChunithmRejectedAlias.
+// Note that you need to call ChunithmRejectedAlias.Unwrap() before calling this method if this ChunithmRejectedAlias
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChunithmRejectedAlias) Update() *ChunithmRejectedAliasUpdateOne {
+	return NewChunithmRejectedAliasClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChunithmRejectedAlias entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChunithmRejectedAlias) Unwrap() *ChunithmRejectedAlias {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("maindb: ChunithmRejectedAlias is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChunithmRejectedAlias) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChunithmRejectedAlias(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("music_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MusicID))
+	builder.WriteString(", ")
+	builder.WriteString("alias=")
+	builder.WriteString(_m.Alias)
+	builder.WriteString(", ")
+	builder.WriteString("reviewed_by=")
+	builder.WriteString(_m.ReviewedBy)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("reviewed_at=")
+	builder.WriteString(_m.ReviewedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChunithmRejectedAliasSlice is a parsable slice of ChunithmRejectedAlias.
+type ChunithmRejectedAliasSlice []*ChunithmRejectedAlias
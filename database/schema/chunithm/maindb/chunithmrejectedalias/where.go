@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chunithmrejectedalias
+
+import (
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLTE(FieldID, id))
+}
+
+// MusicID applies equality check predicate on the "music_id" field. It's identical to MusicIDEQ.
+func MusicID(v int) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldMusicID, v))
+}
+
+// Alias applies equality check predicate on the "alias" field. It's identical to AliasEQ.
+func Alias(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldAlias, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldReason, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// MusicIDEQ applies the EQ predicate on the "music_id" field.
+func MusicIDEQ(v int) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldMusicID, v))
+}
+
+// MusicIDNEQ applies the NEQ predicate on the "music_id" field.
+func MusicIDNEQ(v int) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNEQ(FieldMusicID, v))
+}
+
+// MusicIDIn applies the In predicate on the "music_id" field.
+func MusicIDIn(vs ...int) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldIn(FieldMusicID, vs...))
+}
+
+// MusicIDNotIn applies the NotIn predicate on the "music_id" field.
+func MusicIDNotIn(vs ...int) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNotIn(FieldMusicID, vs...))
+}
+
+// MusicIDGT applies the GT predicate on the "music_id" field.
+func MusicIDGT(v int) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGT(FieldMusicID, v))
+}
+
+// MusicIDGTE applies the GTE predicate on the "music_id" field.
+func MusicIDGTE(v int) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGTE(FieldMusicID, v))
+}
+
+// MusicIDLT applies the LT predicate on the "music_id" field.
+func MusicIDLT(v int) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLT(FieldMusicID, v))
+}
+
+// MusicIDLTE applies the LTE predicate on the "music_id" field.
+func MusicIDLTE(v int) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLTE(FieldMusicID, v))
+}
+
+// AliasEQ applies the EQ predicate on the "alias" field.
+func AliasEQ(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldAlias, v))
+}
+
+// AliasNEQ applies the NEQ predicate on the "alias" field.
+func AliasNEQ(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNEQ(FieldAlias, v))
+}
+
+// AliasIn applies the In predicate on the "alias" field.
+func AliasIn(vs ...string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldIn(FieldAlias, vs...))
+}
+
+// AliasNotIn applies the NotIn predicate on the "alias" field.
+func AliasNotIn(vs ...string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNotIn(FieldAlias, vs...))
+}
+
+// AliasGT applies the GT predicate on the "alias" field.
+func AliasGT(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGT(FieldAlias, v))
+}
+
+// AliasGTE applies the GTE predicate on the "alias" field.
+func AliasGTE(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGTE(FieldAlias, v))
+}
+
+// AliasLT applies the LT predicate on the "alias" field.
+func AliasLT(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLT(FieldAlias, v))
+}
+
+// AliasLTE applies the LTE predicate on the "alias" field.
+func AliasLTE(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLTE(FieldAlias, v))
+}
+
+// AliasContains applies the Contains predicate on the "alias" field.
+func AliasContains(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldContains(FieldAlias, v))
+}
+
+// AliasHasPrefix applies the HasPrefix predicate on the "alias" field.
+func AliasHasPrefix(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldHasPrefix(FieldAlias, v))
+}
+
+// AliasHasSuffix applies the HasSuffix predicate on the "alias" field.
+func AliasHasSuffix(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldHasSuffix(FieldAlias, v))
+}
+
+// AliasEqualFold applies the EqualFold predicate on the "alias" field.
+func AliasEqualFold(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEqualFold(FieldAlias, v))
+}
+
+// AliasContainsFold applies the ContainsFold predicate on the "alias" field.
+func AliasContainsFold(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldContainsFold(FieldAlias, v))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByContains applies the Contains predicate on the "reviewed_by" field.
+func ReviewedByContains(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldContains(FieldReviewedBy, v))
+}
+
+// ReviewedByHasPrefix applies the HasPrefix predicate on the "reviewed_by" field.
+func ReviewedByHasPrefix(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldHasPrefix(FieldReviewedBy, v))
+}
+
+// ReviewedByHasSuffix applies the HasSuffix predicate on the "reviewed_by" field.
+func ReviewedByHasSuffix(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldHasSuffix(FieldReviewedBy, v))
+}
+
+// ReviewedByEqualFold applies the EqualFold predicate on the "reviewed_by" field.
+func ReviewedByEqualFold(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEqualFold(FieldReviewedBy, v))
+}
+
+// ReviewedByContainsFold applies the ContainsFold predicate on the "reviewed_by" field.
+func ReviewedByContainsFold(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldContainsFold(FieldReviewedBy, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChunithmRejectedAlias) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChunithmRejectedAlias) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChunithmRejectedAlias) predicate.ChunithmRejectedAlias {
+	return predicate.ChunithmRejectedAlias(sql.NotPredicates(p))
+}
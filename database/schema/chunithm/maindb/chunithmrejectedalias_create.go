@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmrejectedalias"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmRejectedAliasCreate is the builder for creating a ChunithmRejectedAlias entity.
+type ChunithmRejectedAliasCreate struct {
+	config
+	mutation *ChunithmRejectedAliasMutation
+	hooks    []Hook
+}
+
+// SetMusicID sets the "music_id" field.
+func (_c *ChunithmRejectedAliasCreate) SetMusicID(v int) *ChunithmRejectedAliasCreate {
+	_c.mutation.SetMusicID(v)
+	return _c
+}
+
+// SetAlias sets the "alias" field.
+func (_c *ChunithmRejectedAliasCreate) SetAlias(v string) *ChunithmRejectedAliasCreate {
+	_c.mutation.SetAlias(v)
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *ChunithmRejectedAliasCreate) SetReviewedBy(v string) *ChunithmRejectedAliasCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ChunithmRejectedAliasCreate) SetReason(v string) *ChunithmRejectedAliasCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *ChunithmRejectedAliasCreate) SetReviewedAt(v time.Time) *ChunithmRejectedAliasCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChunithmRejectedAliasCreate) SetID(v int64) *ChunithmRejectedAliasCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ChunithmRejectedAliasMutation object of the builder.
+func (_c *ChunithmRejectedAliasCreate) Mutation() *ChunithmRejectedAliasMutation {
+	return _c.mutation
+}
+
+// Save creates the ChunithmRejectedAlias in the database.
+func (_c *ChunithmRejectedAliasCreate) Save(ctx context.Context) (*ChunithmRejectedAlias, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChunithmRejectedAliasCreate) SaveX(ctx context.Context) *ChunithmRejectedAlias {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmRejectedAliasCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmRejectedAliasCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChunithmRejectedAliasCreate) check() error {
+	if _, ok := _c.mutation.MusicID(); !ok {
+		return &ValidationError{Name: "music_id", err: errors.New(`maindb: missing required field "ChunithmRejectedAlias.music_id"`)}
+	}
+	if _, ok := _c.mutation.Alias(); !ok {
+		return &ValidationError{Name: "alias", err: errors.New(`maindb: missing required field "ChunithmRejectedAlias.alias"`)}
+	}
+	if v, ok := _c.mutation.Alias(); ok {
+		if err := chunithmrejectedalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`maindb: validator failed for field "ChunithmRejectedAlias.alias": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReviewedBy(); !ok {
+		return &ValidationError{Name: "reviewed_by", err: errors.New(`maindb: missing required field "ChunithmRejectedAlias.reviewed_by"`)}
+	}
+	if v, ok := _c.mutation.ReviewedBy(); ok {
+		if err := chunithmrejectedalias.ReviewedByValidator(v); err != nil {
+			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`maindb: validator failed for field "ChunithmRejectedAlias.reviewed_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`maindb: missing required field "ChunithmRejectedAlias.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := chunithmrejectedalias.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`maindb: validator failed for field "ChunithmRejectedAlias.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReviewedAt(); !ok {
+		return &ValidationError{Name: "reviewed_at", err: errors.New(`maindb: missing required field "ChunithmRejectedAlias.reviewed_at"`)}
+	}
+	return nil
+}
+
+func (_c *ChunithmRejectedAliasCreate) sqlSave(ctx context.Context) (*ChunithmRejectedAlias, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChunithmRejectedAliasCreate) createSpec() (*ChunithmRejectedAlias, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChunithmRejectedAlias{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chunithmrejectedalias.Table, sqlgraph.NewFieldSpec(chunithmrejectedalias.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.MusicID(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldMusicID, field.TypeInt, value)
+		_node.MusicID = value
+	}
+	if value, ok := _c.mutation.Alias(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldAlias, field.TypeString, value)
+		_node.Alias = value
+	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldReviewedBy, field.TypeString, value)
+		_node.ReviewedBy = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = value
+	}
+	return _node, _spec
+}
+
+// ChunithmRejectedAliasCreateBulk is the builder for creating many ChunithmRejectedAlias entities in bulk.
+type ChunithmRejectedAliasCreateBulk struct {
+	config
+	err      error
+	builders []*ChunithmRejectedAliasCreate
+}
+
+// Save creates the ChunithmRejectedAlias entities in the database.
+func (_c *ChunithmRejectedAliasCreateBulk) Save(ctx context.Context) ([]*ChunithmRejectedAlias, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChunithmRejectedAlias, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChunithmRejectedAliasMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChunithmRejectedAliasCreateBulk) SaveX(ctx context.Context) []*ChunithmRejectedAlias {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmRejectedAliasCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmRejectedAliasCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"haruki-database/database/schema/chunithm/maindb/chunithmrejectedalias"
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmRejectedAliasDelete is the builder for deleting a ChunithmRejectedAlias entity.
+type ChunithmRejectedAliasDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmRejectedAliasMutation
+}
+
+// Where appends a list predicates to the ChunithmRejectedAliasDelete builder.
+func (_d *ChunithmRejectedAliasDelete) Where(ps ...predicate.ChunithmRejectedAlias) *ChunithmRejectedAliasDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ChunithmRejectedAliasDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ChunithmRejectedAliasDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ChunithmRejectedAliasDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(chunithmrejectedalias.Table, sqlgraph.NewFieldSpec(chunithmrejectedalias.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ChunithmRejectedAliasDeleteOne is the builder for deleting a single ChunithmRejectedAlias entity.
+type ChunithmRejectedAliasDeleteOne struct {
+	_d *ChunithmRejectedAliasDelete
+}
+
+// Where appends a list predicates to the ChunithmRejectedAliasDelete builder.
+func (_d *ChunithmRejectedAliasDeleteOne) Where(ps ...predicate.ChunithmRejectedAlias) *ChunithmRejectedAliasDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ChunithmRejectedAliasDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{chunithmrejectedalias.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ChunithmRejectedAliasDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
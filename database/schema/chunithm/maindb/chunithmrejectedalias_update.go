@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maindb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmrejectedalias"
+	"haruki-database/database/schema/chunithm/maindb/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmRejectedAliasUpdate is the builder for updating ChunithmRejectedAlias entities.
+type ChunithmRejectedAliasUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmRejectedAliasMutation
+}
+
+// Where appends a list predicates to the ChunithmRejectedAliasUpdate builder.
+func (_u *ChunithmRejectedAliasUpdate) Where(ps ...predicate.ChunithmRejectedAlias) *ChunithmRejectedAliasUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMusicID sets the "music_id" field.
+func (_u *ChunithmRejectedAliasUpdate) SetMusicID(v int) *ChunithmRejectedAliasUpdate {
+	_u.mutation.ResetMusicID()
+	_u.mutation.SetMusicID(v)
+	return _u
+}
+
+// SetNillableMusicID sets the "music_id" field if the given value is not nil.
+func (_u *ChunithmRejectedAliasUpdate) SetNillableMusicID(v *int) *ChunithmRejectedAliasUpdate {
+	if v != nil {
+		_u.SetMusicID(*v)
+	}
+	return _u
+}
+
+// AddMusicID adds value to the "music_id" field.
+func (_u *ChunithmRejectedAliasUpdate) AddMusicID(v int) *ChunithmRejectedAliasUpdate {
+	_u.mutation.AddMusicID(v)
+	return _u
+}
+
+// SetAlias sets the "alias" field.
+func (_u *ChunithmRejectedAliasUpdate) SetAlias(v string) *ChunithmRejectedAliasUpdate {
+	_u.mutation.SetAlias(v)
+	return _u
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_u *ChunithmRejectedAliasUpdate) SetNillableAlias(v *string) *ChunithmRejectedAliasUpdate {
+	if v != nil {
+		_u.SetAlias(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *ChunithmRejectedAliasUpdate) SetReviewedBy(v string) *ChunithmRejectedAliasUpdate {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *ChunithmRejectedAliasUpdate) SetNillableReviewedBy(v *string) *ChunithmRejectedAliasUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ChunithmRejectedAliasUpdate) SetReason(v string) *ChunithmRejectedAliasUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ChunithmRejectedAliasUpdate) SetNillableReason(v *string) *ChunithmRejectedAliasUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *ChunithmRejectedAliasUpdate) SetReviewedAt(v time.Time) *ChunithmRejectedAliasUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *ChunithmRejectedAliasUpdate) SetNillableReviewedAt(v *time.Time) *ChunithmRejectedAliasUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ChunithmRejectedAliasMutation object of the builder.
+func (_u *ChunithmRejectedAliasUpdate) Mutation() *ChunithmRejectedAliasMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChunithmRejectedAliasUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmRejectedAliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChunithmRejectedAliasUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmRejectedAliasUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmRejectedAliasUpdate) check() error {
+	if v, ok := _u.mutation.Alias(); ok {
+		if err := chunithmrejectedalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`maindb: validator failed for field "ChunithmRejectedAlias.alias": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReviewedBy(); ok {
+		if err := chunithmrejectedalias.ReviewedByValidator(v); err != nil {
+			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`maindb: validator failed for field "ChunithmRejectedAlias.reviewed_by": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := chunithmrejectedalias.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`maindb: validator failed for field "ChunithmRejectedAlias.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmRejectedAliasUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmrejectedalias.Table, chunithmrejectedalias.Columns, sqlgraph.NewFieldSpec(chunithmrejectedalias.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MusicID(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMusicID(); ok {
+		_spec.AddField(chunithmrejectedalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Alias(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldAlias, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldReviewedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmrejectedalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChunithmRejectedAliasUpdateOne is the builder for updating a single ChunithmRejectedAlias entity.
+type ChunithmRejectedAliasUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChunithmRejectedAliasMutation
+}
+
+// SetMusicID sets the "music_id" field.
+func (_u *ChunithmRejectedAliasUpdateOne) SetMusicID(v int) *ChunithmRejectedAliasUpdateOne {
+	_u.mutation.ResetMusicID()
+	_u.mutation.SetMusicID(v)
+	return _u
+}
+
+// SetNillableMusicID sets the "music_id" field if the given value is not nil.
+func (_u *ChunithmRejectedAliasUpdateOne) SetNillableMusicID(v *int) *ChunithmRejectedAliasUpdateOne {
+	if v != nil {
+		_u.SetMusicID(*v)
+	}
+	return _u
+}
+
+// AddMusicID adds value to the "music_id" field.
+func (_u *ChunithmRejectedAliasUpdateOne) AddMusicID(v int) *ChunithmRejectedAliasUpdateOne {
+	_u.mutation.AddMusicID(v)
+	return _u
+}
+
+// SetAlias sets the "alias" field.
+func (_u *ChunithmRejectedAliasUpdateOne) SetAlias(v string) *ChunithmRejectedAliasUpdateOne {
+	_u.mutation.SetAlias(v)
+	return _u
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_u *ChunithmRejectedAliasUpdateOne) SetNillableAlias(v *string) *ChunithmRejectedAliasUpdateOne {
+	if v != nil {
+		_u.SetAlias(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *ChunithmRejectedAliasUpdateOne) SetReviewedBy(v string) *ChunithmRejectedAliasUpdateOne {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *ChunithmRejectedAliasUpdateOne) SetNillableReviewedBy(v *string) *ChunithmRejectedAliasUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ChunithmRejectedAliasUpdateOne) SetReason(v string) *ChunithmRejectedAliasUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ChunithmRejectedAliasUpdateOne) SetNillableReason(v *string) *ChunithmRejectedAliasUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *ChunithmRejectedAliasUpdateOne) SetReviewedAt(v time.Time) *ChunithmRejectedAliasUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *ChunithmRejectedAliasUpdateOne) SetNillableReviewedAt(v *time.Time) *ChunithmRejectedAliasUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ChunithmRejectedAliasMutation object of the builder.
+func (_u *ChunithmRejectedAliasUpdateOne) Mutation() *ChunithmRejectedAliasMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChunithmRejectedAliasUpdate builder.
+func (_u *ChunithmRejectedAliasUpdateOne) Where(ps ...predicate.ChunithmRejectedAlias) *ChunithmRejectedAliasUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChunithmRejectedAliasUpdateOne) Select(field string, fields ...string) *ChunithmRejectedAliasUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChunithmRejectedAlias entity.
+func (_u *ChunithmRejectedAliasUpdateOne) Save(ctx context.Context) (*ChunithmRejectedAlias, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmRejectedAliasUpdateOne) SaveX(ctx context.Context) *ChunithmRejectedAlias {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChunithmRejectedAliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmRejectedAliasUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmRejectedAliasUpdateOne) check() error {
+	if v, ok := _u.mutation.Alias(); ok {
+		if err := chunithmrejectedalias.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`maindb: validator failed for field "ChunithmRejectedAlias.alias": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReviewedBy(); ok {
+		if err := chunithmrejectedalias.ReviewedByValidator(v); err != nil {
+			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`maindb: validator failed for field "ChunithmRejectedAlias.reviewed_by": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := chunithmrejectedalias.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`maindb: validator failed for field "ChunithmRejectedAlias.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmRejectedAliasUpdateOne) sqlSave(ctx context.Context) (_node *ChunithmRejectedAlias, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmrejectedalias.Table, chunithmrejectedalias.Columns, sqlgraph.NewFieldSpec(chunithmrejectedalias.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`maindb: missing "ChunithmRejectedAlias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chunithmrejectedalias.FieldID)
+		for _, f := range fields {
+			if !chunithmrejectedalias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("maindb: invalid field %q for query", f)}
+			}
+			if f != chunithmrejectedalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MusicID(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMusicID(); ok {
+		_spec.AddField(chunithmrejectedalias.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Alias(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldAlias, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldReviewedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(chunithmrejectedalias.FieldReviewedAt, field.TypeTime, value)
+	}
+	_node = &ChunithmRejectedAlias{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmrejectedalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -11,9 +11,13 @@ import (
 
 	"haruki-database/database/schema/chunithm/maindb/migrate"
 
+	"haruki-database/database/schema/chunithm/maindb/chunithmaliasadmin"
 	"haruki-database/database/schema/chunithm/maindb/chunithmbinding"
 	"haruki-database/database/schema/chunithm/maindb/chunithmdefaultserver"
+	"haruki-database/database/schema/chunithm/maindb/chunithmgroupalias"
 	"haruki-database/database/schema/chunithm/maindb/chunithmmusicalias"
+	"haruki-database/database/schema/chunithm/maindb/chunithmpendingalias"
+	"haruki-database/database/schema/chunithm/maindb/chunithmrejectedalias"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -25,12 +29,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ChunithmAliasAdmin is the client for interacting with the ChunithmAliasAdmin builders.
+	ChunithmAliasAdmin *ChunithmAliasAdminClient
 	// ChunithmBinding is the client for interacting with the ChunithmBinding builders.
 	ChunithmBinding *ChunithmBindingClient
 	// ChunithmDefaultServer is the client for interacting with the ChunithmDefaultServer builders.
 	ChunithmDefaultServer *ChunithmDefaultServerClient
+	// ChunithmGroupAlias is the client for interacting with the ChunithmGroupAlias builders.
+	ChunithmGroupAlias *ChunithmGroupAliasClient
 	// ChunithmMusicAlias is the client for interacting with the ChunithmMusicAlias builders.
 	ChunithmMusicAlias *ChunithmMusicAliasClient
+	// ChunithmPendingAlias is the client for interacting with the ChunithmPendingAlias builders.
+	ChunithmPendingAlias *ChunithmPendingAliasClient
+	// ChunithmRejectedAlias is the client for interacting with the ChunithmRejectedAlias builders.
+	ChunithmRejectedAlias *ChunithmRejectedAliasClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -42,9 +54,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ChunithmAliasAdmin = NewChunithmAliasAdminClient(c.config)
 	c.ChunithmBinding = NewChunithmBindingClient(c.config)
 	c.ChunithmDefaultServer = NewChunithmDefaultServerClient(c.config)
+	c.ChunithmGroupAlias = NewChunithmGroupAliasClient(c.config)
 	c.ChunithmMusicAlias = NewChunithmMusicAliasClient(c.config)
+	c.ChunithmPendingAlias = NewChunithmPendingAliasClient(c.config)
+	c.ChunithmRejectedAlias = NewChunithmRejectedAliasClient(c.config)
 }
 
 type (
@@ -137,9 +153,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                   ctx,
 		config:                cfg,
+		ChunithmAliasAdmin:    NewChunithmAliasAdminClient(cfg),
 		ChunithmBinding:       NewChunithmBindingClient(cfg),
 		ChunithmDefaultServer: NewChunithmDefaultServerClient(cfg),
+		ChunithmGroupAlias:    NewChunithmGroupAliasClient(cfg),
 		ChunithmMusicAlias:    NewChunithmMusicAliasClient(cfg),
+		ChunithmPendingAlias:  NewChunithmPendingAliasClient(cfg),
+		ChunithmRejectedAlias: NewChunithmRejectedAliasClient(cfg),
 	}, nil
 }
 
@@ -159,16 +179,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                   ctx,
 		config:                cfg,
+		ChunithmAliasAdmin:    NewChunithmAliasAdminClient(cfg),
 		ChunithmBinding:       NewChunithmBindingClient(cfg),
 		ChunithmDefaultServer: NewChunithmDefaultServerClient(cfg),
+		ChunithmGroupAlias:    NewChunithmGroupAliasClient(cfg),
 		ChunithmMusicAlias:    NewChunithmMusicAliasClient(cfg),
+		ChunithmPendingAlias:  NewChunithmPendingAliasClient(cfg),
+		ChunithmRejectedAlias: NewChunithmRejectedAliasClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ChunithmBinding.
+//		ChunithmAliasAdmin.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -190,33 +214,182 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.ChunithmBinding.Use(hooks...)
-	c.ChunithmDefaultServer.Use(hooks...)
-	c.ChunithmMusicAlias.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.ChunithmAliasAdmin, c.ChunithmBinding, c.ChunithmDefaultServer,
+		c.ChunithmGroupAlias, c.ChunithmMusicAlias, c.ChunithmPendingAlias,
+		c.ChunithmRejectedAlias,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.ChunithmBinding.Intercept(interceptors...)
-	c.ChunithmDefaultServer.Intercept(interceptors...)
-	c.ChunithmMusicAlias.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.ChunithmAliasAdmin, c.ChunithmBinding, c.ChunithmDefaultServer,
+		c.ChunithmGroupAlias, c.ChunithmMusicAlias, c.ChunithmPendingAlias,
+		c.ChunithmRejectedAlias,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ChunithmAliasAdminMutation:
+		return c.ChunithmAliasAdmin.mutate(ctx, m)
 	case *ChunithmBindingMutation:
 		return c.ChunithmBinding.mutate(ctx, m)
 	case *ChunithmDefaultServerMutation:
 		return c.ChunithmDefaultServer.mutate(ctx, m)
+	case *ChunithmGroupAliasMutation:
+		return c.ChunithmGroupAlias.mutate(ctx, m)
 	case *ChunithmMusicAliasMutation:
 		return c.ChunithmMusicAlias.mutate(ctx, m)
+	case *ChunithmPendingAliasMutation:
+		return c.ChunithmPendingAlias.mutate(ctx, m)
+	case *ChunithmRejectedAliasMutation:
+		return c.ChunithmRejectedAlias.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("maindb: unknown mutation type %T", m)
 	}
 }
 
+// ChunithmAliasAdminClient is a client for the ChunithmAliasAdmin schema.
+type ChunithmAliasAdminClient struct {
+	config
+}
+
+// NewChunithmAliasAdminClient returns a client for the ChunithmAliasAdmin from the given config.
+func NewChunithmAliasAdminClient(c config) *ChunithmAliasAdminClient {
+	return &ChunithmAliasAdminClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chunithmaliasadmin.Hooks(f(g(h())))`.
+func (c *ChunithmAliasAdminClient) Use(hooks ...Hook) {
+	c.hooks.ChunithmAliasAdmin = append(c.hooks.ChunithmAliasAdmin, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chunithmaliasadmin.Intercept(f(g(h())))`.
+func (c *ChunithmAliasAdminClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChunithmAliasAdmin = append(c.inters.ChunithmAliasAdmin, interceptors...)
+}
+
+// Create returns a builder for creating a ChunithmAliasAdmin entity.
+func (c *ChunithmAliasAdminClient) Create() *ChunithmAliasAdminCreate {
+	mutation := newChunithmAliasAdminMutation(c.config, OpCreate)
+	return &ChunithmAliasAdminCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChunithmAliasAdmin entities.
+func (c *ChunithmAliasAdminClient) CreateBulk(builders ...*ChunithmAliasAdminCreate) *ChunithmAliasAdminCreateBulk {
+	return &ChunithmAliasAdminCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChunithmAliasAdminClient) MapCreateBulk(slice any, setFunc func(*ChunithmAliasAdminCreate, int)) *ChunithmAliasAdminCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChunithmAliasAdminCreateBulk{err: fmt.Errorf("calling to ChunithmAliasAdminClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChunithmAliasAdminCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChunithmAliasAdminCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChunithmAliasAdmin.
+func (c *ChunithmAliasAdminClient) Update() *ChunithmAliasAdminUpdate {
+	mutation := newChunithmAliasAdminMutation(c.config, OpUpdate)
+	return &ChunithmAliasAdminUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChunithmAliasAdminClient) UpdateOne(_m *ChunithmAliasAdmin) *ChunithmAliasAdminUpdateOne {
+	mutation := newChunithmAliasAdminMutation(c.config, OpUpdateOne, withChunithmAliasAdmin(_m))
+	return &ChunithmAliasAdminUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChunithmAliasAdminClient) UpdateOneID(id int) *ChunithmAliasAdminUpdateOne {
+	mutation := newChunithmAliasAdminMutation(c.config, OpUpdateOne, withChunithmAliasAdminID(id))
+	return &ChunithmAliasAdminUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChunithmAliasAdmin.
+func (c *ChunithmAliasAdminClient) Delete() *ChunithmAliasAdminDelete {
+	mutation := newChunithmAliasAdminMutation(c.config, OpDelete)
+	return &ChunithmAliasAdminDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChunithmAliasAdminClient) DeleteOne(_m *ChunithmAliasAdmin) *ChunithmAliasAdminDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChunithmAliasAdminClient) DeleteOneID(id int) *ChunithmAliasAdminDeleteOne {
+	builder := c.Delete().Where(chunithmaliasadmin.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChunithmAliasAdminDeleteOne{builder}
+}
+
+// Query returns a query builder for ChunithmAliasAdmin.
+func (c *ChunithmAliasAdminClient) Query() *ChunithmAliasAdminQuery {
+	return &ChunithmAliasAdminQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChunithmAliasAdmin},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChunithmAliasAdmin entity by its id.
+func (c *ChunithmAliasAdminClient) Get(ctx context.Context, id int) (*ChunithmAliasAdmin, error) {
+	return c.Query().Where(chunithmaliasadmin.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChunithmAliasAdminClient) GetX(ctx context.Context, id int) *ChunithmAliasAdmin {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChunithmAliasAdminClient) Hooks() []Hook {
+	return c.hooks.ChunithmAliasAdmin
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChunithmAliasAdminClient) Interceptors() []Interceptor {
+	return c.inters.ChunithmAliasAdmin
+}
+
+func (c *ChunithmAliasAdminClient) mutate(ctx context.Context, m *ChunithmAliasAdminMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChunithmAliasAdminCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChunithmAliasAdminUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChunithmAliasAdminUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChunithmAliasAdminDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("maindb: unknown ChunithmAliasAdmin mutation op: %q", m.Op())
+	}
+}
+
 // ChunithmBindingClient is a client for the ChunithmBinding schema.
 type ChunithmBindingClient struct {
 	config
@@ -483,6 +656,139 @@ func (c *ChunithmDefaultServerClient) mutate(ctx context.Context, m *ChunithmDef
 	}
 }
 
+// ChunithmGroupAliasClient is a client for the ChunithmGroupAlias schema.
+type ChunithmGroupAliasClient struct {
+	config
+}
+
+// NewChunithmGroupAliasClient returns a client for the ChunithmGroupAlias from the given config.
+func NewChunithmGroupAliasClient(c config) *ChunithmGroupAliasClient {
+	return &ChunithmGroupAliasClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chunithmgroupalias.Hooks(f(g(h())))`.
+func (c *ChunithmGroupAliasClient) Use(hooks ...Hook) {
+	c.hooks.ChunithmGroupAlias = append(c.hooks.ChunithmGroupAlias, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chunithmgroupalias.Intercept(f(g(h())))`.
+func (c *ChunithmGroupAliasClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChunithmGroupAlias = append(c.inters.ChunithmGroupAlias, interceptors...)
+}
+
+// Create returns a builder for creating a ChunithmGroupAlias entity.
+func (c *ChunithmGroupAliasClient) Create() *ChunithmGroupAliasCreate {
+	mutation := newChunithmGroupAliasMutation(c.config, OpCreate)
+	return &ChunithmGroupAliasCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChunithmGroupAlias entities.
+func (c *ChunithmGroupAliasClient) CreateBulk(builders ...*ChunithmGroupAliasCreate) *ChunithmGroupAliasCreateBulk {
+	return &ChunithmGroupAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChunithmGroupAliasClient) MapCreateBulk(slice any, setFunc func(*ChunithmGroupAliasCreate, int)) *ChunithmGroupAliasCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChunithmGroupAliasCreateBulk{err: fmt.Errorf("calling to ChunithmGroupAliasClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChunithmGroupAliasCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChunithmGroupAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChunithmGroupAlias.
+func (c *ChunithmGroupAliasClient) Update() *ChunithmGroupAliasUpdate {
+	mutation := newChunithmGroupAliasMutation(c.config, OpUpdate)
+	return &ChunithmGroupAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChunithmGroupAliasClient) UpdateOne(_m *ChunithmGroupAlias) *ChunithmGroupAliasUpdateOne {
+	mutation := newChunithmGroupAliasMutation(c.config, OpUpdateOne, withChunithmGroupAlias(_m))
+	return &ChunithmGroupAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChunithmGroupAliasClient) UpdateOneID(id int) *ChunithmGroupAliasUpdateOne {
+	mutation := newChunithmGroupAliasMutation(c.config, OpUpdateOne, withChunithmGroupAliasID(id))
+	return &ChunithmGroupAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChunithmGroupAlias.
+func (c *ChunithmGroupAliasClient) Delete() *ChunithmGroupAliasDelete {
+	mutation := newChunithmGroupAliasMutation(c.config, OpDelete)
+	return &ChunithmGroupAliasDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChunithmGroupAliasClient) DeleteOne(_m *ChunithmGroupAlias) *ChunithmGroupAliasDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChunithmGroupAliasClient) DeleteOneID(id int) *ChunithmGroupAliasDeleteOne {
+	builder := c.Delete().Where(chunithmgroupalias.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChunithmGroupAliasDeleteOne{builder}
+}
+
+// Query returns a query builder for ChunithmGroupAlias.
+func (c *ChunithmGroupAliasClient) Query() *ChunithmGroupAliasQuery {
+	return &ChunithmGroupAliasQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChunithmGroupAlias},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChunithmGroupAlias entity by its id.
+func (c *ChunithmGroupAliasClient) Get(ctx context.Context, id int) (*ChunithmGroupAlias, error) {
+	return c.Query().Where(chunithmgroupalias.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChunithmGroupAliasClient) GetX(ctx context.Context, id int) *ChunithmGroupAlias {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChunithmGroupAliasClient) Hooks() []Hook {
+	return c.hooks.ChunithmGroupAlias
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChunithmGroupAliasClient) Interceptors() []Interceptor {
+	return c.inters.ChunithmGroupAlias
+}
+
+func (c *ChunithmGroupAliasClient) mutate(ctx context.Context, m *ChunithmGroupAliasMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChunithmGroupAliasCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChunithmGroupAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChunithmGroupAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChunithmGroupAliasDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("maindb: unknown ChunithmGroupAlias mutation op: %q", m.Op())
+	}
+}
+
 // ChunithmMusicAliasClient is a client for the ChunithmMusicAlias schema.
 type ChunithmMusicAliasClient struct {
 	config
@@ -616,12 +922,281 @@ func (c *ChunithmMusicAliasClient) mutate(ctx context.Context, m *ChunithmMusicA
 	}
 }
 
+// ChunithmPendingAliasClient is a client for the ChunithmPendingAlias schema.
+type ChunithmPendingAliasClient struct {
+	config
+}
+
+// NewChunithmPendingAliasClient returns a client for the ChunithmPendingAlias from the given config.
+func NewChunithmPendingAliasClient(c config) *ChunithmPendingAliasClient {
+	return &ChunithmPendingAliasClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chunithmpendingalias.Hooks(f(g(h())))`.
+func (c *ChunithmPendingAliasClient) Use(hooks ...Hook) {
+	c.hooks.ChunithmPendingAlias = append(c.hooks.ChunithmPendingAlias, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chunithmpendingalias.Intercept(f(g(h())))`.
+func (c *ChunithmPendingAliasClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChunithmPendingAlias = append(c.inters.ChunithmPendingAlias, interceptors...)
+}
+
+// Create returns a builder for creating a ChunithmPendingAlias entity.
+func (c *ChunithmPendingAliasClient) Create() *ChunithmPendingAliasCreate {
+	mutation := newChunithmPendingAliasMutation(c.config, OpCreate)
+	return &ChunithmPendingAliasCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChunithmPendingAlias entities.
+func (c *ChunithmPendingAliasClient) CreateBulk(builders ...*ChunithmPendingAliasCreate) *ChunithmPendingAliasCreateBulk {
+	return &ChunithmPendingAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChunithmPendingAliasClient) MapCreateBulk(slice any, setFunc func(*ChunithmPendingAliasCreate, int)) *ChunithmPendingAliasCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChunithmPendingAliasCreateBulk{err: fmt.Errorf("calling to ChunithmPendingAliasClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChunithmPendingAliasCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChunithmPendingAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChunithmPendingAlias.
+func (c *ChunithmPendingAliasClient) Update() *ChunithmPendingAliasUpdate {
+	mutation := newChunithmPendingAliasMutation(c.config, OpUpdate)
+	return &ChunithmPendingAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChunithmPendingAliasClient) UpdateOne(_m *ChunithmPendingAlias) *ChunithmPendingAliasUpdateOne {
+	mutation := newChunithmPendingAliasMutation(c.config, OpUpdateOne, withChunithmPendingAlias(_m))
+	return &ChunithmPendingAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChunithmPendingAliasClient) UpdateOneID(id int64) *ChunithmPendingAliasUpdateOne {
+	mutation := newChunithmPendingAliasMutation(c.config, OpUpdateOne, withChunithmPendingAliasID(id))
+	return &ChunithmPendingAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChunithmPendingAlias.
+func (c *ChunithmPendingAliasClient) Delete() *ChunithmPendingAliasDelete {
+	mutation := newChunithmPendingAliasMutation(c.config, OpDelete)
+	return &ChunithmPendingAliasDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChunithmPendingAliasClient) DeleteOne(_m *ChunithmPendingAlias) *ChunithmPendingAliasDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChunithmPendingAliasClient) DeleteOneID(id int64) *ChunithmPendingAliasDeleteOne {
+	builder := c.Delete().Where(chunithmpendingalias.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChunithmPendingAliasDeleteOne{builder}
+}
+
+// Query returns a query builder for ChunithmPendingAlias.
+func (c *ChunithmPendingAliasClient) Query() *ChunithmPendingAliasQuery {
+	return &ChunithmPendingAliasQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChunithmPendingAlias},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChunithmPendingAlias entity by its id.
+func (c *ChunithmPendingAliasClient) Get(ctx context.Context, id int64) (*ChunithmPendingAlias, error) {
+	return c.Query().Where(chunithmpendingalias.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChunithmPendingAliasClient) GetX(ctx context.Context, id int64) *ChunithmPendingAlias {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChunithmPendingAliasClient) Hooks() []Hook {
+	return c.hooks.ChunithmPendingAlias
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChunithmPendingAliasClient) Interceptors() []Interceptor {
+	return c.inters.ChunithmPendingAlias
+}
+
+func (c *ChunithmPendingAliasClient) mutate(ctx context.Context, m *ChunithmPendingAliasMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChunithmPendingAliasCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChunithmPendingAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChunithmPendingAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChunithmPendingAliasDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("maindb: unknown ChunithmPendingAlias mutation op: %q", m.Op())
+	}
+}
+
+// ChunithmRejectedAliasClient is a client for the ChunithmRejectedAlias schema.
+type ChunithmRejectedAliasClient struct {
+	config
+}
+
+// NewChunithmRejectedAliasClient returns a client for the ChunithmRejectedAlias from the given config.
+func NewChunithmRejectedAliasClient(c config) *ChunithmRejectedAliasClient {
+	return &ChunithmRejectedAliasClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chunithmrejectedalias.Hooks(f(g(h())))`.
+func (c *ChunithmRejectedAliasClient) Use(hooks ...Hook) {
+	c.hooks.ChunithmRejectedAlias = append(c.hooks.ChunithmRejectedAlias, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chunithmrejectedalias.Intercept(f(g(h())))`.
+func (c *ChunithmRejectedAliasClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChunithmRejectedAlias = append(c.inters.ChunithmRejectedAlias, interceptors...)
+}
+
+// Create returns a builder for creating a ChunithmRejectedAlias entity.
+func (c *ChunithmRejectedAliasClient) Create() *ChunithmRejectedAliasCreate {
+	mutation := newChunithmRejectedAliasMutation(c.config, OpCreate)
+	return &ChunithmRejectedAliasCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChunithmRejectedAlias entities.
+func (c *ChunithmRejectedAliasClient) CreateBulk(builders ...*ChunithmRejectedAliasCreate) *ChunithmRejectedAliasCreateBulk {
+	return &ChunithmRejectedAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChunithmRejectedAliasClient) MapCreateBulk(slice any, setFunc func(*ChunithmRejectedAliasCreate, int)) *ChunithmRejectedAliasCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChunithmRejectedAliasCreateBulk{err: fmt.Errorf("calling to ChunithmRejectedAliasClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChunithmRejectedAliasCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChunithmRejectedAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChunithmRejectedAlias.
+func (c *ChunithmRejectedAliasClient) Update() *ChunithmRejectedAliasUpdate {
+	mutation := newChunithmRejectedAliasMutation(c.config, OpUpdate)
+	return &ChunithmRejectedAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChunithmRejectedAliasClient) UpdateOne(_m *ChunithmRejectedAlias) *ChunithmRejectedAliasUpdateOne {
+	mutation := newChunithmRejectedAliasMutation(c.config, OpUpdateOne, withChunithmRejectedAlias(_m))
+	return &ChunithmRejectedAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChunithmRejectedAliasClient) UpdateOneID(id int64) *ChunithmRejectedAliasUpdateOne {
+	mutation := newChunithmRejectedAliasMutation(c.config, OpUpdateOne, withChunithmRejectedAliasID(id))
+	return &ChunithmRejectedAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChunithmRejectedAlias.
+func (c *ChunithmRejectedAliasClient) Delete() *ChunithmRejectedAliasDelete {
+	mutation := newChunithmRejectedAliasMutation(c.config, OpDelete)
+	return &ChunithmRejectedAliasDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChunithmRejectedAliasClient) DeleteOne(_m *ChunithmRejectedAlias) *ChunithmRejectedAliasDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChunithmRejectedAliasClient) DeleteOneID(id int64) *ChunithmRejectedAliasDeleteOne {
+	builder := c.Delete().Where(chunithmrejectedalias.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChunithmRejectedAliasDeleteOne{builder}
+}
+
+// Query returns a query builder for ChunithmRejectedAlias.
+func (c *ChunithmRejectedAliasClient) Query() *ChunithmRejectedAliasQuery {
+	return &ChunithmRejectedAliasQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChunithmRejectedAlias},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChunithmRejectedAlias entity by its id.
+func (c *ChunithmRejectedAliasClient) Get(ctx context.Context, id int64) (*ChunithmRejectedAlias, error) {
+	return c.Query().Where(chunithmrejectedalias.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChunithmRejectedAliasClient) GetX(ctx context.Context, id int64) *ChunithmRejectedAlias {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChunithmRejectedAliasClient) Hooks() []Hook {
+	return c.hooks.ChunithmRejectedAlias
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChunithmRejectedAliasClient) Interceptors() []Interceptor {
+	return c.inters.ChunithmRejectedAlias
+}
+
+func (c *ChunithmRejectedAliasClient) mutate(ctx context.Context, m *ChunithmRejectedAliasMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChunithmRejectedAliasCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChunithmRejectedAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChunithmRejectedAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChunithmRejectedAliasDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("maindb: unknown ChunithmRejectedAlias mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ChunithmBinding, ChunithmDefaultServer, ChunithmMusicAlias []ent.Hook
+		ChunithmAliasAdmin, ChunithmBinding, ChunithmDefaultServer, ChunithmGroupAlias,
+		ChunithmMusicAlias, ChunithmPendingAlias, ChunithmRejectedAlias []ent.Hook
 	}
 	inters struct {
-		ChunithmBinding, ChunithmDefaultServer, ChunithmMusicAlias []ent.Interceptor
+		ChunithmAliasAdmin, ChunithmBinding, ChunithmDefaultServer, ChunithmGroupAlias,
+		ChunithmMusicAlias, ChunithmPendingAlias,
+		ChunithmRejectedAlias []ent.Interceptor
 	}
 )
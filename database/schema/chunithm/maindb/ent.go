@@ -6,9 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmaliasadmin"
 	"haruki-database/database/schema/chunithm/maindb/chunithmbinding"
 	"haruki-database/database/schema/chunithm/maindb/chunithmdefaultserver"
+	"haruki-database/database/schema/chunithm/maindb/chunithmgroupalias"
 	"haruki-database/database/schema/chunithm/maindb/chunithmmusicalias"
+	"haruki-database/database/schema/chunithm/maindb/chunithmpendingalias"
+	"haruki-database/database/schema/chunithm/maindb/chunithmrejectedalias"
 	"reflect"
 	"sync"
 
@@ -75,9 +79,13 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			chunithmaliasadmin.Table:    chunithmaliasadmin.ValidColumn,
 			chunithmbinding.Table:       chunithmbinding.ValidColumn,
 			chunithmdefaultserver.Table: chunithmdefaultserver.ValidColumn,
+			chunithmgroupalias.Table:    chunithmgroupalias.ValidColumn,
 			chunithmmusicalias.Table:    chunithmmusicalias.ValidColumn,
+			chunithmpendingalias.Table:  chunithmpendingalias.ValidColumn,
+			chunithmrejectedalias.Table: chunithmrejectedalias.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -8,6 +8,18 @@ import (
 	"haruki-database/database/schema/chunithm/maindb"
 )
 
+// The ChunithmAliasAdminFunc type is an adapter to allow the use of ordinary
+// function as ChunithmAliasAdmin mutator.
+type ChunithmAliasAdminFunc func(context.Context, *maindb.ChunithmAliasAdminMutation) (maindb.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChunithmAliasAdminFunc) Mutate(ctx context.Context, m maindb.Mutation) (maindb.Value, error) {
+	if mv, ok := m.(*maindb.ChunithmAliasAdminMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *maindb.ChunithmAliasAdminMutation", m)
+}
+
 // The ChunithmBindingFunc type is an adapter to allow the use of ordinary
 // function as ChunithmBinding mutator.
 type ChunithmBindingFunc func(context.Context, *maindb.ChunithmBindingMutation) (maindb.Value, error)
@@ -32,6 +44,18 @@ func (f ChunithmDefaultServerFunc) Mutate(ctx context.Context, m maindb.Mutation
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *maindb.ChunithmDefaultServerMutation", m)
 }
 
+// The ChunithmGroupAliasFunc type is an adapter to allow the use of ordinary
+// function as ChunithmGroupAlias mutator.
+type ChunithmGroupAliasFunc func(context.Context, *maindb.ChunithmGroupAliasMutation) (maindb.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChunithmGroupAliasFunc) Mutate(ctx context.Context, m maindb.Mutation) (maindb.Value, error) {
+	if mv, ok := m.(*maindb.ChunithmGroupAliasMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *maindb.ChunithmGroupAliasMutation", m)
+}
+
 // The ChunithmMusicAliasFunc type is an adapter to allow the use of ordinary
 // function as ChunithmMusicAlias mutator.
 type ChunithmMusicAliasFunc func(context.Context, *maindb.ChunithmMusicAliasMutation) (maindb.Value, error)
@@ -44,6 +68,30 @@ func (f ChunithmMusicAliasFunc) Mutate(ctx context.Context, m maindb.Mutation) (
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *maindb.ChunithmMusicAliasMutation", m)
 }
 
+// The ChunithmPendingAliasFunc type is an adapter to allow the use of ordinary
+// function as ChunithmPendingAlias mutator.
+type ChunithmPendingAliasFunc func(context.Context, *maindb.ChunithmPendingAliasMutation) (maindb.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChunithmPendingAliasFunc) Mutate(ctx context.Context, m maindb.Mutation) (maindb.Value, error) {
+	if mv, ok := m.(*maindb.ChunithmPendingAliasMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *maindb.ChunithmPendingAliasMutation", m)
+}
+
+// The ChunithmRejectedAliasFunc type is an adapter to allow the use of ordinary
+// function as ChunithmRejectedAlias mutator.
+type ChunithmRejectedAliasFunc func(context.Context, *maindb.ChunithmRejectedAliasMutation) (maindb.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChunithmRejectedAliasFunc) Mutate(ctx context.Context, m maindb.Mutation) (maindb.Value, error) {
+	if mv, ok := m.(*maindb.ChunithmRejectedAliasMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *maindb.ChunithmRejectedAliasMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, maindb.Mutation) bool
 
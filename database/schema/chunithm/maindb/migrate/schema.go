@@ -8,12 +8,28 @@ import (
 )
 
 var (
+	// ChunithmAliasAdminsColumns holds the columns for the "chunithm_alias_admins" table.
+	ChunithmAliasAdminsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "haruki_user_id", Type: field.TypeInt, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+	}
+	// ChunithmAliasAdminsTable holds the schema information for the "chunithm_alias_admins" table.
+	ChunithmAliasAdminsTable = &schema.Table{
+		Name:       "chunithm_alias_admins",
+		Columns:    ChunithmAliasAdminsColumns,
+		PrimaryKey: []*schema.Column{ChunithmAliasAdminsColumns[0]},
+	}
 	// ChunithmBindingsColumns holds the columns for the "chunithm_bindings" table.
 	ChunithmBindingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "haruki_user_id", Type: field.TypeInt},
 		{Name: "server", Type: field.TypeString, Size: 10},
 		{Name: "aime_id", Type: field.TypeString, Size: 50},
+		{Name: "label", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "visible", Type: field.TypeBool, Default: true},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
 	}
 	// ChunithmBindingsTable holds the schema information for the "chunithm_bindings" table.
 	ChunithmBindingsTable = &schema.Table{
@@ -22,9 +38,9 @@ var (
 		PrimaryKey: []*schema.Column{ChunithmBindingsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "chunithmbinding_haruki_user_id_server",
+				Name:    "chunithmbinding_haruki_user_id_server_aime_id",
 				Unique:  true,
-				Columns: []*schema.Column{ChunithmBindingsColumns[1], ChunithmBindingsColumns[2]},
+				Columns: []*schema.Column{ChunithmBindingsColumns[1], ChunithmBindingsColumns[2], ChunithmBindingsColumns[3]},
 			},
 		},
 	}
@@ -40,6 +56,27 @@ var (
 		Columns:    ChunithmDefaultServersColumns,
 		PrimaryKey: []*schema.Column{ChunithmDefaultServersColumns[0]},
 	}
+	// ChunithmGroupAliasColumns holds the columns for the "chunithm_group_alias" table.
+	ChunithmGroupAliasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "platform", Type: field.TypeString, Size: 20},
+		{Name: "group_id", Type: field.TypeString, Size: 50},
+		{Name: "music_id", Type: field.TypeInt},
+		{Name: "alias", Type: field.TypeString, Size: 100},
+	}
+	// ChunithmGroupAliasTable holds the schema information for the "chunithm_group_alias" table.
+	ChunithmGroupAliasTable = &schema.Table{
+		Name:       "chunithm_group_alias",
+		Columns:    ChunithmGroupAliasColumns,
+		PrimaryKey: []*schema.Column{ChunithmGroupAliasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chunithmgroupalias_platform_group_id_music_id_alias",
+				Unique:  true,
+				Columns: []*schema.Column{ChunithmGroupAliasColumns[1], ChunithmGroupAliasColumns[2], ChunithmGroupAliasColumns[3], ChunithmGroupAliasColumns[4]},
+			},
+		},
+	}
 	// ChunithmMusicAliasColumns holds the columns for the "chunithm_music_alias" table.
 	ChunithmMusicAliasColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -59,11 +96,51 @@ var (
 			},
 		},
 	}
+	// ChunithmPendingAliasColumns holds the columns for the "chunithm_pending_alias" table.
+	ChunithmPendingAliasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "music_id", Type: field.TypeInt},
+		{Name: "alias", Type: field.TypeString, Size: 100},
+		{Name: "submitted_by", Type: field.TypeString, Size: 100},
+		{Name: "submitted_at", Type: field.TypeTime},
+	}
+	// ChunithmPendingAliasTable holds the schema information for the "chunithm_pending_alias" table.
+	ChunithmPendingAliasTable = &schema.Table{
+		Name:       "chunithm_pending_alias",
+		Columns:    ChunithmPendingAliasColumns,
+		PrimaryKey: []*schema.Column{ChunithmPendingAliasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chunithmpendingalias_music_id_alias",
+				Unique:  true,
+				Columns: []*schema.Column{ChunithmPendingAliasColumns[1], ChunithmPendingAliasColumns[2]},
+			},
+		},
+	}
+	// ChunithmRejectedAliasColumns holds the columns for the "chunithm_rejected_alias" table.
+	ChunithmRejectedAliasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "music_id", Type: field.TypeInt},
+		{Name: "alias", Type: field.TypeString, Size: 100},
+		{Name: "reviewed_by", Type: field.TypeString, Size: 100},
+		{Name: "reason", Type: field.TypeString, Size: 255},
+		{Name: "reviewed_at", Type: field.TypeTime},
+	}
+	// ChunithmRejectedAliasTable holds the schema information for the "chunithm_rejected_alias" table.
+	ChunithmRejectedAliasTable = &schema.Table{
+		Name:       "chunithm_rejected_alias",
+		Columns:    ChunithmRejectedAliasColumns,
+		PrimaryKey: []*schema.Column{ChunithmRejectedAliasColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ChunithmAliasAdminsTable,
 		ChunithmBindingsTable,
 		ChunithmDefaultServersTable,
+		ChunithmGroupAliasTable,
 		ChunithmMusicAliasTable,
+		ChunithmPendingAliasTable,
+		ChunithmRejectedAliasTable,
 	}
 )
 
@@ -6,11 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"haruki-database/database/schema/chunithm/maindb/chunithmaliasadmin"
 	"haruki-database/database/schema/chunithm/maindb/chunithmbinding"
 	"haruki-database/database/schema/chunithm/maindb/chunithmdefaultserver"
+	"haruki-database/database/schema/chunithm/maindb/chunithmgroupalias"
 	"haruki-database/database/schema/chunithm/maindb/chunithmmusicalias"
+	"haruki-database/database/schema/chunithm/maindb/chunithmpendingalias"
+	"haruki-database/database/schema/chunithm/maindb/chunithmrejectedalias"
 	"haruki-database/database/schema/chunithm/maindb/predicate"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -25,38 +30,41 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeChunithmAliasAdmin    = "ChunithmAliasAdmin"
 	TypeChunithmBinding       = "ChunithmBinding"
 	TypeChunithmDefaultServer = "ChunithmDefaultServer"
+	TypeChunithmGroupAlias    = "ChunithmGroupAlias"
 	TypeChunithmMusicAlias    = "ChunithmMusicAlias"
+	TypeChunithmPendingAlias  = "ChunithmPendingAlias"
+	TypeChunithmRejectedAlias = "ChunithmRejectedAlias"
 )
 
-// ChunithmBindingMutation represents an operation that mutates the ChunithmBinding nodes in the graph.
-type ChunithmBindingMutation struct {
+// ChunithmAliasAdminMutation represents an operation that mutates the ChunithmAliasAdmin nodes in the graph.
+type ChunithmAliasAdminMutation struct {
 	config
 	op                Op
 	typ               string
 	id                *int
 	haruki_user_id    *int
 	addharuki_user_id *int
-	server            *string
-	aime_id           *string
+	name              *string
 	clearedFields     map[string]struct{}
 	done              bool
-	oldValue          func(context.Context) (*ChunithmBinding, error)
-	predicates        []predicate.ChunithmBinding
+	oldValue          func(context.Context) (*ChunithmAliasAdmin, error)
+	predicates        []predicate.ChunithmAliasAdmin
 }
 
-var _ ent.Mutation = (*ChunithmBindingMutation)(nil)
+var _ ent.Mutation = (*ChunithmAliasAdminMutation)(nil)
 
-// chunithmbindingOption allows management of the mutation configuration using functional options.
-type chunithmbindingOption func(*ChunithmBindingMutation)
+// chunithmaliasadminOption allows management of the mutation configuration using functional options.
+type chunithmaliasadminOption func(*ChunithmAliasAdminMutation)
 
-// newChunithmBindingMutation creates new mutation for the ChunithmBinding entity.
-func newChunithmBindingMutation(c config, op Op, opts ...chunithmbindingOption) *ChunithmBindingMutation {
-	m := &ChunithmBindingMutation{
+// newChunithmAliasAdminMutation creates new mutation for the ChunithmAliasAdmin entity.
+func newChunithmAliasAdminMutation(c config, op Op, opts ...chunithmaliasadminOption) *ChunithmAliasAdminMutation {
+	m := &ChunithmAliasAdminMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChunithmBinding,
+		typ:           TypeChunithmAliasAdmin,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -65,20 +73,20 @@ func newChunithmBindingMutation(c config, op Op, opts ...chunithmbindingOption)
 	return m
 }
 
-// withChunithmBindingID sets the ID field of the mutation.
-func withChunithmBindingID(id int) chunithmbindingOption {
-	return func(m *ChunithmBindingMutation) {
+// withChunithmAliasAdminID sets the ID field of the mutation.
+func withChunithmAliasAdminID(id int) chunithmaliasadminOption {
+	return func(m *ChunithmAliasAdminMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ChunithmBinding
+			value *ChunithmAliasAdmin
 		)
-		m.oldValue = func(ctx context.Context) (*ChunithmBinding, error) {
+		m.oldValue = func(ctx context.Context) (*ChunithmAliasAdmin, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ChunithmBinding.Get(ctx, id)
+					value, err = m.Client().ChunithmAliasAdmin.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -87,10 +95,10 @@ func withChunithmBindingID(id int) chunithmbindingOption {
 	}
 }
 
-// withChunithmBinding sets the old ChunithmBinding of the mutation.
-func withChunithmBinding(node *ChunithmBinding) chunithmbindingOption {
-	return func(m *ChunithmBindingMutation) {
-		m.oldValue = func(context.Context) (*ChunithmBinding, error) {
+// withChunithmAliasAdmin sets the old ChunithmAliasAdmin of the mutation.
+func withChunithmAliasAdmin(node *ChunithmAliasAdmin) chunithmaliasadminOption {
+	return func(m *ChunithmAliasAdminMutation) {
+		m.oldValue = func(context.Context) (*ChunithmAliasAdmin, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -99,7 +107,7 @@ func withChunithmBinding(node *ChunithmBinding) chunithmbindingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChunithmBindingMutation) Client() *Client {
+func (m ChunithmAliasAdminMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -107,7 +115,7 @@ func (m ChunithmBindingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChunithmBindingMutation) Tx() (*Tx, error) {
+func (m ChunithmAliasAdminMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("maindb: mutation is not running in a transaction")
 	}
@@ -118,7 +126,7 @@ func (m ChunithmBindingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChunithmBindingMutation) ID() (id int, exists bool) {
+func (m *ChunithmAliasAdminMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -129,7 +137,7 @@ func (m *ChunithmBindingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChunithmBindingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ChunithmAliasAdminMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -138,20 +146,20 @@ func (m *ChunithmBindingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChunithmBinding.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChunithmAliasAdmin.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetHarukiUserID sets the "haruki_user_id" field.
-func (m *ChunithmBindingMutation) SetHarukiUserID(i int) {
+func (m *ChunithmAliasAdminMutation) SetHarukiUserID(i int) {
 	m.haruki_user_id = &i
 	m.addharuki_user_id = nil
 }
 
 // HarukiUserID returns the value of the "haruki_user_id" field in the mutation.
-func (m *ChunithmBindingMutation) HarukiUserID() (r int, exists bool) {
+func (m *ChunithmAliasAdminMutation) HarukiUserID() (r int, exists bool) {
 	v := m.haruki_user_id
 	if v == nil {
 		return
@@ -159,10 +167,10 @@ func (m *ChunithmBindingMutation) HarukiUserID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldHarukiUserID returns the old "haruki_user_id" field's value of the ChunithmBinding entity.
-// If the ChunithmBinding object wasn't provided to the builder, the object is fetched from the database.
+// OldHarukiUserID returns the old "haruki_user_id" field's value of the ChunithmAliasAdmin entity.
+// If the ChunithmAliasAdmin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmBindingMutation) OldHarukiUserID(ctx context.Context) (v int, err error) {
+func (m *ChunithmAliasAdminMutation) OldHarukiUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldHarukiUserID is only allowed on UpdateOne operations")
 	}
@@ -177,7 +185,7 @@ func (m *ChunithmBindingMutation) OldHarukiUserID(ctx context.Context) (v int, e
 }
 
 // AddHarukiUserID adds i to the "haruki_user_id" field.
-func (m *ChunithmBindingMutation) AddHarukiUserID(i int) {
+func (m *ChunithmAliasAdminMutation) AddHarukiUserID(i int) {
 	if m.addharuki_user_id != nil {
 		*m.addharuki_user_id += i
 	} else {
@@ -186,7 +194,7 @@ func (m *ChunithmBindingMutation) AddHarukiUserID(i int) {
 }
 
 // AddedHarukiUserID returns the value that was added to the "haruki_user_id" field in this mutation.
-func (m *ChunithmBindingMutation) AddedHarukiUserID() (r int, exists bool) {
+func (m *ChunithmAliasAdminMutation) AddedHarukiUserID() (r int, exists bool) {
 	v := m.addharuki_user_id
 	if v == nil {
 		return
@@ -195,92 +203,56 @@ func (m *ChunithmBindingMutation) AddedHarukiUserID() (r int, exists bool) {
 }
 
 // ResetHarukiUserID resets all changes to the "haruki_user_id" field.
-func (m *ChunithmBindingMutation) ResetHarukiUserID() {
+func (m *ChunithmAliasAdminMutation) ResetHarukiUserID() {
 	m.haruki_user_id = nil
 	m.addharuki_user_id = nil
 }
 
-// SetServer sets the "server" field.
-func (m *ChunithmBindingMutation) SetServer(s string) {
-	m.server = &s
-}
-
-// Server returns the value of the "server" field in the mutation.
-func (m *ChunithmBindingMutation) Server() (r string, exists bool) {
-	v := m.server
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldServer returns the old "server" field's value of the ChunithmBinding entity.
-// If the ChunithmBinding object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmBindingMutation) OldServer(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServer is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServer requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServer: %w", err)
-	}
-	return oldValue.Server, nil
-}
-
-// ResetServer resets all changes to the "server" field.
-func (m *ChunithmBindingMutation) ResetServer() {
-	m.server = nil
-}
-
-// SetAimeID sets the "aime_id" field.
-func (m *ChunithmBindingMutation) SetAimeID(s string) {
-	m.aime_id = &s
+// SetName sets the "name" field.
+func (m *ChunithmAliasAdminMutation) SetName(s string) {
+	m.name = &s
 }
 
-// AimeID returns the value of the "aime_id" field in the mutation.
-func (m *ChunithmBindingMutation) AimeID() (r string, exists bool) {
-	v := m.aime_id
+// Name returns the value of the "name" field in the mutation.
+func (m *ChunithmAliasAdminMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAimeID returns the old "aime_id" field's value of the ChunithmBinding entity.
-// If the ChunithmBinding object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ChunithmAliasAdmin entity.
+// If the ChunithmAliasAdmin object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmBindingMutation) OldAimeID(ctx context.Context) (v string, err error) {
+func (m *ChunithmAliasAdminMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAimeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAimeID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAimeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.AimeID, nil
+	return oldValue.Name, nil
 }
 
-// ResetAimeID resets all changes to the "aime_id" field.
-func (m *ChunithmBindingMutation) ResetAimeID() {
-	m.aime_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *ChunithmAliasAdminMutation) ResetName() {
+	m.name = nil
 }
 
-// Where appends a list predicates to the ChunithmBindingMutation builder.
-func (m *ChunithmBindingMutation) Where(ps ...predicate.ChunithmBinding) {
+// Where appends a list predicates to the ChunithmAliasAdminMutation builder.
+func (m *ChunithmAliasAdminMutation) Where(ps ...predicate.ChunithmAliasAdmin) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChunithmBindingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChunithmAliasAdminMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChunithmBindingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChunithmBinding, len(ps))
+func (m *ChunithmAliasAdminMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChunithmAliasAdmin, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -288,33 +260,30 @@ func (m *ChunithmBindingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChunithmBindingMutation) Op() Op {
+func (m *ChunithmAliasAdminMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChunithmBindingMutation) SetOp(op Op) {
+func (m *ChunithmAliasAdminMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ChunithmBinding).
-func (m *ChunithmBindingMutation) Type() string {
+// Type returns the node type of this mutation (ChunithmAliasAdmin).
+func (m *ChunithmAliasAdminMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChunithmBindingMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *ChunithmAliasAdminMutation) Fields() []string {
+	fields := make([]string, 0, 2)
 	if m.haruki_user_id != nil {
-		fields = append(fields, chunithmbinding.FieldHarukiUserID)
+		fields = append(fields, chunithmaliasadmin.FieldHarukiUserID)
 	}
-	if m.server != nil {
-		fields = append(fields, chunithmbinding.FieldServer)
-	}
-	if m.aime_id != nil {
-		fields = append(fields, chunithmbinding.FieldAimeID)
+	if m.name != nil {
+		fields = append(fields, chunithmaliasadmin.FieldName)
 	}
 	return fields
 }
@@ -322,14 +291,12 @@ func (m *ChunithmBindingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChunithmBindingMutation) Field(name string) (ent.Value, bool) {
+func (m *ChunithmAliasAdminMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case chunithmbinding.FieldHarukiUserID:
+	case chunithmaliasadmin.FieldHarukiUserID:
 		return m.HarukiUserID()
-	case chunithmbinding.FieldServer:
-		return m.Server()
-	case chunithmbinding.FieldAimeID:
-		return m.AimeID()
+	case chunithmaliasadmin.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
@@ -337,54 +304,45 @@ func (m *ChunithmBindingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChunithmBindingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChunithmAliasAdminMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case chunithmbinding.FieldHarukiUserID:
+	case chunithmaliasadmin.FieldHarukiUserID:
 		return m.OldHarukiUserID(ctx)
-	case chunithmbinding.FieldServer:
-		return m.OldServer(ctx)
-	case chunithmbinding.FieldAimeID:
-		return m.OldAimeID(ctx)
+	case chunithmaliasadmin.FieldName:
+		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChunithmBinding field %s", name)
+	return nil, fmt.Errorf("unknown ChunithmAliasAdmin field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChunithmBindingMutation) SetField(name string, value ent.Value) error {
+func (m *ChunithmAliasAdminMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case chunithmbinding.FieldHarukiUserID:
+	case chunithmaliasadmin.FieldHarukiUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetHarukiUserID(v)
 		return nil
-	case chunithmbinding.FieldServer:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetServer(v)
-		return nil
-	case chunithmbinding.FieldAimeID:
+	case chunithmaliasadmin.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAimeID(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmBinding field %s", name)
+	return fmt.Errorf("unknown ChunithmAliasAdmin field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChunithmBindingMutation) AddedFields() []string {
+func (m *ChunithmAliasAdminMutation) AddedFields() []string {
 	var fields []string
 	if m.addharuki_user_id != nil {
-		fields = append(fields, chunithmbinding.FieldHarukiUserID)
+		fields = append(fields, chunithmaliasadmin.FieldHarukiUserID)
 	}
 	return fields
 }
@@ -392,9 +350,9 @@ func (m *ChunithmBindingMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChunithmBindingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChunithmAliasAdminMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case chunithmbinding.FieldHarukiUserID:
+	case chunithmaliasadmin.FieldHarukiUserID:
 		return m.AddedHarukiUserID()
 	}
 	return nil, false
@@ -403,9 +361,9 @@ func (m *ChunithmBindingMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChunithmBindingMutation) AddField(name string, value ent.Value) error {
+func (m *ChunithmAliasAdminMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case chunithmbinding.FieldHarukiUserID:
+	case chunithmaliasadmin.FieldHarukiUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -413,95 +371,92 @@ func (m *ChunithmBindingMutation) AddField(name string, value ent.Value) error {
 		m.AddHarukiUserID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmBinding numeric field %s", name)
+	return fmt.Errorf("unknown ChunithmAliasAdmin numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChunithmBindingMutation) ClearedFields() []string {
+func (m *ChunithmAliasAdminMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChunithmBindingMutation) FieldCleared(name string) bool {
+func (m *ChunithmAliasAdminMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChunithmBindingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ChunithmBinding nullable field %s", name)
+func (m *ChunithmAliasAdminMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChunithmAliasAdmin nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChunithmBindingMutation) ResetField(name string) error {
+func (m *ChunithmAliasAdminMutation) ResetField(name string) error {
 	switch name {
-	case chunithmbinding.FieldHarukiUserID:
+	case chunithmaliasadmin.FieldHarukiUserID:
 		m.ResetHarukiUserID()
 		return nil
-	case chunithmbinding.FieldServer:
-		m.ResetServer()
-		return nil
-	case chunithmbinding.FieldAimeID:
-		m.ResetAimeID()
+	case chunithmaliasadmin.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmBinding field %s", name)
+	return fmt.Errorf("unknown ChunithmAliasAdmin field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChunithmBindingMutation) AddedEdges() []string {
+func (m *ChunithmAliasAdminMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChunithmBindingMutation) AddedIDs(name string) []ent.Value {
+func (m *ChunithmAliasAdminMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChunithmBindingMutation) RemovedEdges() []string {
+func (m *ChunithmAliasAdminMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChunithmBindingMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChunithmAliasAdminMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChunithmBindingMutation) ClearedEdges() []string {
+func (m *ChunithmAliasAdminMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChunithmBindingMutation) EdgeCleared(name string) bool {
+func (m *ChunithmAliasAdminMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChunithmBindingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ChunithmBinding unique edge %s", name)
+func (m *ChunithmAliasAdminMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmAliasAdmin unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChunithmBindingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ChunithmBinding edge %s", name)
+func (m *ChunithmAliasAdminMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmAliasAdmin edge %s", name)
 }
 
-// ChunithmDefaultServerMutation represents an operation that mutates the ChunithmDefaultServer nodes in the graph.
-type ChunithmDefaultServerMutation struct {
+// ChunithmBindingMutation represents an operation that mutates the ChunithmBinding nodes in the graph.
+type ChunithmBindingMutation struct {
 	config
 	op                Op
 	typ               string
@@ -509,23 +464,29 @@ type ChunithmDefaultServerMutation struct {
 	haruki_user_id    *int
 	addharuki_user_id *int
 	server            *string
+	aime_id           *string
+	label             *string
+	visible           *bool
+	is_default        *bool
+	sort_order        *int
+	addsort_order     *int
 	clearedFields     map[string]struct{}
 	done              bool
-	oldValue          func(context.Context) (*ChunithmDefaultServer, error)
-	predicates        []predicate.ChunithmDefaultServer
+	oldValue          func(context.Context) (*ChunithmBinding, error)
+	predicates        []predicate.ChunithmBinding
 }
 
-var _ ent.Mutation = (*ChunithmDefaultServerMutation)(nil)
+var _ ent.Mutation = (*ChunithmBindingMutation)(nil)
 
-// chunithmdefaultserverOption allows management of the mutation configuration using functional options.
-type chunithmdefaultserverOption func(*ChunithmDefaultServerMutation)
+// chunithmbindingOption allows management of the mutation configuration using functional options.
+type chunithmbindingOption func(*ChunithmBindingMutation)
 
-// newChunithmDefaultServerMutation creates new mutation for the ChunithmDefaultServer entity.
-func newChunithmDefaultServerMutation(c config, op Op, opts ...chunithmdefaultserverOption) *ChunithmDefaultServerMutation {
-	m := &ChunithmDefaultServerMutation{
+// newChunithmBindingMutation creates new mutation for the ChunithmBinding entity.
+func newChunithmBindingMutation(c config, op Op, opts ...chunithmbindingOption) *ChunithmBindingMutation {
+	m := &ChunithmBindingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChunithmDefaultServer,
+		typ:           TypeChunithmBinding,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -534,20 +495,20 @@ func newChunithmDefaultServerMutation(c config, op Op, opts ...chunithmdefaultse
 	return m
 }
 
-// withChunithmDefaultServerID sets the ID field of the mutation.
-func withChunithmDefaultServerID(id int) chunithmdefaultserverOption {
-	return func(m *ChunithmDefaultServerMutation) {
+// withChunithmBindingID sets the ID field of the mutation.
+func withChunithmBindingID(id int) chunithmbindingOption {
+	return func(m *ChunithmBindingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ChunithmDefaultServer
+			value *ChunithmBinding
 		)
-		m.oldValue = func(ctx context.Context) (*ChunithmDefaultServer, error) {
+		m.oldValue = func(ctx context.Context) (*ChunithmBinding, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ChunithmDefaultServer.Get(ctx, id)
+					value, err = m.Client().ChunithmBinding.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -556,10 +517,10 @@ func withChunithmDefaultServerID(id int) chunithmdefaultserverOption {
 	}
 }
 
-// withChunithmDefaultServer sets the old ChunithmDefaultServer of the mutation.
-func withChunithmDefaultServer(node *ChunithmDefaultServer) chunithmdefaultserverOption {
-	return func(m *ChunithmDefaultServerMutation) {
-		m.oldValue = func(context.Context) (*ChunithmDefaultServer, error) {
+// withChunithmBinding sets the old ChunithmBinding of the mutation.
+func withChunithmBinding(node *ChunithmBinding) chunithmbindingOption {
+	return func(m *ChunithmBindingMutation) {
+		m.oldValue = func(context.Context) (*ChunithmBinding, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -568,7 +529,7 @@ func withChunithmDefaultServer(node *ChunithmDefaultServer) chunithmdefaultserve
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChunithmDefaultServerMutation) Client() *Client {
+func (m ChunithmBindingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -576,7 +537,7 @@ func (m ChunithmDefaultServerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChunithmDefaultServerMutation) Tx() (*Tx, error) {
+func (m ChunithmBindingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("maindb: mutation is not running in a transaction")
 	}
@@ -587,7 +548,7 @@ func (m ChunithmDefaultServerMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChunithmDefaultServerMutation) ID() (id int, exists bool) {
+func (m *ChunithmBindingMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -598,7 +559,7 @@ func (m *ChunithmDefaultServerMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChunithmDefaultServerMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ChunithmBindingMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -607,20 +568,20 @@ func (m *ChunithmDefaultServerMutation) IDs(ctx context.Context) ([]int, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChunithmDefaultServer.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChunithmBinding.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetHarukiUserID sets the "haruki_user_id" field.
-func (m *ChunithmDefaultServerMutation) SetHarukiUserID(i int) {
+func (m *ChunithmBindingMutation) SetHarukiUserID(i int) {
 	m.haruki_user_id = &i
 	m.addharuki_user_id = nil
 }
 
 // HarukiUserID returns the value of the "haruki_user_id" field in the mutation.
-func (m *ChunithmDefaultServerMutation) HarukiUserID() (r int, exists bool) {
+func (m *ChunithmBindingMutation) HarukiUserID() (r int, exists bool) {
 	v := m.haruki_user_id
 	if v == nil {
 		return
@@ -628,10 +589,10 @@ func (m *ChunithmDefaultServerMutation) HarukiUserID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldHarukiUserID returns the old "haruki_user_id" field's value of the ChunithmDefaultServer entity.
-// If the ChunithmDefaultServer object wasn't provided to the builder, the object is fetched from the database.
+// OldHarukiUserID returns the old "haruki_user_id" field's value of the ChunithmBinding entity.
+// If the ChunithmBinding object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmDefaultServerMutation) OldHarukiUserID(ctx context.Context) (v int, err error) {
+func (m *ChunithmBindingMutation) OldHarukiUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldHarukiUserID is only allowed on UpdateOne operations")
 	}
@@ -646,7 +607,7 @@ func (m *ChunithmDefaultServerMutation) OldHarukiUserID(ctx context.Context) (v
 }
 
 // AddHarukiUserID adds i to the "haruki_user_id" field.
-func (m *ChunithmDefaultServerMutation) AddHarukiUserID(i int) {
+func (m *ChunithmBindingMutation) AddHarukiUserID(i int) {
 	if m.addharuki_user_id != nil {
 		*m.addharuki_user_id += i
 	} else {
@@ -655,7 +616,7 @@ func (m *ChunithmDefaultServerMutation) AddHarukiUserID(i int) {
 }
 
 // AddedHarukiUserID returns the value that was added to the "haruki_user_id" field in this mutation.
-func (m *ChunithmDefaultServerMutation) AddedHarukiUserID() (r int, exists bool) {
+func (m *ChunithmBindingMutation) AddedHarukiUserID() (r int, exists bool) {
 	v := m.addharuki_user_id
 	if v == nil {
 		return
@@ -664,18 +625,18 @@ func (m *ChunithmDefaultServerMutation) AddedHarukiUserID() (r int, exists bool)
 }
 
 // ResetHarukiUserID resets all changes to the "haruki_user_id" field.
-func (m *ChunithmDefaultServerMutation) ResetHarukiUserID() {
+func (m *ChunithmBindingMutation) ResetHarukiUserID() {
 	m.haruki_user_id = nil
 	m.addharuki_user_id = nil
 }
 
 // SetServer sets the "server" field.
-func (m *ChunithmDefaultServerMutation) SetServer(s string) {
+func (m *ChunithmBindingMutation) SetServer(s string) {
 	m.server = &s
 }
 
 // Server returns the value of the "server" field in the mutation.
-func (m *ChunithmDefaultServerMutation) Server() (r string, exists bool) {
+func (m *ChunithmBindingMutation) Server() (r string, exists bool) {
 	v := m.server
 	if v == nil {
 		return
@@ -683,10 +644,10 @@ func (m *ChunithmDefaultServerMutation) Server() (r string, exists bool) {
 	return *v, true
 }
 
-// OldServer returns the old "server" field's value of the ChunithmDefaultServer entity.
-// If the ChunithmDefaultServer object wasn't provided to the builder, the object is fetched from the database.
+// OldServer returns the old "server" field's value of the ChunithmBinding entity.
+// If the ChunithmBinding object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmDefaultServerMutation) OldServer(ctx context.Context) (v string, err error) {
+func (m *ChunithmBindingMutation) OldServer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldServer is only allowed on UpdateOne operations")
 	}
@@ -701,50 +662,2233 @@ func (m *ChunithmDefaultServerMutation) OldServer(ctx context.Context) (v string
 }
 
 // ResetServer resets all changes to the "server" field.
-func (m *ChunithmDefaultServerMutation) ResetServer() {
+func (m *ChunithmBindingMutation) ResetServer() {
 	m.server = nil
 }
 
-// Where appends a list predicates to the ChunithmDefaultServerMutation builder.
-func (m *ChunithmDefaultServerMutation) Where(ps ...predicate.ChunithmDefaultServer) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the ChunithmDefaultServerMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChunithmDefaultServerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChunithmDefaultServer, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// SetAimeID sets the "aime_id" field.
+func (m *ChunithmBindingMutation) SetAimeID(s string) {
+	m.aime_id = &s
+}
+
+// AimeID returns the value of the "aime_id" field in the mutation.
+func (m *ChunithmBindingMutation) AimeID() (r string, exists bool) {
+	v := m.aime_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAimeID returns the old "aime_id" field's value of the ChunithmBinding entity.
+// If the ChunithmBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmBindingMutation) OldAimeID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAimeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAimeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAimeID: %w", err)
+	}
+	return oldValue.AimeID, nil
+}
+
+// ResetAimeID resets all changes to the "aime_id" field.
+func (m *ChunithmBindingMutation) ResetAimeID() {
+	m.aime_id = nil
+}
+
+// SetLabel sets the "label" field.
+func (m *ChunithmBindingMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *ChunithmBindingMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the ChunithmBinding entity.
+// If the ChunithmBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmBindingMutation) OldLabel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *ChunithmBindingMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[chunithmbinding.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *ChunithmBindingMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[chunithmbinding.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *ChunithmBindingMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, chunithmbinding.FieldLabel)
+}
+
+// SetVisible sets the "visible" field.
+func (m *ChunithmBindingMutation) SetVisible(b bool) {
+	m.visible = &b
+}
+
+// Visible returns the value of the "visible" field in the mutation.
+func (m *ChunithmBindingMutation) Visible() (r bool, exists bool) {
+	v := m.visible
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisible returns the old "visible" field's value of the ChunithmBinding entity.
+// If the ChunithmBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmBindingMutation) OldVisible(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisible is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisible requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisible: %w", err)
+	}
+	return oldValue.Visible, nil
+}
+
+// ResetVisible resets all changes to the "visible" field.
+func (m *ChunithmBindingMutation) ResetVisible() {
+	m.visible = nil
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *ChunithmBindingMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *ChunithmBindingMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the ChunithmBinding entity.
+// If the ChunithmBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmBindingMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *ChunithmBindingMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (m *ChunithmBindingMutation) SetSortOrder(i int) {
+	m.sort_order = &i
+	m.addsort_order = nil
+}
+
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *ChunithmBindingMutation) SortOrder() (r int, exists bool) {
+	v := m.sort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSortOrder returns the old "sort_order" field's value of the ChunithmBinding entity.
+// If the ChunithmBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmBindingMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
+	}
+	return oldValue.SortOrder, nil
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (m *ChunithmBindingMutation) AddSortOrder(i int) {
+	if m.addsort_order != nil {
+		*m.addsort_order += i
+	} else {
+		m.addsort_order = &i
+	}
+}
+
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *ChunithmBindingMutation) AddedSortOrder() (r int, exists bool) {
+	v := m.addsort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *ChunithmBindingMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
+}
+
+// Where appends a list predicates to the ChunithmBindingMutation builder.
+func (m *ChunithmBindingMutation) Where(ps ...predicate.ChunithmBinding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChunithmBindingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChunithmBindingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChunithmBinding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChunithmBindingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChunithmBindingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChunithmBinding).
+func (m *ChunithmBindingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChunithmBindingMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.haruki_user_id != nil {
+		fields = append(fields, chunithmbinding.FieldHarukiUserID)
+	}
+	if m.server != nil {
+		fields = append(fields, chunithmbinding.FieldServer)
+	}
+	if m.aime_id != nil {
+		fields = append(fields, chunithmbinding.FieldAimeID)
+	}
+	if m.label != nil {
+		fields = append(fields, chunithmbinding.FieldLabel)
+	}
+	if m.visible != nil {
+		fields = append(fields, chunithmbinding.FieldVisible)
+	}
+	if m.is_default != nil {
+		fields = append(fields, chunithmbinding.FieldIsDefault)
+	}
+	if m.sort_order != nil {
+		fields = append(fields, chunithmbinding.FieldSortOrder)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChunithmBindingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmbinding.FieldHarukiUserID:
+		return m.HarukiUserID()
+	case chunithmbinding.FieldServer:
+		return m.Server()
+	case chunithmbinding.FieldAimeID:
+		return m.AimeID()
+	case chunithmbinding.FieldLabel:
+		return m.Label()
+	case chunithmbinding.FieldVisible:
+		return m.Visible()
+	case chunithmbinding.FieldIsDefault:
+		return m.IsDefault()
+	case chunithmbinding.FieldSortOrder:
+		return m.SortOrder()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChunithmBindingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chunithmbinding.FieldHarukiUserID:
+		return m.OldHarukiUserID(ctx)
+	case chunithmbinding.FieldServer:
+		return m.OldServer(ctx)
+	case chunithmbinding.FieldAimeID:
+		return m.OldAimeID(ctx)
+	case chunithmbinding.FieldLabel:
+		return m.OldLabel(ctx)
+	case chunithmbinding.FieldVisible:
+		return m.OldVisible(ctx)
+	case chunithmbinding.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case chunithmbinding.FieldSortOrder:
+		return m.OldSortOrder(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChunithmBinding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmBindingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chunithmbinding.FieldHarukiUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHarukiUserID(v)
+		return nil
+	case chunithmbinding.FieldServer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServer(v)
+		return nil
+	case chunithmbinding.FieldAimeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAimeID(v)
+		return nil
+	case chunithmbinding.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case chunithmbinding.FieldVisible:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisible(v)
+		return nil
+	case chunithmbinding.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	case chunithmbinding.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortOrder(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmBinding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChunithmBindingMutation) AddedFields() []string {
+	var fields []string
+	if m.addharuki_user_id != nil {
+		fields = append(fields, chunithmbinding.FieldHarukiUserID)
+	}
+	if m.addsort_order != nil {
+		fields = append(fields, chunithmbinding.FieldSortOrder)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChunithmBindingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmbinding.FieldHarukiUserID:
+		return m.AddedHarukiUserID()
+	case chunithmbinding.FieldSortOrder:
+		return m.AddedSortOrder()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmBindingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chunithmbinding.FieldHarukiUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHarukiUserID(v)
+		return nil
+	case chunithmbinding.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmBinding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChunithmBindingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(chunithmbinding.FieldLabel) {
+		fields = append(fields, chunithmbinding.FieldLabel)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChunithmBindingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChunithmBindingMutation) ClearField(name string) error {
+	switch name {
+	case chunithmbinding.FieldLabel:
+		m.ClearLabel()
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmBinding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChunithmBindingMutation) ResetField(name string) error {
+	switch name {
+	case chunithmbinding.FieldHarukiUserID:
+		m.ResetHarukiUserID()
+		return nil
+	case chunithmbinding.FieldServer:
+		m.ResetServer()
+		return nil
+	case chunithmbinding.FieldAimeID:
+		m.ResetAimeID()
+		return nil
+	case chunithmbinding.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case chunithmbinding.FieldVisible:
+		m.ResetVisible()
+		return nil
+	case chunithmbinding.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	case chunithmbinding.FieldSortOrder:
+		m.ResetSortOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmBinding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChunithmBindingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChunithmBindingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChunithmBindingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChunithmBindingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChunithmBindingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChunithmBindingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChunithmBindingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmBinding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChunithmBindingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmBinding edge %s", name)
+}
+
+// ChunithmDefaultServerMutation represents an operation that mutates the ChunithmDefaultServer nodes in the graph.
+type ChunithmDefaultServerMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	haruki_user_id    *int
+	addharuki_user_id *int
+	server            *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*ChunithmDefaultServer, error)
+	predicates        []predicate.ChunithmDefaultServer
+}
+
+var _ ent.Mutation = (*ChunithmDefaultServerMutation)(nil)
+
+// chunithmdefaultserverOption allows management of the mutation configuration using functional options.
+type chunithmdefaultserverOption func(*ChunithmDefaultServerMutation)
+
+// newChunithmDefaultServerMutation creates new mutation for the ChunithmDefaultServer entity.
+func newChunithmDefaultServerMutation(c config, op Op, opts ...chunithmdefaultserverOption) *ChunithmDefaultServerMutation {
+	m := &ChunithmDefaultServerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChunithmDefaultServer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChunithmDefaultServerID sets the ID field of the mutation.
+func withChunithmDefaultServerID(id int) chunithmdefaultserverOption {
+	return func(m *ChunithmDefaultServerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChunithmDefaultServer
+		)
+		m.oldValue = func(ctx context.Context) (*ChunithmDefaultServer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChunithmDefaultServer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChunithmDefaultServer sets the old ChunithmDefaultServer of the mutation.
+func withChunithmDefaultServer(node *ChunithmDefaultServer) chunithmdefaultserverOption {
+	return func(m *ChunithmDefaultServerMutation) {
+		m.oldValue = func(context.Context) (*ChunithmDefaultServer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChunithmDefaultServerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChunithmDefaultServerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("maindb: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChunithmDefaultServerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChunithmDefaultServerMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChunithmDefaultServer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (m *ChunithmDefaultServerMutation) SetHarukiUserID(i int) {
+	m.haruki_user_id = &i
+	m.addharuki_user_id = nil
+}
+
+// HarukiUserID returns the value of the "haruki_user_id" field in the mutation.
+func (m *ChunithmDefaultServerMutation) HarukiUserID() (r int, exists bool) {
+	v := m.haruki_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHarukiUserID returns the old "haruki_user_id" field's value of the ChunithmDefaultServer entity.
+// If the ChunithmDefaultServer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmDefaultServerMutation) OldHarukiUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHarukiUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHarukiUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHarukiUserID: %w", err)
+	}
+	return oldValue.HarukiUserID, nil
+}
+
+// AddHarukiUserID adds i to the "haruki_user_id" field.
+func (m *ChunithmDefaultServerMutation) AddHarukiUserID(i int) {
+	if m.addharuki_user_id != nil {
+		*m.addharuki_user_id += i
+	} else {
+		m.addharuki_user_id = &i
+	}
+}
+
+// AddedHarukiUserID returns the value that was added to the "haruki_user_id" field in this mutation.
+func (m *ChunithmDefaultServerMutation) AddedHarukiUserID() (r int, exists bool) {
+	v := m.addharuki_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHarukiUserID resets all changes to the "haruki_user_id" field.
+func (m *ChunithmDefaultServerMutation) ResetHarukiUserID() {
+	m.haruki_user_id = nil
+	m.addharuki_user_id = nil
+}
+
+// SetServer sets the "server" field.
+func (m *ChunithmDefaultServerMutation) SetServer(s string) {
+	m.server = &s
+}
+
+// Server returns the value of the "server" field in the mutation.
+func (m *ChunithmDefaultServerMutation) Server() (r string, exists bool) {
+	v := m.server
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServer returns the old "server" field's value of the ChunithmDefaultServer entity.
+// If the ChunithmDefaultServer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmDefaultServerMutation) OldServer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServer: %w", err)
+	}
+	return oldValue.Server, nil
+}
+
+// ResetServer resets all changes to the "server" field.
+func (m *ChunithmDefaultServerMutation) ResetServer() {
+	m.server = nil
+}
+
+// Where appends a list predicates to the ChunithmDefaultServerMutation builder.
+func (m *ChunithmDefaultServerMutation) Where(ps ...predicate.ChunithmDefaultServer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChunithmDefaultServerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChunithmDefaultServerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChunithmDefaultServer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChunithmDefaultServerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChunithmDefaultServerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChunithmDefaultServer).
+func (m *ChunithmDefaultServerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChunithmDefaultServerMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.haruki_user_id != nil {
+		fields = append(fields, chunithmdefaultserver.FieldHarukiUserID)
+	}
+	if m.server != nil {
+		fields = append(fields, chunithmdefaultserver.FieldServer)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChunithmDefaultServerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmdefaultserver.FieldHarukiUserID:
+		return m.HarukiUserID()
+	case chunithmdefaultserver.FieldServer:
+		return m.Server()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChunithmDefaultServerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chunithmdefaultserver.FieldHarukiUserID:
+		return m.OldHarukiUserID(ctx)
+	case chunithmdefaultserver.FieldServer:
+		return m.OldServer(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChunithmDefaultServer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmDefaultServerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chunithmdefaultserver.FieldHarukiUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHarukiUserID(v)
+		return nil
+	case chunithmdefaultserver.FieldServer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServer(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmDefaultServer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChunithmDefaultServerMutation) AddedFields() []string {
+	var fields []string
+	if m.addharuki_user_id != nil {
+		fields = append(fields, chunithmdefaultserver.FieldHarukiUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChunithmDefaultServerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmdefaultserver.FieldHarukiUserID:
+		return m.AddedHarukiUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmDefaultServerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chunithmdefaultserver.FieldHarukiUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHarukiUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmDefaultServer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChunithmDefaultServerMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChunithmDefaultServerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChunithmDefaultServerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChunithmDefaultServer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChunithmDefaultServerMutation) ResetField(name string) error {
+	switch name {
+	case chunithmdefaultserver.FieldHarukiUserID:
+		m.ResetHarukiUserID()
+		return nil
+	case chunithmdefaultserver.FieldServer:
+		m.ResetServer()
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmDefaultServer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChunithmDefaultServerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChunithmDefaultServerMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChunithmDefaultServerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChunithmDefaultServerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChunithmDefaultServerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChunithmDefaultServerMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChunithmDefaultServerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmDefaultServer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChunithmDefaultServerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmDefaultServer edge %s", name)
+}
+
+// ChunithmGroupAliasMutation represents an operation that mutates the ChunithmGroupAlias nodes in the graph.
+type ChunithmGroupAliasMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	platform      *string
+	group_id      *string
+	music_id      *int
+	addmusic_id   *int
+	alias         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ChunithmGroupAlias, error)
+	predicates    []predicate.ChunithmGroupAlias
+}
+
+var _ ent.Mutation = (*ChunithmGroupAliasMutation)(nil)
+
+// chunithmgroupaliasOption allows management of the mutation configuration using functional options.
+type chunithmgroupaliasOption func(*ChunithmGroupAliasMutation)
+
+// newChunithmGroupAliasMutation creates new mutation for the ChunithmGroupAlias entity.
+func newChunithmGroupAliasMutation(c config, op Op, opts ...chunithmgroupaliasOption) *ChunithmGroupAliasMutation {
+	m := &ChunithmGroupAliasMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChunithmGroupAlias,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChunithmGroupAliasID sets the ID field of the mutation.
+func withChunithmGroupAliasID(id int) chunithmgroupaliasOption {
+	return func(m *ChunithmGroupAliasMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChunithmGroupAlias
+		)
+		m.oldValue = func(ctx context.Context) (*ChunithmGroupAlias, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChunithmGroupAlias.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChunithmGroupAlias sets the old ChunithmGroupAlias of the mutation.
+func withChunithmGroupAlias(node *ChunithmGroupAlias) chunithmgroupaliasOption {
+	return func(m *ChunithmGroupAliasMutation) {
+		m.oldValue = func(context.Context) (*ChunithmGroupAlias, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChunithmGroupAliasMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChunithmGroupAliasMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("maindb: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChunithmGroupAliasMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChunithmGroupAliasMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChunithmGroupAlias.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPlatform sets the "platform" field.
+func (m *ChunithmGroupAliasMutation) SetPlatform(s string) {
+	m.platform = &s
+}
+
+// Platform returns the value of the "platform" field in the mutation.
+func (m *ChunithmGroupAliasMutation) Platform() (r string, exists bool) {
+	v := m.platform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatform returns the old "platform" field's value of the ChunithmGroupAlias entity.
+// If the ChunithmGroupAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmGroupAliasMutation) OldPlatform(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+	}
+	return oldValue.Platform, nil
+}
+
+// ResetPlatform resets all changes to the "platform" field.
+func (m *ChunithmGroupAliasMutation) ResetPlatform() {
+	m.platform = nil
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *ChunithmGroupAliasMutation) SetGroupID(s string) {
+	m.group_id = &s
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *ChunithmGroupAliasMutation) GroupID() (r string, exists bool) {
+	v := m.group_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the ChunithmGroupAlias entity.
+// If the ChunithmGroupAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmGroupAliasMutation) OldGroupID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *ChunithmGroupAliasMutation) ResetGroupID() {
+	m.group_id = nil
+}
+
+// SetMusicID sets the "music_id" field.
+func (m *ChunithmGroupAliasMutation) SetMusicID(i int) {
+	m.music_id = &i
+	m.addmusic_id = nil
+}
+
+// MusicID returns the value of the "music_id" field in the mutation.
+func (m *ChunithmGroupAliasMutation) MusicID() (r int, exists bool) {
+	v := m.music_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMusicID returns the old "music_id" field's value of the ChunithmGroupAlias entity.
+// If the ChunithmGroupAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmGroupAliasMutation) OldMusicID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMusicID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMusicID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMusicID: %w", err)
+	}
+	return oldValue.MusicID, nil
+}
+
+// AddMusicID adds i to the "music_id" field.
+func (m *ChunithmGroupAliasMutation) AddMusicID(i int) {
+	if m.addmusic_id != nil {
+		*m.addmusic_id += i
+	} else {
+		m.addmusic_id = &i
+	}
+}
+
+// AddedMusicID returns the value that was added to the "music_id" field in this mutation.
+func (m *ChunithmGroupAliasMutation) AddedMusicID() (r int, exists bool) {
+	v := m.addmusic_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMusicID resets all changes to the "music_id" field.
+func (m *ChunithmGroupAliasMutation) ResetMusicID() {
+	m.music_id = nil
+	m.addmusic_id = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *ChunithmGroupAliasMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *ChunithmGroupAliasMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the ChunithmGroupAlias entity.
+// If the ChunithmGroupAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmGroupAliasMutation) OldAlias(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *ChunithmGroupAliasMutation) ResetAlias() {
+	m.alias = nil
+}
+
+// Where appends a list predicates to the ChunithmGroupAliasMutation builder.
+func (m *ChunithmGroupAliasMutation) Where(ps ...predicate.ChunithmGroupAlias) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChunithmGroupAliasMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChunithmGroupAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChunithmGroupAlias, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChunithmGroupAliasMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChunithmGroupAliasMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChunithmGroupAlias).
+func (m *ChunithmGroupAliasMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChunithmGroupAliasMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.platform != nil {
+		fields = append(fields, chunithmgroupalias.FieldPlatform)
+	}
+	if m.group_id != nil {
+		fields = append(fields, chunithmgroupalias.FieldGroupID)
+	}
+	if m.music_id != nil {
+		fields = append(fields, chunithmgroupalias.FieldMusicID)
+	}
+	if m.alias != nil {
+		fields = append(fields, chunithmgroupalias.FieldAlias)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChunithmGroupAliasMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmgroupalias.FieldPlatform:
+		return m.Platform()
+	case chunithmgroupalias.FieldGroupID:
+		return m.GroupID()
+	case chunithmgroupalias.FieldMusicID:
+		return m.MusicID()
+	case chunithmgroupalias.FieldAlias:
+		return m.Alias()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChunithmGroupAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chunithmgroupalias.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case chunithmgroupalias.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case chunithmgroupalias.FieldMusicID:
+		return m.OldMusicID(ctx)
+	case chunithmgroupalias.FieldAlias:
+		return m.OldAlias(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChunithmGroupAlias field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmGroupAliasMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chunithmgroupalias.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case chunithmgroupalias.FieldGroupID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case chunithmgroupalias.FieldMusicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMusicID(v)
+		return nil
+	case chunithmgroupalias.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmGroupAlias field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChunithmGroupAliasMutation) AddedFields() []string {
+	var fields []string
+	if m.addmusic_id != nil {
+		fields = append(fields, chunithmgroupalias.FieldMusicID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChunithmGroupAliasMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmgroupalias.FieldMusicID:
+		return m.AddedMusicID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmGroupAliasMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chunithmgroupalias.FieldMusicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMusicID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmGroupAlias numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChunithmGroupAliasMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChunithmGroupAliasMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChunithmGroupAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChunithmGroupAlias nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChunithmGroupAliasMutation) ResetField(name string) error {
+	switch name {
+	case chunithmgroupalias.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case chunithmgroupalias.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case chunithmgroupalias.FieldMusicID:
+		m.ResetMusicID()
+		return nil
+	case chunithmgroupalias.FieldAlias:
+		m.ResetAlias()
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmGroupAlias field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChunithmGroupAliasMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChunithmGroupAliasMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChunithmGroupAliasMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChunithmGroupAliasMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChunithmGroupAliasMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChunithmGroupAliasMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChunithmGroupAliasMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmGroupAlias unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChunithmGroupAliasMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmGroupAlias edge %s", name)
+}
+
+// ChunithmMusicAliasMutation represents an operation that mutates the ChunithmMusicAlias nodes in the graph.
+type ChunithmMusicAliasMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	music_id      *int
+	addmusic_id   *int
+	alias         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ChunithmMusicAlias, error)
+	predicates    []predicate.ChunithmMusicAlias
+}
+
+var _ ent.Mutation = (*ChunithmMusicAliasMutation)(nil)
+
+// chunithmmusicaliasOption allows management of the mutation configuration using functional options.
+type chunithmmusicaliasOption func(*ChunithmMusicAliasMutation)
+
+// newChunithmMusicAliasMutation creates new mutation for the ChunithmMusicAlias entity.
+func newChunithmMusicAliasMutation(c config, op Op, opts ...chunithmmusicaliasOption) *ChunithmMusicAliasMutation {
+	m := &ChunithmMusicAliasMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChunithmMusicAlias,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChunithmMusicAliasID sets the ID field of the mutation.
+func withChunithmMusicAliasID(id int64) chunithmmusicaliasOption {
+	return func(m *ChunithmMusicAliasMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChunithmMusicAlias
+		)
+		m.oldValue = func(ctx context.Context) (*ChunithmMusicAlias, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChunithmMusicAlias.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChunithmMusicAlias sets the old ChunithmMusicAlias of the mutation.
+func withChunithmMusicAlias(node *ChunithmMusicAlias) chunithmmusicaliasOption {
+	return func(m *ChunithmMusicAliasMutation) {
+		m.oldValue = func(context.Context) (*ChunithmMusicAlias, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChunithmMusicAliasMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChunithmMusicAliasMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("maindb: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChunithmMusicAlias entities.
+func (m *ChunithmMusicAliasMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChunithmMusicAliasMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChunithmMusicAliasMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChunithmMusicAlias.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMusicID sets the "music_id" field.
+func (m *ChunithmMusicAliasMutation) SetMusicID(i int) {
+	m.music_id = &i
+	m.addmusic_id = nil
+}
+
+// MusicID returns the value of the "music_id" field in the mutation.
+func (m *ChunithmMusicAliasMutation) MusicID() (r int, exists bool) {
+	v := m.music_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMusicID returns the old "music_id" field's value of the ChunithmMusicAlias entity.
+// If the ChunithmMusicAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmMusicAliasMutation) OldMusicID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMusicID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMusicID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMusicID: %w", err)
+	}
+	return oldValue.MusicID, nil
+}
+
+// AddMusicID adds i to the "music_id" field.
+func (m *ChunithmMusicAliasMutation) AddMusicID(i int) {
+	if m.addmusic_id != nil {
+		*m.addmusic_id += i
+	} else {
+		m.addmusic_id = &i
+	}
+}
+
+// AddedMusicID returns the value that was added to the "music_id" field in this mutation.
+func (m *ChunithmMusicAliasMutation) AddedMusicID() (r int, exists bool) {
+	v := m.addmusic_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMusicID resets all changes to the "music_id" field.
+func (m *ChunithmMusicAliasMutation) ResetMusicID() {
+	m.music_id = nil
+	m.addmusic_id = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *ChunithmMusicAliasMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *ChunithmMusicAliasMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the ChunithmMusicAlias entity.
+// If the ChunithmMusicAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmMusicAliasMutation) OldAlias(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *ChunithmMusicAliasMutation) ResetAlias() {
+	m.alias = nil
+}
+
+// Where appends a list predicates to the ChunithmMusicAliasMutation builder.
+func (m *ChunithmMusicAliasMutation) Where(ps ...predicate.ChunithmMusicAlias) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChunithmMusicAliasMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChunithmMusicAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChunithmMusicAlias, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChunithmMusicAliasMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChunithmMusicAliasMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChunithmMusicAlias).
+func (m *ChunithmMusicAliasMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChunithmMusicAliasMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.music_id != nil {
+		fields = append(fields, chunithmmusicalias.FieldMusicID)
+	}
+	if m.alias != nil {
+		fields = append(fields, chunithmmusicalias.FieldAlias)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChunithmMusicAliasMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmmusicalias.FieldMusicID:
+		return m.MusicID()
+	case chunithmmusicalias.FieldAlias:
+		return m.Alias()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChunithmMusicAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chunithmmusicalias.FieldMusicID:
+		return m.OldMusicID(ctx)
+	case chunithmmusicalias.FieldAlias:
+		return m.OldAlias(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChunithmMusicAlias field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmMusicAliasMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chunithmmusicalias.FieldMusicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMusicID(v)
+		return nil
+	case chunithmmusicalias.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmMusicAlias field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChunithmMusicAliasMutation) AddedFields() []string {
+	var fields []string
+	if m.addmusic_id != nil {
+		fields = append(fields, chunithmmusicalias.FieldMusicID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChunithmMusicAliasMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmmusicalias.FieldMusicID:
+		return m.AddedMusicID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmMusicAliasMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chunithmmusicalias.FieldMusicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMusicID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmMusicAlias numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChunithmMusicAliasMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChunithmMusicAliasMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChunithmMusicAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChunithmMusicAlias nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChunithmMusicAliasMutation) ResetField(name string) error {
+	switch name {
+	case chunithmmusicalias.FieldMusicID:
+		m.ResetMusicID()
+		return nil
+	case chunithmmusicalias.FieldAlias:
+		m.ResetAlias()
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmMusicAlias field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChunithmMusicAliasMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChunithmMusicAliasMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChunithmMusicAliasMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChunithmMusicAliasMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChunithmMusicAliasMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChunithmMusicAliasMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChunithmMusicAliasMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmMusicAlias unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChunithmMusicAliasMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmMusicAlias edge %s", name)
+}
+
+// ChunithmPendingAliasMutation represents an operation that mutates the ChunithmPendingAlias nodes in the graph.
+type ChunithmPendingAliasMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	music_id      *int
+	addmusic_id   *int
+	alias         *string
+	submitted_by  *string
+	submitted_at  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ChunithmPendingAlias, error)
+	predicates    []predicate.ChunithmPendingAlias
+}
+
+var _ ent.Mutation = (*ChunithmPendingAliasMutation)(nil)
+
+// chunithmpendingaliasOption allows management of the mutation configuration using functional options.
+type chunithmpendingaliasOption func(*ChunithmPendingAliasMutation)
+
+// newChunithmPendingAliasMutation creates new mutation for the ChunithmPendingAlias entity.
+func newChunithmPendingAliasMutation(c config, op Op, opts ...chunithmpendingaliasOption) *ChunithmPendingAliasMutation {
+	m := &ChunithmPendingAliasMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChunithmPendingAlias,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChunithmPendingAliasID sets the ID field of the mutation.
+func withChunithmPendingAliasID(id int64) chunithmpendingaliasOption {
+	return func(m *ChunithmPendingAliasMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChunithmPendingAlias
+		)
+		m.oldValue = func(ctx context.Context) (*ChunithmPendingAlias, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChunithmPendingAlias.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChunithmPendingAlias sets the old ChunithmPendingAlias of the mutation.
+func withChunithmPendingAlias(node *ChunithmPendingAlias) chunithmpendingaliasOption {
+	return func(m *ChunithmPendingAliasMutation) {
+		m.oldValue = func(context.Context) (*ChunithmPendingAlias, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChunithmPendingAliasMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChunithmPendingAliasMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("maindb: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChunithmPendingAlias entities.
+func (m *ChunithmPendingAliasMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChunithmPendingAliasMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChunithmPendingAliasMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChunithmPendingAlias.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMusicID sets the "music_id" field.
+func (m *ChunithmPendingAliasMutation) SetMusicID(i int) {
+	m.music_id = &i
+	m.addmusic_id = nil
+}
+
+// MusicID returns the value of the "music_id" field in the mutation.
+func (m *ChunithmPendingAliasMutation) MusicID() (r int, exists bool) {
+	v := m.music_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMusicID returns the old "music_id" field's value of the ChunithmPendingAlias entity.
+// If the ChunithmPendingAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmPendingAliasMutation) OldMusicID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMusicID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMusicID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMusicID: %w", err)
+	}
+	return oldValue.MusicID, nil
+}
+
+// AddMusicID adds i to the "music_id" field.
+func (m *ChunithmPendingAliasMutation) AddMusicID(i int) {
+	if m.addmusic_id != nil {
+		*m.addmusic_id += i
+	} else {
+		m.addmusic_id = &i
+	}
+}
+
+// AddedMusicID returns the value that was added to the "music_id" field in this mutation.
+func (m *ChunithmPendingAliasMutation) AddedMusicID() (r int, exists bool) {
+	v := m.addmusic_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMusicID resets all changes to the "music_id" field.
+func (m *ChunithmPendingAliasMutation) ResetMusicID() {
+	m.music_id = nil
+	m.addmusic_id = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *ChunithmPendingAliasMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *ChunithmPendingAliasMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the ChunithmPendingAlias entity.
+// If the ChunithmPendingAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmPendingAliasMutation) OldAlias(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *ChunithmPendingAliasMutation) ResetAlias() {
+	m.alias = nil
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (m *ChunithmPendingAliasMutation) SetSubmittedBy(s string) {
+	m.submitted_by = &s
+}
+
+// SubmittedBy returns the value of the "submitted_by" field in the mutation.
+func (m *ChunithmPendingAliasMutation) SubmittedBy() (r string, exists bool) {
+	v := m.submitted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedBy returns the old "submitted_by" field's value of the ChunithmPendingAlias entity.
+// If the ChunithmPendingAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmPendingAliasMutation) OldSubmittedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedBy: %w", err)
+	}
+	return oldValue.SubmittedBy, nil
+}
+
+// ResetSubmittedBy resets all changes to the "submitted_by" field.
+func (m *ChunithmPendingAliasMutation) ResetSubmittedBy() {
+	m.submitted_by = nil
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (m *ChunithmPendingAliasMutation) SetSubmittedAt(t time.Time) {
+	m.submitted_at = &t
+}
+
+// SubmittedAt returns the value of the "submitted_at" field in the mutation.
+func (m *ChunithmPendingAliasMutation) SubmittedAt() (r time.Time, exists bool) {
+	v := m.submitted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedAt returns the old "submitted_at" field's value of the ChunithmPendingAlias entity.
+// If the ChunithmPendingAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmPendingAliasMutation) OldSubmittedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+	}
+	return oldValue.SubmittedAt, nil
+}
+
+// ResetSubmittedAt resets all changes to the "submitted_at" field.
+func (m *ChunithmPendingAliasMutation) ResetSubmittedAt() {
+	m.submitted_at = nil
+}
+
+// Where appends a list predicates to the ChunithmPendingAliasMutation builder.
+func (m *ChunithmPendingAliasMutation) Where(ps ...predicate.ChunithmPendingAlias) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChunithmPendingAliasMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChunithmPendingAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChunithmPendingAlias, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
 // Op returns the operation name.
-func (m *ChunithmDefaultServerMutation) Op() Op {
+func (m *ChunithmPendingAliasMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChunithmDefaultServerMutation) SetOp(op Op) {
+func (m *ChunithmPendingAliasMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ChunithmDefaultServer).
-func (m *ChunithmDefaultServerMutation) Type() string {
+// Type returns the node type of this mutation (ChunithmPendingAlias).
+func (m *ChunithmPendingAliasMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChunithmDefaultServerMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.haruki_user_id != nil {
-		fields = append(fields, chunithmdefaultserver.FieldHarukiUserID)
+func (m *ChunithmPendingAliasMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.music_id != nil {
+		fields = append(fields, chunithmpendingalias.FieldMusicID)
 	}
-	if m.server != nil {
-		fields = append(fields, chunithmdefaultserver.FieldServer)
+	if m.alias != nil {
+		fields = append(fields, chunithmpendingalias.FieldAlias)
+	}
+	if m.submitted_by != nil {
+		fields = append(fields, chunithmpendingalias.FieldSubmittedBy)
+	}
+	if m.submitted_at != nil {
+		fields = append(fields, chunithmpendingalias.FieldSubmittedAt)
 	}
 	return fields
 }
@@ -752,12 +2896,16 @@ func (m *ChunithmDefaultServerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChunithmDefaultServerMutation) Field(name string) (ent.Value, bool) {
+func (m *ChunithmPendingAliasMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case chunithmdefaultserver.FieldHarukiUserID:
-		return m.HarukiUserID()
-	case chunithmdefaultserver.FieldServer:
-		return m.Server()
+	case chunithmpendingalias.FieldMusicID:
+		return m.MusicID()
+	case chunithmpendingalias.FieldAlias:
+		return m.Alias()
+	case chunithmpendingalias.FieldSubmittedBy:
+		return m.SubmittedBy()
+	case chunithmpendingalias.FieldSubmittedAt:
+		return m.SubmittedAt()
 	}
 	return nil, false
 }
@@ -765,45 +2913,63 @@ func (m *ChunithmDefaultServerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChunithmDefaultServerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChunithmPendingAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case chunithmdefaultserver.FieldHarukiUserID:
-		return m.OldHarukiUserID(ctx)
-	case chunithmdefaultserver.FieldServer:
-		return m.OldServer(ctx)
+	case chunithmpendingalias.FieldMusicID:
+		return m.OldMusicID(ctx)
+	case chunithmpendingalias.FieldAlias:
+		return m.OldAlias(ctx)
+	case chunithmpendingalias.FieldSubmittedBy:
+		return m.OldSubmittedBy(ctx)
+	case chunithmpendingalias.FieldSubmittedAt:
+		return m.OldSubmittedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChunithmDefaultServer field %s", name)
+	return nil, fmt.Errorf("unknown ChunithmPendingAlias field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChunithmDefaultServerMutation) SetField(name string, value ent.Value) error {
+func (m *ChunithmPendingAliasMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case chunithmdefaultserver.FieldHarukiUserID:
+	case chunithmpendingalias.FieldMusicID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHarukiUserID(v)
+		m.SetMusicID(v)
 		return nil
-	case chunithmdefaultserver.FieldServer:
+	case chunithmpendingalias.FieldAlias:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetServer(v)
+		m.SetAlias(v)
+		return nil
+	case chunithmpendingalias.FieldSubmittedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedBy(v)
+		return nil
+	case chunithmpendingalias.FieldSubmittedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmDefaultServer field %s", name)
+	return fmt.Errorf("unknown ChunithmPendingAlias field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChunithmDefaultServerMutation) AddedFields() []string {
+func (m *ChunithmPendingAliasMutation) AddedFields() []string {
 	var fields []string
-	if m.addharuki_user_id != nil {
-		fields = append(fields, chunithmdefaultserver.FieldHarukiUserID)
+	if m.addmusic_id != nil {
+		fields = append(fields, chunithmpendingalias.FieldMusicID)
 	}
 	return fields
 }
@@ -811,10 +2977,10 @@ func (m *ChunithmDefaultServerMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChunithmDefaultServerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChunithmPendingAliasMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case chunithmdefaultserver.FieldHarukiUserID:
-		return m.AddedHarukiUserID()
+	case chunithmpendingalias.FieldMusicID:
+		return m.AddedMusicID()
 	}
 	return nil, false
 }
@@ -822,102 +2988,108 @@ func (m *ChunithmDefaultServerMutation) AddedField(name string) (ent.Value, bool
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChunithmDefaultServerMutation) AddField(name string, value ent.Value) error {
+func (m *ChunithmPendingAliasMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case chunithmdefaultserver.FieldHarukiUserID:
+	case chunithmpendingalias.FieldMusicID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddHarukiUserID(v)
+		m.AddMusicID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmDefaultServer numeric field %s", name)
+	return fmt.Errorf("unknown ChunithmPendingAlias numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChunithmDefaultServerMutation) ClearedFields() []string {
+func (m *ChunithmPendingAliasMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChunithmDefaultServerMutation) FieldCleared(name string) bool {
+func (m *ChunithmPendingAliasMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChunithmDefaultServerMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ChunithmDefaultServer nullable field %s", name)
+func (m *ChunithmPendingAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChunithmPendingAlias nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChunithmDefaultServerMutation) ResetField(name string) error {
+func (m *ChunithmPendingAliasMutation) ResetField(name string) error {
 	switch name {
-	case chunithmdefaultserver.FieldHarukiUserID:
-		m.ResetHarukiUserID()
+	case chunithmpendingalias.FieldMusicID:
+		m.ResetMusicID()
 		return nil
-	case chunithmdefaultserver.FieldServer:
-		m.ResetServer()
+	case chunithmpendingalias.FieldAlias:
+		m.ResetAlias()
+		return nil
+	case chunithmpendingalias.FieldSubmittedBy:
+		m.ResetSubmittedBy()
+		return nil
+	case chunithmpendingalias.FieldSubmittedAt:
+		m.ResetSubmittedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmDefaultServer field %s", name)
+	return fmt.Errorf("unknown ChunithmPendingAlias field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChunithmDefaultServerMutation) AddedEdges() []string {
+func (m *ChunithmPendingAliasMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChunithmDefaultServerMutation) AddedIDs(name string) []ent.Value {
+func (m *ChunithmPendingAliasMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChunithmDefaultServerMutation) RemovedEdges() []string {
+func (m *ChunithmPendingAliasMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChunithmDefaultServerMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChunithmPendingAliasMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChunithmDefaultServerMutation) ClearedEdges() []string {
+func (m *ChunithmPendingAliasMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChunithmDefaultServerMutation) EdgeCleared(name string) bool {
+func (m *ChunithmPendingAliasMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChunithmDefaultServerMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ChunithmDefaultServer unique edge %s", name)
+func (m *ChunithmPendingAliasMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmPendingAlias unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChunithmDefaultServerMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ChunithmDefaultServer edge %s", name)
+func (m *ChunithmPendingAliasMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmPendingAlias edge %s", name)
 }
 
-// ChunithmMusicAliasMutation represents an operation that mutates the ChunithmMusicAlias nodes in the graph.
-type ChunithmMusicAliasMutation struct {
+// ChunithmRejectedAliasMutation represents an operation that mutates the ChunithmRejectedAlias nodes in the graph.
+type ChunithmRejectedAliasMutation struct {
 	config
 	op            Op
 	typ           string
@@ -925,23 +3097,26 @@ type ChunithmMusicAliasMutation struct {
 	music_id      *int
 	addmusic_id   *int
 	alias         *string
+	reviewed_by   *string
+	reason        *string
+	reviewed_at   *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*ChunithmMusicAlias, error)
-	predicates    []predicate.ChunithmMusicAlias
+	oldValue      func(context.Context) (*ChunithmRejectedAlias, error)
+	predicates    []predicate.ChunithmRejectedAlias
 }
 
-var _ ent.Mutation = (*ChunithmMusicAliasMutation)(nil)
+var _ ent.Mutation = (*ChunithmRejectedAliasMutation)(nil)
 
-// chunithmmusicaliasOption allows management of the mutation configuration using functional options.
-type chunithmmusicaliasOption func(*ChunithmMusicAliasMutation)
+// chunithmrejectedaliasOption allows management of the mutation configuration using functional options.
+type chunithmrejectedaliasOption func(*ChunithmRejectedAliasMutation)
 
-// newChunithmMusicAliasMutation creates new mutation for the ChunithmMusicAlias entity.
-func newChunithmMusicAliasMutation(c config, op Op, opts ...chunithmmusicaliasOption) *ChunithmMusicAliasMutation {
-	m := &ChunithmMusicAliasMutation{
+// newChunithmRejectedAliasMutation creates new mutation for the ChunithmRejectedAlias entity.
+func newChunithmRejectedAliasMutation(c config, op Op, opts ...chunithmrejectedaliasOption) *ChunithmRejectedAliasMutation {
+	m := &ChunithmRejectedAliasMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChunithmMusicAlias,
+		typ:           TypeChunithmRejectedAlias,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -950,20 +3125,20 @@ func newChunithmMusicAliasMutation(c config, op Op, opts ...chunithmmusicaliasOp
 	return m
 }
 
-// withChunithmMusicAliasID sets the ID field of the mutation.
-func withChunithmMusicAliasID(id int64) chunithmmusicaliasOption {
-	return func(m *ChunithmMusicAliasMutation) {
+// withChunithmRejectedAliasID sets the ID field of the mutation.
+func withChunithmRejectedAliasID(id int64) chunithmrejectedaliasOption {
+	return func(m *ChunithmRejectedAliasMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ChunithmMusicAlias
+			value *ChunithmRejectedAlias
 		)
-		m.oldValue = func(ctx context.Context) (*ChunithmMusicAlias, error) {
+		m.oldValue = func(ctx context.Context) (*ChunithmRejectedAlias, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ChunithmMusicAlias.Get(ctx, id)
+					value, err = m.Client().ChunithmRejectedAlias.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -972,10 +3147,10 @@ func withChunithmMusicAliasID(id int64) chunithmmusicaliasOption {
 	}
 }
 
-// withChunithmMusicAlias sets the old ChunithmMusicAlias of the mutation.
-func withChunithmMusicAlias(node *ChunithmMusicAlias) chunithmmusicaliasOption {
-	return func(m *ChunithmMusicAliasMutation) {
-		m.oldValue = func(context.Context) (*ChunithmMusicAlias, error) {
+// withChunithmRejectedAlias sets the old ChunithmRejectedAlias of the mutation.
+func withChunithmRejectedAlias(node *ChunithmRejectedAlias) chunithmrejectedaliasOption {
+	return func(m *ChunithmRejectedAliasMutation) {
+		m.oldValue = func(context.Context) (*ChunithmRejectedAlias, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -984,7 +3159,7 @@ func withChunithmMusicAlias(node *ChunithmMusicAlias) chunithmmusicaliasOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChunithmMusicAliasMutation) Client() *Client {
+func (m ChunithmRejectedAliasMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -992,7 +3167,7 @@ func (m ChunithmMusicAliasMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChunithmMusicAliasMutation) Tx() (*Tx, error) {
+func (m ChunithmRejectedAliasMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("maindb: mutation is not running in a transaction")
 	}
@@ -1002,14 +3177,14 @@ func (m ChunithmMusicAliasMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ChunithmMusicAlias entities.
-func (m *ChunithmMusicAliasMutation) SetID(id int64) {
+// operation is only accepted on creation of ChunithmRejectedAlias entities.
+func (m *ChunithmRejectedAliasMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChunithmMusicAliasMutation) ID() (id int64, exists bool) {
+func (m *ChunithmRejectedAliasMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1020,7 +3195,7 @@ func (m *ChunithmMusicAliasMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChunithmMusicAliasMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *ChunithmRejectedAliasMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1029,20 +3204,20 @@ func (m *ChunithmMusicAliasMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChunithmMusicAlias.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChunithmRejectedAlias.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetMusicID sets the "music_id" field.
-func (m *ChunithmMusicAliasMutation) SetMusicID(i int) {
+func (m *ChunithmRejectedAliasMutation) SetMusicID(i int) {
 	m.music_id = &i
 	m.addmusic_id = nil
 }
 
 // MusicID returns the value of the "music_id" field in the mutation.
-func (m *ChunithmMusicAliasMutation) MusicID() (r int, exists bool) {
+func (m *ChunithmRejectedAliasMutation) MusicID() (r int, exists bool) {
 	v := m.music_id
 	if v == nil {
 		return
@@ -1050,10 +3225,10 @@ func (m *ChunithmMusicAliasMutation) MusicID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldMusicID returns the old "music_id" field's value of the ChunithmMusicAlias entity.
-// If the ChunithmMusicAlias object wasn't provided to the builder, the object is fetched from the database.
+// OldMusicID returns the old "music_id" field's value of the ChunithmRejectedAlias entity.
+// If the ChunithmRejectedAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmMusicAliasMutation) OldMusicID(ctx context.Context) (v int, err error) {
+func (m *ChunithmRejectedAliasMutation) OldMusicID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMusicID is only allowed on UpdateOne operations")
 	}
@@ -1068,7 +3243,7 @@ func (m *ChunithmMusicAliasMutation) OldMusicID(ctx context.Context) (v int, err
 }
 
 // AddMusicID adds i to the "music_id" field.
-func (m *ChunithmMusicAliasMutation) AddMusicID(i int) {
+func (m *ChunithmRejectedAliasMutation) AddMusicID(i int) {
 	if m.addmusic_id != nil {
 		*m.addmusic_id += i
 	} else {
@@ -1077,7 +3252,7 @@ func (m *ChunithmMusicAliasMutation) AddMusicID(i int) {
 }
 
 // AddedMusicID returns the value that was added to the "music_id" field in this mutation.
-func (m *ChunithmMusicAliasMutation) AddedMusicID() (r int, exists bool) {
+func (m *ChunithmRejectedAliasMutation) AddedMusicID() (r int, exists bool) {
 	v := m.addmusic_id
 	if v == nil {
 		return
@@ -1086,18 +3261,18 @@ func (m *ChunithmMusicAliasMutation) AddedMusicID() (r int, exists bool) {
 }
 
 // ResetMusicID resets all changes to the "music_id" field.
-func (m *ChunithmMusicAliasMutation) ResetMusicID() {
+func (m *ChunithmRejectedAliasMutation) ResetMusicID() {
 	m.music_id = nil
 	m.addmusic_id = nil
 }
 
 // SetAlias sets the "alias" field.
-func (m *ChunithmMusicAliasMutation) SetAlias(s string) {
+func (m *ChunithmRejectedAliasMutation) SetAlias(s string) {
 	m.alias = &s
 }
 
 // Alias returns the value of the "alias" field in the mutation.
-func (m *ChunithmMusicAliasMutation) Alias() (r string, exists bool) {
+func (m *ChunithmRejectedAliasMutation) Alias() (r string, exists bool) {
 	v := m.alias
 	if v == nil {
 		return
@@ -1105,10 +3280,10 @@ func (m *ChunithmMusicAliasMutation) Alias() (r string, exists bool) {
 	return *v, true
 }
 
-// OldAlias returns the old "alias" field's value of the ChunithmMusicAlias entity.
-// If the ChunithmMusicAlias object wasn't provided to the builder, the object is fetched from the database.
+// OldAlias returns the old "alias" field's value of the ChunithmRejectedAlias entity.
+// If the ChunithmRejectedAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmMusicAliasMutation) OldAlias(ctx context.Context) (v string, err error) {
+func (m *ChunithmRejectedAliasMutation) OldAlias(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
 	}
@@ -1123,19 +3298,127 @@ func (m *ChunithmMusicAliasMutation) OldAlias(ctx context.Context) (v string, er
 }
 
 // ResetAlias resets all changes to the "alias" field.
-func (m *ChunithmMusicAliasMutation) ResetAlias() {
+func (m *ChunithmRejectedAliasMutation) ResetAlias() {
 	m.alias = nil
 }
 
-// Where appends a list predicates to the ChunithmMusicAliasMutation builder.
-func (m *ChunithmMusicAliasMutation) Where(ps ...predicate.ChunithmMusicAlias) {
+// SetReviewedBy sets the "reviewed_by" field.
+func (m *ChunithmRejectedAliasMutation) SetReviewedBy(s string) {
+	m.reviewed_by = &s
+}
+
+// ReviewedBy returns the value of the "reviewed_by" field in the mutation.
+func (m *ChunithmRejectedAliasMutation) ReviewedBy() (r string, exists bool) {
+	v := m.reviewed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedBy returns the old "reviewed_by" field's value of the ChunithmRejectedAlias entity.
+// If the ChunithmRejectedAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmRejectedAliasMutation) OldReviewedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedBy: %w", err)
+	}
+	return oldValue.ReviewedBy, nil
+}
+
+// ResetReviewedBy resets all changes to the "reviewed_by" field.
+func (m *ChunithmRejectedAliasMutation) ResetReviewedBy() {
+	m.reviewed_by = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ChunithmRejectedAliasMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ChunithmRejectedAliasMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ChunithmRejectedAlias entity.
+// If the ChunithmRejectedAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmRejectedAliasMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ChunithmRejectedAliasMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *ChunithmRejectedAliasMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *ChunithmRejectedAliasMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedAt returns the old "reviewed_at" field's value of the ChunithmRejectedAlias entity.
+// If the ChunithmRejectedAlias object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmRejectedAliasMutation) OldReviewedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
+	}
+	return oldValue.ReviewedAt, nil
+}
+
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *ChunithmRejectedAliasMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+}
+
+// Where appends a list predicates to the ChunithmRejectedAliasMutation builder.
+func (m *ChunithmRejectedAliasMutation) Where(ps ...predicate.ChunithmRejectedAlias) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChunithmMusicAliasMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChunithmRejectedAliasMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChunithmMusicAliasMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChunithmMusicAlias, len(ps))
+func (m *ChunithmRejectedAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChunithmRejectedAlias, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1143,30 +3426,39 @@ func (m *ChunithmMusicAliasMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChunithmMusicAliasMutation) Op() Op {
+func (m *ChunithmRejectedAliasMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChunithmMusicAliasMutation) SetOp(op Op) {
+func (m *ChunithmRejectedAliasMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ChunithmMusicAlias).
-func (m *ChunithmMusicAliasMutation) Type() string {
+// Type returns the node type of this mutation (ChunithmRejectedAlias).
+func (m *ChunithmRejectedAliasMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChunithmMusicAliasMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+func (m *ChunithmRejectedAliasMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.music_id != nil {
-		fields = append(fields, chunithmmusicalias.FieldMusicID)
+		fields = append(fields, chunithmrejectedalias.FieldMusicID)
 	}
 	if m.alias != nil {
-		fields = append(fields, chunithmmusicalias.FieldAlias)
+		fields = append(fields, chunithmrejectedalias.FieldAlias)
+	}
+	if m.reviewed_by != nil {
+		fields = append(fields, chunithmrejectedalias.FieldReviewedBy)
+	}
+	if m.reason != nil {
+		fields = append(fields, chunithmrejectedalias.FieldReason)
+	}
+	if m.reviewed_at != nil {
+		fields = append(fields, chunithmrejectedalias.FieldReviewedAt)
 	}
 	return fields
 }
@@ -1174,12 +3466,18 @@ func (m *ChunithmMusicAliasMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChunithmMusicAliasMutation) Field(name string) (ent.Value, bool) {
+func (m *ChunithmRejectedAliasMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case chunithmmusicalias.FieldMusicID:
+	case chunithmrejectedalias.FieldMusicID:
 		return m.MusicID()
-	case chunithmmusicalias.FieldAlias:
+	case chunithmrejectedalias.FieldAlias:
 		return m.Alias()
+	case chunithmrejectedalias.FieldReviewedBy:
+		return m.ReviewedBy()
+	case chunithmrejectedalias.FieldReason:
+		return m.Reason()
+	case chunithmrejectedalias.FieldReviewedAt:
+		return m.ReviewedAt()
 	}
 	return nil, false
 }
@@ -1187,45 +3485,72 @@ func (m *ChunithmMusicAliasMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChunithmMusicAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChunithmRejectedAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case chunithmmusicalias.FieldMusicID:
+	case chunithmrejectedalias.FieldMusicID:
 		return m.OldMusicID(ctx)
-	case chunithmmusicalias.FieldAlias:
+	case chunithmrejectedalias.FieldAlias:
 		return m.OldAlias(ctx)
+	case chunithmrejectedalias.FieldReviewedBy:
+		return m.OldReviewedBy(ctx)
+	case chunithmrejectedalias.FieldReason:
+		return m.OldReason(ctx)
+	case chunithmrejectedalias.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChunithmMusicAlias field %s", name)
+	return nil, fmt.Errorf("unknown ChunithmRejectedAlias field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChunithmMusicAliasMutation) SetField(name string, value ent.Value) error {
+func (m *ChunithmRejectedAliasMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case chunithmmusicalias.FieldMusicID:
+	case chunithmrejectedalias.FieldMusicID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMusicID(v)
 		return nil
-	case chunithmmusicalias.FieldAlias:
+	case chunithmrejectedalias.FieldAlias:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAlias(v)
 		return nil
+	case chunithmrejectedalias.FieldReviewedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedBy(v)
+		return nil
+	case chunithmrejectedalias.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case chunithmrejectedalias.FieldReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ChunithmMusicAlias field %s", name)
+	return fmt.Errorf("unknown ChunithmRejectedAlias field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChunithmMusicAliasMutation) AddedFields() []string {
+func (m *ChunithmRejectedAliasMutation) AddedFields() []string {
 	var fields []string
 	if m.addmusic_id != nil {
-		fields = append(fields, chunithmmusicalias.FieldMusicID)
+		fields = append(fields, chunithmrejectedalias.FieldMusicID)
 	}
 	return fields
 }
@@ -1233,9 +3558,9 @@ func (m *ChunithmMusicAliasMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChunithmMusicAliasMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChunithmRejectedAliasMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case chunithmmusicalias.FieldMusicID:
+	case chunithmrejectedalias.FieldMusicID:
 		return m.AddedMusicID()
 	}
 	return nil, false
@@ -1244,9 +3569,9 @@ func (m *ChunithmMusicAliasMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChunithmMusicAliasMutation) AddField(name string, value ent.Value) error {
+func (m *ChunithmRejectedAliasMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case chunithmmusicalias.FieldMusicID:
+	case chunithmrejectedalias.FieldMusicID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1254,86 +3579,95 @@ func (m *ChunithmMusicAliasMutation) AddField(name string, value ent.Value) erro
 		m.AddMusicID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmMusicAlias numeric field %s", name)
+	return fmt.Errorf("unknown ChunithmRejectedAlias numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChunithmMusicAliasMutation) ClearedFields() []string {
+func (m *ChunithmRejectedAliasMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChunithmMusicAliasMutation) FieldCleared(name string) bool {
+func (m *ChunithmRejectedAliasMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChunithmMusicAliasMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ChunithmMusicAlias nullable field %s", name)
+func (m *ChunithmRejectedAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChunithmRejectedAlias nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChunithmMusicAliasMutation) ResetField(name string) error {
+func (m *ChunithmRejectedAliasMutation) ResetField(name string) error {
 	switch name {
-	case chunithmmusicalias.FieldMusicID:
+	case chunithmrejectedalias.FieldMusicID:
 		m.ResetMusicID()
 		return nil
-	case chunithmmusicalias.FieldAlias:
+	case chunithmrejectedalias.FieldAlias:
 		m.ResetAlias()
 		return nil
+	case chunithmrejectedalias.FieldReviewedBy:
+		m.ResetReviewedBy()
+		return nil
+	case chunithmrejectedalias.FieldReason:
+		m.ResetReason()
+		return nil
+	case chunithmrejectedalias.FieldReviewedAt:
+		m.ResetReviewedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown ChunithmMusicAlias field %s", name)
+	return fmt.Errorf("unknown ChunithmRejectedAlias field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChunithmMusicAliasMutation) AddedEdges() []string {
+func (m *ChunithmRejectedAliasMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChunithmMusicAliasMutation) AddedIDs(name string) []ent.Value {
+func (m *ChunithmRejectedAliasMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChunithmMusicAliasMutation) RemovedEdges() []string {
+func (m *ChunithmRejectedAliasMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChunithmMusicAliasMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChunithmRejectedAliasMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChunithmMusicAliasMutation) ClearedEdges() []string {
+func (m *ChunithmRejectedAliasMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChunithmMusicAliasMutation) EdgeCleared(name string) bool {
+func (m *ChunithmRejectedAliasMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChunithmMusicAliasMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ChunithmMusicAlias unique edge %s", name)
+func (m *ChunithmRejectedAliasMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmRejectedAlias unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChunithmMusicAliasMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ChunithmMusicAlias edge %s", name)
+func (m *ChunithmRejectedAliasMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmRejectedAlias edge %s", name)
 }
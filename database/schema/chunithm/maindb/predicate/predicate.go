@@ -6,11 +6,23 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ChunithmAliasAdmin is the predicate function for chunithmaliasadmin builders.
+type ChunithmAliasAdmin func(*sql.Selector)
+
 // ChunithmBinding is the predicate function for chunithmbinding builders.
 type ChunithmBinding func(*sql.Selector)
 
 // ChunithmDefaultServer is the predicate function for chunithmdefaultserver builders.
 type ChunithmDefaultServer func(*sql.Selector)
 
+// ChunithmGroupAlias is the predicate function for chunithmgroupalias builders.
+type ChunithmGroupAlias func(*sql.Selector)
+
 // ChunithmMusicAlias is the predicate function for chunithmmusicalias builders.
 type ChunithmMusicAlias func(*sql.Selector)
+
+// ChunithmPendingAlias is the predicate function for chunithmpendingalias builders.
+type ChunithmPendingAlias func(*sql.Selector)
+
+// ChunithmRejectedAlias is the predicate function for chunithmrejectedalias builders.
+type ChunithmRejectedAlias func(*sql.Selector)
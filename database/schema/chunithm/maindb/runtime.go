@@ -3,9 +3,13 @@
 package maindb
 
 import (
+	"haruki-database/database/schema/chunithm/maindb/chunithmaliasadmin"
 	"haruki-database/database/schema/chunithm/maindb/chunithmbinding"
 	"haruki-database/database/schema/chunithm/maindb/chunithmdefaultserver"
+	"haruki-database/database/schema/chunithm/maindb/chunithmgroupalias"
 	"haruki-database/database/schema/chunithm/maindb/chunithmmusicalias"
+	"haruki-database/database/schema/chunithm/maindb/chunithmpendingalias"
+	"haruki-database/database/schema/chunithm/maindb/chunithmrejectedalias"
 	"haruki-database/entsrc/schema/chunithm/maindb/schema"
 )
 
@@ -13,6 +17,12 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	chunithmaliasadminFields := schema.ChunithmAliasAdmin{}.Fields()
+	_ = chunithmaliasadminFields
+	// chunithmaliasadminDescName is the schema descriptor for name field.
+	chunithmaliasadminDescName := chunithmaliasadminFields[1].Descriptor()
+	// chunithmaliasadmin.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	chunithmaliasadmin.NameValidator = chunithmaliasadminDescName.Validators[0].(func(string) error)
 	chunithmbindingFields := schema.ChunithmBinding{}.Fields()
 	_ = chunithmbindingFields
 	// chunithmbindingDescServer is the schema descriptor for server field.
@@ -23,16 +33,70 @@ func init() {
 	chunithmbindingDescAimeID := chunithmbindingFields[2].Descriptor()
 	// chunithmbinding.AimeIDValidator is a validator for the "aime_id" field. It is called by the builders before save.
 	chunithmbinding.AimeIDValidator = chunithmbindingDescAimeID.Validators[0].(func(string) error)
+	// chunithmbindingDescLabel is the schema descriptor for label field.
+	chunithmbindingDescLabel := chunithmbindingFields[3].Descriptor()
+	// chunithmbinding.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	chunithmbinding.LabelValidator = chunithmbindingDescLabel.Validators[0].(func(string) error)
+	// chunithmbindingDescVisible is the schema descriptor for visible field.
+	chunithmbindingDescVisible := chunithmbindingFields[4].Descriptor()
+	// chunithmbinding.DefaultVisible holds the default value on creation for the visible field.
+	chunithmbinding.DefaultVisible = chunithmbindingDescVisible.Default.(bool)
+	// chunithmbindingDescIsDefault is the schema descriptor for is_default field.
+	chunithmbindingDescIsDefault := chunithmbindingFields[5].Descriptor()
+	// chunithmbinding.DefaultIsDefault holds the default value on creation for the is_default field.
+	chunithmbinding.DefaultIsDefault = chunithmbindingDescIsDefault.Default.(bool)
+	// chunithmbindingDescSortOrder is the schema descriptor for sort_order field.
+	chunithmbindingDescSortOrder := chunithmbindingFields[6].Descriptor()
+	// chunithmbinding.DefaultSortOrder holds the default value on creation for the sort_order field.
+	chunithmbinding.DefaultSortOrder = chunithmbindingDescSortOrder.Default.(int)
 	chunithmdefaultserverFields := schema.ChunithmDefaultServer{}.Fields()
 	_ = chunithmdefaultserverFields
 	// chunithmdefaultserverDescServer is the schema descriptor for server field.
 	chunithmdefaultserverDescServer := chunithmdefaultserverFields[1].Descriptor()
 	// chunithmdefaultserver.ServerValidator is a validator for the "server" field. It is called by the builders before save.
 	chunithmdefaultserver.ServerValidator = chunithmdefaultserverDescServer.Validators[0].(func(string) error)
+	chunithmgroupaliasFields := schema.ChunithmGroupAlias{}.Fields()
+	_ = chunithmgroupaliasFields
+	// chunithmgroupaliasDescPlatform is the schema descriptor for platform field.
+	chunithmgroupaliasDescPlatform := chunithmgroupaliasFields[0].Descriptor()
+	// chunithmgroupalias.PlatformValidator is a validator for the "platform" field. It is called by the builders before save.
+	chunithmgroupalias.PlatformValidator = chunithmgroupaliasDescPlatform.Validators[0].(func(string) error)
+	// chunithmgroupaliasDescGroupID is the schema descriptor for group_id field.
+	chunithmgroupaliasDescGroupID := chunithmgroupaliasFields[1].Descriptor()
+	// chunithmgroupalias.GroupIDValidator is a validator for the "group_id" field. It is called by the builders before save.
+	chunithmgroupalias.GroupIDValidator = chunithmgroupaliasDescGroupID.Validators[0].(func(string) error)
+	// chunithmgroupaliasDescAlias is the schema descriptor for alias field.
+	chunithmgroupaliasDescAlias := chunithmgroupaliasFields[3].Descriptor()
+	// chunithmgroupalias.AliasValidator is a validator for the "alias" field. It is called by the builders before save.
+	chunithmgroupalias.AliasValidator = chunithmgroupaliasDescAlias.Validators[0].(func(string) error)
 	chunithmmusicaliasFields := schema.ChunithmMusicAlias{}.Fields()
 	_ = chunithmmusicaliasFields
 	// chunithmmusicaliasDescAlias is the schema descriptor for alias field.
 	chunithmmusicaliasDescAlias := chunithmmusicaliasFields[2].Descriptor()
 	// chunithmmusicalias.AliasValidator is a validator for the "alias" field. It is called by the builders before save.
 	chunithmmusicalias.AliasValidator = chunithmmusicaliasDescAlias.Validators[0].(func(string) error)
+	chunithmpendingaliasFields := schema.ChunithmPendingAlias{}.Fields()
+	_ = chunithmpendingaliasFields
+	// chunithmpendingaliasDescAlias is the schema descriptor for alias field.
+	chunithmpendingaliasDescAlias := chunithmpendingaliasFields[2].Descriptor()
+	// chunithmpendingalias.AliasValidator is a validator for the "alias" field. It is called by the builders before save.
+	chunithmpendingalias.AliasValidator = chunithmpendingaliasDescAlias.Validators[0].(func(string) error)
+	// chunithmpendingaliasDescSubmittedBy is the schema descriptor for submitted_by field.
+	chunithmpendingaliasDescSubmittedBy := chunithmpendingaliasFields[3].Descriptor()
+	// chunithmpendingalias.SubmittedByValidator is a validator for the "submitted_by" field. It is called by the builders before save.
+	chunithmpendingalias.SubmittedByValidator = chunithmpendingaliasDescSubmittedBy.Validators[0].(func(string) error)
+	chunithmrejectedaliasFields := schema.ChunithmRejectedAlias{}.Fields()
+	_ = chunithmrejectedaliasFields
+	// chunithmrejectedaliasDescAlias is the schema descriptor for alias field.
+	chunithmrejectedaliasDescAlias := chunithmrejectedaliasFields[2].Descriptor()
+	// chunithmrejectedalias.AliasValidator is a validator for the "alias" field. It is called by the builders before save.
+	chunithmrejectedalias.AliasValidator = chunithmrejectedaliasDescAlias.Validators[0].(func(string) error)
+	// chunithmrejectedaliasDescReviewedBy is the schema descriptor for reviewed_by field.
+	chunithmrejectedaliasDescReviewedBy := chunithmrejectedaliasFields[3].Descriptor()
+	// chunithmrejectedalias.ReviewedByValidator is a validator for the "reviewed_by" field. It is called by the builders before save.
+	chunithmrejectedalias.ReviewedByValidator = chunithmrejectedaliasDescReviewedBy.Validators[0].(func(string) error)
+	// chunithmrejectedaliasDescReason is the schema descriptor for reason field.
+	chunithmrejectedaliasDescReason := chunithmrejectedaliasFields[4].Descriptor()
+	// chunithmrejectedalias.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	chunithmrejectedalias.ReasonValidator = chunithmrejectedaliasDescReason.Validators[0].(func(string) error)
 }
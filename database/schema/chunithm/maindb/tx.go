@@ -12,12 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ChunithmAliasAdmin is the client for interacting with the ChunithmAliasAdmin builders.
+	ChunithmAliasAdmin *ChunithmAliasAdminClient
 	// ChunithmBinding is the client for interacting with the ChunithmBinding builders.
 	ChunithmBinding *ChunithmBindingClient
 	// ChunithmDefaultServer is the client for interacting with the ChunithmDefaultServer builders.
 	ChunithmDefaultServer *ChunithmDefaultServerClient
+	// ChunithmGroupAlias is the client for interacting with the ChunithmGroupAlias builders.
+	ChunithmGroupAlias *ChunithmGroupAliasClient
 	// ChunithmMusicAlias is the client for interacting with the ChunithmMusicAlias builders.
 	ChunithmMusicAlias *ChunithmMusicAliasClient
+	// ChunithmPendingAlias is the client for interacting with the ChunithmPendingAlias builders.
+	ChunithmPendingAlias *ChunithmPendingAliasClient
+	// ChunithmRejectedAlias is the client for interacting with the ChunithmRejectedAlias builders.
+	ChunithmRejectedAlias *ChunithmRejectedAliasClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +157,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ChunithmAliasAdmin = NewChunithmAliasAdminClient(tx.config)
 	tx.ChunithmBinding = NewChunithmBindingClient(tx.config)
 	tx.ChunithmDefaultServer = NewChunithmDefaultServerClient(tx.config)
+	tx.ChunithmGroupAlias = NewChunithmGroupAliasClient(tx.config)
 	tx.ChunithmMusicAlias = NewChunithmMusicAliasClient(tx.config)
+	tx.ChunithmPendingAlias = NewChunithmPendingAliasClient(tx.config)
+	tx.ChunithmRejectedAlias = NewChunithmRejectedAliasClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +173,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ChunithmBinding.QueryXXX(), the query will be executed
+// applies a query, for example: ChunithmAliasAdmin.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -35,7 +35,9 @@ type ChunithmChartData struct {
 	// FlickCount holds the value of the "flick_count" field.
 	FlickCount *int `json:"flick_count,omitempty"`
 	// TotalCount holds the value of the "total_count" field.
-	TotalCount   *int `json:"total_count,omitempty"`
+	TotalCount *int `json:"total_count,omitempty"`
+	// Chart length in seconds
+	Duration     *float64 `json:"duration,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -44,7 +46,7 @@ func (*ChunithmChartData) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case chunithmchartdata.FieldBpm:
+		case chunithmchartdata.FieldBpm, chunithmchartdata.FieldDuration:
 			values[i] = new(sql.NullFloat64)
 		case chunithmchartdata.FieldID, chunithmchartdata.FieldMusicID, chunithmchartdata.FieldDifficulty, chunithmchartdata.FieldTapCount, chunithmchartdata.FieldHoldCount, chunithmchartdata.FieldSlideCount, chunithmchartdata.FieldAirCount, chunithmchartdata.FieldFlickCount, chunithmchartdata.FieldTotalCount:
 			values[i] = new(sql.NullInt64)
@@ -139,6 +141,13 @@ func (_m *ChunithmChartData) assignValues(columns []string, values []any) error
 				_m.TotalCount = new(int)
 				*_m.TotalCount = int(value.Int64)
 			}
+		case chunithmchartdata.FieldDuration:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				_m.Duration = new(float64)
+				*_m.Duration = value.Float64
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -220,6 +229,11 @@ func (_m *ChunithmChartData) String() string {
 		builder.WriteString("total_count=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.Duration; v != nil {
+		builder.WriteString("duration=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
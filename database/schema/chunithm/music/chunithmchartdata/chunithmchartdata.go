@@ -31,6 +31,8 @@ const (
 	FieldFlickCount = "flick_count"
 	// FieldTotalCount holds the string denoting the total_count field in the database.
 	FieldTotalCount = "total_count"
+	// FieldDuration holds the string denoting the duration field in the database.
+	FieldDuration = "duration"
 	// Table holds the table name of the chunithmchartdata in the database.
 	Table = "chart_data"
 )
@@ -48,6 +50,7 @@ var Columns = []string{
 	FieldAirCount,
 	FieldFlickCount,
 	FieldTotalCount,
+	FieldDuration,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -122,3 +125,8 @@ func ByFlickCount(opts ...sql.OrderTermOption) OrderOption {
 func ByTotalCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalCount, opts...).ToFunc()
 }
+
+// ByDuration orders the results by the duration field.
+func ByDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuration, opts...).ToFunc()
+}
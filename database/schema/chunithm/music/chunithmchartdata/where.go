@@ -103,6 +103,11 @@ func TotalCount(v int) predicate.ChunithmChartData {
 	return predicate.ChunithmChartData(sql.FieldEQ(FieldTotalCount, v))
 }
 
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v float64) predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldEQ(FieldDuration, v))
+}
+
 // MusicIDEQ applies the EQ predicate on the "music_id" field.
 func MusicIDEQ(v int) predicate.ChunithmChartData {
 	return predicate.ChunithmChartData(sql.FieldEQ(FieldMusicID, v))
@@ -608,6 +613,56 @@ func TotalCountNotNil() predicate.ChunithmChartData {
 	return predicate.ChunithmChartData(sql.FieldNotNull(FieldTotalCount))
 }
 
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v float64) predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v float64) predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...float64) predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...float64) predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v float64) predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldGT(FieldDuration, v))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v float64) predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldGTE(FieldDuration, v))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v float64) predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldLT(FieldDuration, v))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v float64) predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldLTE(FieldDuration, v))
+}
+
+// DurationIsNil applies the IsNil predicate on the "duration" field.
+func DurationIsNil() predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldIsNull(FieldDuration))
+}
+
+// DurationNotNil applies the NotNil predicate on the "duration" field.
+func DurationNotNil() predicate.ChunithmChartData {
+	return predicate.ChunithmChartData(sql.FieldNotNull(FieldDuration))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ChunithmChartData) predicate.ChunithmChartData {
 	return predicate.ChunithmChartData(sql.AndPredicates(predicates...))
@@ -143,6 +143,20 @@ func (_c *ChunithmChartDataCreate) SetNillableTotalCount(v *int) *ChunithmChartD
 	return _c
 }
 
+// SetDuration sets the "duration" field.
+func (_c *ChunithmChartDataCreate) SetDuration(v float64) *ChunithmChartDataCreate {
+	_c.mutation.SetDuration(v)
+	return _c
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_c *ChunithmChartDataCreate) SetNillableDuration(v *float64) *ChunithmChartDataCreate {
+	if v != nil {
+		_c.SetDuration(*v)
+	}
+	return _c
+}
+
 // Mutation returns the ChunithmChartDataMutation object of the builder.
 func (_c *ChunithmChartDataCreate) Mutation() *ChunithmChartDataMutation {
 	return _c.mutation
@@ -254,6 +268,10 @@ func (_c *ChunithmChartDataCreate) createSpec() (*ChunithmChartData, *sqlgraph.C
 		_spec.SetField(chunithmchartdata.FieldTotalCount, field.TypeInt, value)
 		_node.TotalCount = &value
 	}
+	if value, ok := _c.mutation.Duration(); ok {
+		_spec.SetField(chunithmchartdata.FieldDuration, field.TypeFloat64, value)
+		_node.Duration = &value
+	}
 	return _node, _spec
 }
 
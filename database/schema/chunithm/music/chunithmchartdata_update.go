@@ -278,6 +278,33 @@ func (_u *ChunithmChartDataUpdate) ClearTotalCount() *ChunithmChartDataUpdate {
 	return _u
 }
 
+// SetDuration sets the "duration" field.
+func (_u *ChunithmChartDataUpdate) SetDuration(v float64) *ChunithmChartDataUpdate {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *ChunithmChartDataUpdate) SetNillableDuration(v *float64) *ChunithmChartDataUpdate {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *ChunithmChartDataUpdate) AddDuration(v float64) *ChunithmChartDataUpdate {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (_u *ChunithmChartDataUpdate) ClearDuration() *ChunithmChartDataUpdate {
+	_u.mutation.ClearDuration()
+	return _u
+}
+
 // Mutation returns the ChunithmChartDataMutation object of the builder.
 func (_u *ChunithmChartDataUpdate) Mutation() *ChunithmChartDataMutation {
 	return _u.mutation
@@ -413,6 +440,15 @@ func (_u *ChunithmChartDataUpdate) sqlSave(ctx context.Context) (_node int, err
 	if _u.mutation.TotalCountCleared() {
 		_spec.ClearField(chunithmchartdata.FieldTotalCount, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(chunithmchartdata.FieldDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(chunithmchartdata.FieldDuration, field.TypeFloat64, value)
+	}
+	if _u.mutation.DurationCleared() {
+		_spec.ClearField(chunithmchartdata.FieldDuration, field.TypeFloat64)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{chunithmchartdata.Label}
@@ -684,6 +720,33 @@ func (_u *ChunithmChartDataUpdateOne) ClearTotalCount() *ChunithmChartDataUpdate
 	return _u
 }
 
+// SetDuration sets the "duration" field.
+func (_u *ChunithmChartDataUpdateOne) SetDuration(v float64) *ChunithmChartDataUpdateOne {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *ChunithmChartDataUpdateOne) SetNillableDuration(v *float64) *ChunithmChartDataUpdateOne {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *ChunithmChartDataUpdateOne) AddDuration(v float64) *ChunithmChartDataUpdateOne {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (_u *ChunithmChartDataUpdateOne) ClearDuration() *ChunithmChartDataUpdateOne {
+	_u.mutation.ClearDuration()
+	return _u
+}
+
 // Mutation returns the ChunithmChartDataMutation object of the builder.
 func (_u *ChunithmChartDataUpdateOne) Mutation() *ChunithmChartDataMutation {
 	return _u.mutation
@@ -849,6 +912,15 @@ func (_u *ChunithmChartDataUpdateOne) sqlSave(ctx context.Context) (_node *Chuni
 	if _u.mutation.TotalCountCleared() {
 		_spec.ClearField(chunithmchartdata.FieldTotalCount, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(chunithmchartdata.FieldDuration, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(chunithmchartdata.FieldDuration, field.TypeFloat64, value)
+	}
+	if _u.mutation.DurationCleared() {
+		_spec.ClearField(chunithmchartdata.FieldDuration, field.TypeFloat64)
+	}
 	_node = &ChunithmChartData{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package music
+
+import (
+	"fmt"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicavailability"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ChunithmMusicAvailability is the model entity for the ChunithmMusicAvailability schema.
+type ChunithmMusicAvailability struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// MusicID holds the value of the "music_id" field.
+	MusicID int `json:"music_id,omitempty"`
+	// Server holds the value of the "server" field.
+	Server string `json:"server,omitempty"`
+	// ReleaseVersion holds the value of the "release_version" field.
+	ReleaseVersion *string `json:"release_version,omitempty"`
+	// ReleaseDate holds the value of the "release_date" field.
+	ReleaseDate *time.Time `json:"release_date,omitempty"`
+	// IsDeleted holds the value of the "is_deleted" field.
+	IsDeleted bool `json:"is_deleted,omitempty"`
+	// DeletedVersion holds the value of the "deleted_version" field.
+	DeletedVersion *string `json:"deleted_version,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChunithmMusicAvailability) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chunithmmusicavailability.FieldIsDeleted:
+			values[i] = new(sql.NullBool)
+		case chunithmmusicavailability.FieldID, chunithmmusicavailability.FieldMusicID:
+			values[i] = new(sql.NullInt64)
+		case chunithmmusicavailability.FieldServer, chunithmmusicavailability.FieldReleaseVersion, chunithmmusicavailability.FieldDeletedVersion:
+			values[i] = new(sql.NullString)
+		case chunithmmusicavailability.FieldReleaseDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChunithmMusicAvailability fields.
+func (_m *ChunithmMusicAvailability) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chunithmmusicavailability.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case chunithmmusicavailability.FieldMusicID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field music_id", values[i])
+			} else if value.Valid {
+				_m.MusicID = int(value.Int64)
+			}
+		case chunithmmusicavailability.FieldServer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field server", values[i])
+			} else if value.Valid {
+				_m.Server = value.String
+			}
+		case chunithmmusicavailability.FieldReleaseVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field release_version", values[i])
+			} else if value.Valid {
+				_m.ReleaseVersion = new(string)
+				*_m.ReleaseVersion = value.String
+			}
+		case chunithmmusicavailability.FieldReleaseDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field release_date", values[i])
+			} else if value.Valid {
+				_m.ReleaseDate = new(time.Time)
+				*_m.ReleaseDate = value.Time
+			}
+		case chunithmmusicavailability.FieldIsDeleted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_deleted", values[i])
+			} else if value.Valid {
+				_m.IsDeleted = value.Bool
+			}
+		case chunithmmusicavailability.FieldDeletedVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_version", values[i])
+			} else if value.Valid {
+				_m.DeletedVersion = new(string)
+				*_m.DeletedVersion = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChunithmMusicAvailability.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChunithmMusicAvailability) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ChunithmMusicAvailability.
+// Note that you need to call ChunithmMusicAvailability.Unwrap() before calling this method if this ChunithmMusicAvailability
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChunithmMusicAvailability) Update() *ChunithmMusicAvailabilityUpdateOne {
+	return NewChunithmMusicAvailabilityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChunithmMusicAvailability entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChunithmMusicAvailability) Unwrap() *ChunithmMusicAvailability {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("music: ChunithmMusicAvailability is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChunithmMusicAvailability) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChunithmMusicAvailability(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("music_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MusicID))
+	builder.WriteString(", ")
+	builder.WriteString("server=")
+	builder.WriteString(_m.Server)
+	builder.WriteString(", ")
+	if v := _m.ReleaseVersion; v != nil {
+		builder.WriteString("release_version=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ReleaseDate; v != nil {
+		builder.WriteString("release_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_deleted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDeleted))
+	builder.WriteString(", ")
+	if v := _m.DeletedVersion; v != nil {
+		builder.WriteString("deleted_version=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChunithmMusicAvailabilities is a parsable slice of ChunithmMusicAvailability.
+type ChunithmMusicAvailabilities []*ChunithmMusicAvailability
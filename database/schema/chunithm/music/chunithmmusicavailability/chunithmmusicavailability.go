@@ -0,0 +1,98 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chunithmmusicavailability
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the chunithmmusicavailability type in the database.
+	Label = "chunithm_music_availability"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMusicID holds the string denoting the music_id field in the database.
+	FieldMusicID = "music_id"
+	// FieldServer holds the string denoting the server field in the database.
+	FieldServer = "server"
+	// FieldReleaseVersion holds the string denoting the release_version field in the database.
+	FieldReleaseVersion = "release_version"
+	// FieldReleaseDate holds the string denoting the release_date field in the database.
+	FieldReleaseDate = "release_date"
+	// FieldIsDeleted holds the string denoting the is_deleted field in the database.
+	FieldIsDeleted = "is_deleted"
+	// FieldDeletedVersion holds the string denoting the deleted_version field in the database.
+	FieldDeletedVersion = "deleted_version"
+	// Table holds the table name of the chunithmmusicavailability in the database.
+	Table = "music_availability"
+)
+
+// Columns holds all SQL columns for chunithmmusicavailability fields.
+var Columns = []string{
+	FieldID,
+	FieldMusicID,
+	FieldServer,
+	FieldReleaseVersion,
+	FieldReleaseDate,
+	FieldIsDeleted,
+	FieldDeletedVersion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ServerValidator is a validator for the "server" field. It is called by the builders before save.
+	ServerValidator func(string) error
+	// ReleaseVersionValidator is a validator for the "release_version" field. It is called by the builders before save.
+	ReleaseVersionValidator func(string) error
+	// DefaultIsDeleted holds the default value on creation for the "is_deleted" field.
+	DefaultIsDeleted bool
+	// DeletedVersionValidator is a validator for the "deleted_version" field. It is called by the builders before save.
+	DeletedVersionValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ChunithmMusicAvailability queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMusicID orders the results by the music_id field.
+func ByMusicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMusicID, opts...).ToFunc()
+}
+
+// ByServer orders the results by the server field.
+func ByServer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServer, opts...).ToFunc()
+}
+
+// ByReleaseVersion orders the results by the release_version field.
+func ByReleaseVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseVersion, opts...).ToFunc()
+}
+
+// ByReleaseDate orders the results by the release_date field.
+func ByReleaseDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseDate, opts...).ToFunc()
+}
+
+// ByIsDeleted orders the results by the is_deleted field.
+func ByIsDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDeleted, opts...).ToFunc()
+}
+
+// ByDeletedVersion orders the results by the deleted_version field.
+func ByDeletedVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedVersion, opts...).ToFunc()
+}
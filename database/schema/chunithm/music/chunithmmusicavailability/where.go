@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chunithmmusicavailability
+
+import (
+	"haruki-database/database/schema/chunithm/music/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLTE(FieldID, id))
+}
+
+// MusicID applies equality check predicate on the "music_id" field. It's identical to MusicIDEQ.
+func MusicID(v int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldMusicID, v))
+}
+
+// Server applies equality check predicate on the "server" field. It's identical to ServerEQ.
+func Server(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldServer, v))
+}
+
+// ReleaseVersion applies equality check predicate on the "release_version" field. It's identical to ReleaseVersionEQ.
+func ReleaseVersion(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldReleaseVersion, v))
+}
+
+// ReleaseDate applies equality check predicate on the "release_date" field. It's identical to ReleaseDateEQ.
+func ReleaseDate(v time.Time) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldReleaseDate, v))
+}
+
+// IsDeleted applies equality check predicate on the "is_deleted" field. It's identical to IsDeletedEQ.
+func IsDeleted(v bool) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldIsDeleted, v))
+}
+
+// DeletedVersion applies equality check predicate on the "deleted_version" field. It's identical to DeletedVersionEQ.
+func DeletedVersion(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldDeletedVersion, v))
+}
+
+// MusicIDEQ applies the EQ predicate on the "music_id" field.
+func MusicIDEQ(v int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldMusicID, v))
+}
+
+// MusicIDNEQ applies the NEQ predicate on the "music_id" field.
+func MusicIDNEQ(v int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNEQ(FieldMusicID, v))
+}
+
+// MusicIDIn applies the In predicate on the "music_id" field.
+func MusicIDIn(vs ...int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldIn(FieldMusicID, vs...))
+}
+
+// MusicIDNotIn applies the NotIn predicate on the "music_id" field.
+func MusicIDNotIn(vs ...int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNotIn(FieldMusicID, vs...))
+}
+
+// MusicIDGT applies the GT predicate on the "music_id" field.
+func MusicIDGT(v int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGT(FieldMusicID, v))
+}
+
+// MusicIDGTE applies the GTE predicate on the "music_id" field.
+func MusicIDGTE(v int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGTE(FieldMusicID, v))
+}
+
+// MusicIDLT applies the LT predicate on the "music_id" field.
+func MusicIDLT(v int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLT(FieldMusicID, v))
+}
+
+// MusicIDLTE applies the LTE predicate on the "music_id" field.
+func MusicIDLTE(v int) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLTE(FieldMusicID, v))
+}
+
+// ServerEQ applies the EQ predicate on the "server" field.
+func ServerEQ(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldServer, v))
+}
+
+// ServerNEQ applies the NEQ predicate on the "server" field.
+func ServerNEQ(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNEQ(FieldServer, v))
+}
+
+// ServerIn applies the In predicate on the "server" field.
+func ServerIn(vs ...string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldIn(FieldServer, vs...))
+}
+
+// ServerNotIn applies the NotIn predicate on the "server" field.
+func ServerNotIn(vs ...string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNotIn(FieldServer, vs...))
+}
+
+// ServerGT applies the GT predicate on the "server" field.
+func ServerGT(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGT(FieldServer, v))
+}
+
+// ServerGTE applies the GTE predicate on the "server" field.
+func ServerGTE(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGTE(FieldServer, v))
+}
+
+// ServerLT applies the LT predicate on the "server" field.
+func ServerLT(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLT(FieldServer, v))
+}
+
+// ServerLTE applies the LTE predicate on the "server" field.
+func ServerLTE(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLTE(FieldServer, v))
+}
+
+// ServerContains applies the Contains predicate on the "server" field.
+func ServerContains(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldContains(FieldServer, v))
+}
+
+// ServerHasPrefix applies the HasPrefix predicate on the "server" field.
+func ServerHasPrefix(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldHasPrefix(FieldServer, v))
+}
+
+// ServerHasSuffix applies the HasSuffix predicate on the "server" field.
+func ServerHasSuffix(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldHasSuffix(FieldServer, v))
+}
+
+// ServerEqualFold applies the EqualFold predicate on the "server" field.
+func ServerEqualFold(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEqualFold(FieldServer, v))
+}
+
+// ServerContainsFold applies the ContainsFold predicate on the "server" field.
+func ServerContainsFold(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldContainsFold(FieldServer, v))
+}
+
+// ReleaseVersionEQ applies the EQ predicate on the "release_version" field.
+func ReleaseVersionEQ(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldReleaseVersion, v))
+}
+
+// ReleaseVersionNEQ applies the NEQ predicate on the "release_version" field.
+func ReleaseVersionNEQ(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNEQ(FieldReleaseVersion, v))
+}
+
+// ReleaseVersionIn applies the In predicate on the "release_version" field.
+func ReleaseVersionIn(vs ...string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldIn(FieldReleaseVersion, vs...))
+}
+
+// ReleaseVersionNotIn applies the NotIn predicate on the "release_version" field.
+func ReleaseVersionNotIn(vs ...string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNotIn(FieldReleaseVersion, vs...))
+}
+
+// ReleaseVersionGT applies the GT predicate on the "release_version" field.
+func ReleaseVersionGT(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGT(FieldReleaseVersion, v))
+}
+
+// ReleaseVersionGTE applies the GTE predicate on the "release_version" field.
+func ReleaseVersionGTE(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGTE(FieldReleaseVersion, v))
+}
+
+// ReleaseVersionLT applies the LT predicate on the "release_version" field.
+func ReleaseVersionLT(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLT(FieldReleaseVersion, v))
+}
+
+// ReleaseVersionLTE applies the LTE predicate on the "release_version" field.
+func ReleaseVersionLTE(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLTE(FieldReleaseVersion, v))
+}
+
+// ReleaseVersionContains applies the Contains predicate on the "release_version" field.
+func ReleaseVersionContains(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldContains(FieldReleaseVersion, v))
+}
+
+// ReleaseVersionHasPrefix applies the HasPrefix predicate on the "release_version" field.
+func ReleaseVersionHasPrefix(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldHasPrefix(FieldReleaseVersion, v))
+}
+
+// ReleaseVersionHasSuffix applies the HasSuffix predicate on the "release_version" field.
+func ReleaseVersionHasSuffix(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldHasSuffix(FieldReleaseVersion, v))
+}
+
+// ReleaseVersionIsNil applies the IsNil predicate on the "release_version" field.
+func ReleaseVersionIsNil() predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldIsNull(FieldReleaseVersion))
+}
+
+// ReleaseVersionNotNil applies the NotNil predicate on the "release_version" field.
+func ReleaseVersionNotNil() predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNotNull(FieldReleaseVersion))
+}
+
+// ReleaseVersionEqualFold applies the EqualFold predicate on the "release_version" field.
+func ReleaseVersionEqualFold(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEqualFold(FieldReleaseVersion, v))
+}
+
+// ReleaseVersionContainsFold applies the ContainsFold predicate on the "release_version" field.
+func ReleaseVersionContainsFold(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldContainsFold(FieldReleaseVersion, v))
+}
+
+// ReleaseDateEQ applies the EQ predicate on the "release_date" field.
+func ReleaseDateEQ(v time.Time) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldReleaseDate, v))
+}
+
+// ReleaseDateNEQ applies the NEQ predicate on the "release_date" field.
+func ReleaseDateNEQ(v time.Time) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNEQ(FieldReleaseDate, v))
+}
+
+// ReleaseDateIn applies the In predicate on the "release_date" field.
+func ReleaseDateIn(vs ...time.Time) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldIn(FieldReleaseDate, vs...))
+}
+
+// ReleaseDateNotIn applies the NotIn predicate on the "release_date" field.
+func ReleaseDateNotIn(vs ...time.Time) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNotIn(FieldReleaseDate, vs...))
+}
+
+// ReleaseDateGT applies the GT predicate on the "release_date" field.
+func ReleaseDateGT(v time.Time) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGT(FieldReleaseDate, v))
+}
+
+// ReleaseDateGTE applies the GTE predicate on the "release_date" field.
+func ReleaseDateGTE(v time.Time) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGTE(FieldReleaseDate, v))
+}
+
+// ReleaseDateLT applies the LT predicate on the "release_date" field.
+func ReleaseDateLT(v time.Time) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLT(FieldReleaseDate, v))
+}
+
+// ReleaseDateLTE applies the LTE predicate on the "release_date" field.
+func ReleaseDateLTE(v time.Time) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLTE(FieldReleaseDate, v))
+}
+
+// ReleaseDateIsNil applies the IsNil predicate on the "release_date" field.
+func ReleaseDateIsNil() predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldIsNull(FieldReleaseDate))
+}
+
+// ReleaseDateNotNil applies the NotNil predicate on the "release_date" field.
+func ReleaseDateNotNil() predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNotNull(FieldReleaseDate))
+}
+
+// IsDeletedEQ applies the EQ predicate on the "is_deleted" field.
+func IsDeletedEQ(v bool) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldIsDeleted, v))
+}
+
+// IsDeletedNEQ applies the NEQ predicate on the "is_deleted" field.
+func IsDeletedNEQ(v bool) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNEQ(FieldIsDeleted, v))
+}
+
+// DeletedVersionEQ applies the EQ predicate on the "deleted_version" field.
+func DeletedVersionEQ(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEQ(FieldDeletedVersion, v))
+}
+
+// DeletedVersionNEQ applies the NEQ predicate on the "deleted_version" field.
+func DeletedVersionNEQ(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNEQ(FieldDeletedVersion, v))
+}
+
+// DeletedVersionIn applies the In predicate on the "deleted_version" field.
+func DeletedVersionIn(vs ...string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldIn(FieldDeletedVersion, vs...))
+}
+
+// DeletedVersionNotIn applies the NotIn predicate on the "deleted_version" field.
+func DeletedVersionNotIn(vs ...string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNotIn(FieldDeletedVersion, vs...))
+}
+
+// DeletedVersionGT applies the GT predicate on the "deleted_version" field.
+func DeletedVersionGT(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGT(FieldDeletedVersion, v))
+}
+
+// DeletedVersionGTE applies the GTE predicate on the "deleted_version" field.
+func DeletedVersionGTE(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldGTE(FieldDeletedVersion, v))
+}
+
+// DeletedVersionLT applies the LT predicate on the "deleted_version" field.
+func DeletedVersionLT(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLT(FieldDeletedVersion, v))
+}
+
+// DeletedVersionLTE applies the LTE predicate on the "deleted_version" field.
+func DeletedVersionLTE(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldLTE(FieldDeletedVersion, v))
+}
+
+// DeletedVersionContains applies the Contains predicate on the "deleted_version" field.
+func DeletedVersionContains(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldContains(FieldDeletedVersion, v))
+}
+
+// DeletedVersionHasPrefix applies the HasPrefix predicate on the "deleted_version" field.
+func DeletedVersionHasPrefix(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldHasPrefix(FieldDeletedVersion, v))
+}
+
+// DeletedVersionHasSuffix applies the HasSuffix predicate on the "deleted_version" field.
+func DeletedVersionHasSuffix(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldHasSuffix(FieldDeletedVersion, v))
+}
+
+// DeletedVersionIsNil applies the IsNil predicate on the "deleted_version" field.
+func DeletedVersionIsNil() predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldIsNull(FieldDeletedVersion))
+}
+
+// DeletedVersionNotNil applies the NotNil predicate on the "deleted_version" field.
+func DeletedVersionNotNil() predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldNotNull(FieldDeletedVersion))
+}
+
+// DeletedVersionEqualFold applies the EqualFold predicate on the "deleted_version" field.
+func DeletedVersionEqualFold(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldEqualFold(FieldDeletedVersion, v))
+}
+
+// DeletedVersionContainsFold applies the ContainsFold predicate on the "deleted_version" field.
+func DeletedVersionContainsFold(v string) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.FieldContainsFold(FieldDeletedVersion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChunithmMusicAvailability) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChunithmMusicAvailability) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChunithmMusicAvailability) predicate.ChunithmMusicAvailability {
+	return predicate.ChunithmMusicAvailability(sql.NotPredicates(p))
+}
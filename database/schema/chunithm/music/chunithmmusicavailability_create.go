@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package music
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicavailability"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmMusicAvailabilityCreate is the builder for creating a ChunithmMusicAvailability entity.
+type ChunithmMusicAvailabilityCreate struct {
+	config
+	mutation *ChunithmMusicAvailabilityMutation
+	hooks    []Hook
+}
+
+// SetMusicID sets the "music_id" field.
+func (_c *ChunithmMusicAvailabilityCreate) SetMusicID(v int) *ChunithmMusicAvailabilityCreate {
+	_c.mutation.SetMusicID(v)
+	return _c
+}
+
+// SetServer sets the "server" field.
+func (_c *ChunithmMusicAvailabilityCreate) SetServer(v string) *ChunithmMusicAvailabilityCreate {
+	_c.mutation.SetServer(v)
+	return _c
+}
+
+// SetReleaseVersion sets the "release_version" field.
+func (_c *ChunithmMusicAvailabilityCreate) SetReleaseVersion(v string) *ChunithmMusicAvailabilityCreate {
+	_c.mutation.SetReleaseVersion(v)
+	return _c
+}
+
+// SetNillableReleaseVersion sets the "release_version" field if the given value is not nil.
+func (_c *ChunithmMusicAvailabilityCreate) SetNillableReleaseVersion(v *string) *ChunithmMusicAvailabilityCreate {
+	if v != nil {
+		_c.SetReleaseVersion(*v)
+	}
+	return _c
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (_c *ChunithmMusicAvailabilityCreate) SetReleaseDate(v time.Time) *ChunithmMusicAvailabilityCreate {
+	_c.mutation.SetReleaseDate(v)
+	return _c
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (_c *ChunithmMusicAvailabilityCreate) SetNillableReleaseDate(v *time.Time) *ChunithmMusicAvailabilityCreate {
+	if v != nil {
+		_c.SetReleaseDate(*v)
+	}
+	return _c
+}
+
+// SetIsDeleted sets the "is_deleted" field.
+func (_c *ChunithmMusicAvailabilityCreate) SetIsDeleted(v bool) *ChunithmMusicAvailabilityCreate {
+	_c.mutation.SetIsDeleted(v)
+	return _c
+}
+
+// SetNillableIsDeleted sets the "is_deleted" field if the given value is not nil.
+func (_c *ChunithmMusicAvailabilityCreate) SetNillableIsDeleted(v *bool) *ChunithmMusicAvailabilityCreate {
+	if v != nil {
+		_c.SetIsDeleted(*v)
+	}
+	return _c
+}
+
+// SetDeletedVersion sets the "deleted_version" field.
+func (_c *ChunithmMusicAvailabilityCreate) SetDeletedVersion(v string) *ChunithmMusicAvailabilityCreate {
+	_c.mutation.SetDeletedVersion(v)
+	return _c
+}
+
+// SetNillableDeletedVersion sets the "deleted_version" field if the given value is not nil.
+func (_c *ChunithmMusicAvailabilityCreate) SetNillableDeletedVersion(v *string) *ChunithmMusicAvailabilityCreate {
+	if v != nil {
+		_c.SetDeletedVersion(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ChunithmMusicAvailabilityMutation object of the builder.
+func (_c *ChunithmMusicAvailabilityCreate) Mutation() *ChunithmMusicAvailabilityMutation {
+	return _c.mutation
+}
+
+// Save creates the ChunithmMusicAvailability in the database.
+func (_c *ChunithmMusicAvailabilityCreate) Save(ctx context.Context) (*ChunithmMusicAvailability, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChunithmMusicAvailabilityCreate) SaveX(ctx context.Context) *ChunithmMusicAvailability {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmMusicAvailabilityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmMusicAvailabilityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChunithmMusicAvailabilityCreate) defaults() {
+	if _, ok := _c.mutation.IsDeleted(); !ok {
+		v := chunithmmusicavailability.DefaultIsDeleted
+		_c.mutation.SetIsDeleted(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChunithmMusicAvailabilityCreate) check() error {
+	if _, ok := _c.mutation.MusicID(); !ok {
+		return &ValidationError{Name: "music_id", err: errors.New(`music: missing required field "ChunithmMusicAvailability.music_id"`)}
+	}
+	if _, ok := _c.mutation.Server(); !ok {
+		return &ValidationError{Name: "server", err: errors.New(`music: missing required field "ChunithmMusicAvailability.server"`)}
+	}
+	if v, ok := _c.mutation.Server(); ok {
+		if err := chunithmmusicavailability.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`music: validator failed for field "ChunithmMusicAvailability.server": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ReleaseVersion(); ok {
+		if err := chunithmmusicavailability.ReleaseVersionValidator(v); err != nil {
+			return &ValidationError{Name: "release_version", err: fmt.Errorf(`music: validator failed for field "ChunithmMusicAvailability.release_version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsDeleted(); !ok {
+		return &ValidationError{Name: "is_deleted", err: errors.New(`music: missing required field "ChunithmMusicAvailability.is_deleted"`)}
+	}
+	if v, ok := _c.mutation.DeletedVersion(); ok {
+		if err := chunithmmusicavailability.DeletedVersionValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_version", err: fmt.Errorf(`music: validator failed for field "ChunithmMusicAvailability.deleted_version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ChunithmMusicAvailabilityCreate) sqlSave(ctx context.Context) (*ChunithmMusicAvailability, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChunithmMusicAvailabilityCreate) createSpec() (*ChunithmMusicAvailability, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChunithmMusicAvailability{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chunithmmusicavailability.Table, sqlgraph.NewFieldSpec(chunithmmusicavailability.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.MusicID(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldMusicID, field.TypeInt, value)
+		_node.MusicID = value
+	}
+	if value, ok := _c.mutation.Server(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldServer, field.TypeString, value)
+		_node.Server = value
+	}
+	if value, ok := _c.mutation.ReleaseVersion(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldReleaseVersion, field.TypeString, value)
+		_node.ReleaseVersion = &value
+	}
+	if value, ok := _c.mutation.ReleaseDate(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldReleaseDate, field.TypeTime, value)
+		_node.ReleaseDate = &value
+	}
+	if value, ok := _c.mutation.IsDeleted(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldIsDeleted, field.TypeBool, value)
+		_node.IsDeleted = value
+	}
+	if value, ok := _c.mutation.DeletedVersion(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldDeletedVersion, field.TypeString, value)
+		_node.DeletedVersion = &value
+	}
+	return _node, _spec
+}
+
+// ChunithmMusicAvailabilityCreateBulk is the builder for creating many ChunithmMusicAvailability entities in bulk.
+type ChunithmMusicAvailabilityCreateBulk struct {
+	config
+	err      error
+	builders []*ChunithmMusicAvailabilityCreate
+}
+
+// Save creates the ChunithmMusicAvailability entities in the database.
+func (_c *ChunithmMusicAvailabilityCreateBulk) Save(ctx context.Context) ([]*ChunithmMusicAvailability, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChunithmMusicAvailability, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChunithmMusicAvailabilityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChunithmMusicAvailabilityCreateBulk) SaveX(ctx context.Context) []*ChunithmMusicAvailability {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmMusicAvailabilityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmMusicAvailabilityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
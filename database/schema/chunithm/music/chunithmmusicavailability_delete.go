@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package music
+
+import (
+	"context"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicavailability"
+	"haruki-database/database/schema/chunithm/music/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmMusicAvailabilityDelete is the builder for deleting a ChunithmMusicAvailability entity.
+type ChunithmMusicAvailabilityDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmMusicAvailabilityMutation
+}
+
+// Where appends a list predicates to the ChunithmMusicAvailabilityDelete builder.
+func (_d *ChunithmMusicAvailabilityDelete) Where(ps ...predicate.ChunithmMusicAvailability) *ChunithmMusicAvailabilityDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ChunithmMusicAvailabilityDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ChunithmMusicAvailabilityDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ChunithmMusicAvailabilityDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(chunithmmusicavailability.Table, sqlgraph.NewFieldSpec(chunithmmusicavailability.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ChunithmMusicAvailabilityDeleteOne is the builder for deleting a single ChunithmMusicAvailability entity.
+type ChunithmMusicAvailabilityDeleteOne struct {
+	_d *ChunithmMusicAvailabilityDelete
+}
+
+// Where appends a list predicates to the ChunithmMusicAvailabilityDelete builder.
+func (_d *ChunithmMusicAvailabilityDeleteOne) Where(ps ...predicate.ChunithmMusicAvailability) *ChunithmMusicAvailabilityDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ChunithmMusicAvailabilityDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{chunithmmusicavailability.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ChunithmMusicAvailabilityDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package music
+
+import (
+	"context"
+	"fmt"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicavailability"
+	"haruki-database/database/schema/chunithm/music/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmMusicAvailabilityQuery is the builder for querying ChunithmMusicAvailability entities.
+type ChunithmMusicAvailabilityQuery struct {
+	config
+	ctx        *QueryContext
+	order      []chunithmmusicavailability.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ChunithmMusicAvailability
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ChunithmMusicAvailabilityQuery builder.
+func (_q *ChunithmMusicAvailabilityQuery) Where(ps ...predicate.ChunithmMusicAvailability) *ChunithmMusicAvailabilityQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ChunithmMusicAvailabilityQuery) Limit(limit int) *ChunithmMusicAvailabilityQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ChunithmMusicAvailabilityQuery) Offset(offset int) *ChunithmMusicAvailabilityQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ChunithmMusicAvailabilityQuery) Unique(unique bool) *ChunithmMusicAvailabilityQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ChunithmMusicAvailabilityQuery) Order(o ...chunithmmusicavailability.OrderOption) *ChunithmMusicAvailabilityQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first ChunithmMusicAvailability entity from the query.
+// Returns a *NotFoundError when no ChunithmMusicAvailability was found.
+func (_q *ChunithmMusicAvailabilityQuery) First(ctx context.Context) (*ChunithmMusicAvailability, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{chunithmmusicavailability.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ChunithmMusicAvailabilityQuery) FirstX(ctx context.Context) *ChunithmMusicAvailability {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ChunithmMusicAvailability ID from the query.
+// Returns a *NotFoundError when no ChunithmMusicAvailability ID was found.
+func (_q *ChunithmMusicAvailabilityQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{chunithmmusicavailability.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *ChunithmMusicAvailabilityQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ChunithmMusicAvailability entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ChunithmMusicAvailability entity is found.
+// Returns a *NotFoundError when no ChunithmMusicAvailability entities are found.
+func (_q *ChunithmMusicAvailabilityQuery) Only(ctx context.Context) (*ChunithmMusicAvailability, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{chunithmmusicavailability.Label}
+	default:
+		return nil, &NotSingularError{chunithmmusicavailability.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ChunithmMusicAvailabilityQuery) OnlyX(ctx context.Context) *ChunithmMusicAvailability {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ChunithmMusicAvailability ID in the query.
+// Returns a *NotSingularError when more than one ChunithmMusicAvailability ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *ChunithmMusicAvailabilityQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{chunithmmusicavailability.Label}
+	default:
+		err = &NotSingularError{chunithmmusicavailability.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *ChunithmMusicAvailabilityQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ChunithmMusicAvailabilities.
+func (_q *ChunithmMusicAvailabilityQuery) All(ctx context.Context) ([]*ChunithmMusicAvailability, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ChunithmMusicAvailability, *ChunithmMusicAvailabilityQuery]()
+	return withInterceptors[[]*ChunithmMusicAvailability](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ChunithmMusicAvailabilityQuery) AllX(ctx context.Context) []*ChunithmMusicAvailability {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ChunithmMusicAvailability IDs.
+func (_q *ChunithmMusicAvailabilityQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(chunithmmusicavailability.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *ChunithmMusicAvailabilityQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *ChunithmMusicAvailabilityQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ChunithmMusicAvailabilityQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ChunithmMusicAvailabilityQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ChunithmMusicAvailabilityQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("music: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ChunithmMusicAvailabilityQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ChunithmMusicAvailabilityQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ChunithmMusicAvailabilityQuery) Clone() *ChunithmMusicAvailabilityQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ChunithmMusicAvailabilityQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]chunithmmusicavailability.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.ChunithmMusicAvailability{}, _q.predicates...),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MusicID int `json:"music_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ChunithmMusicAvailability.Query().
+//		GroupBy(chunithmmusicavailability.FieldMusicID).
+//		Aggregate(music.Count()).
+//		Scan(ctx, &v)
+func (_q *ChunithmMusicAvailabilityQuery) GroupBy(field string, fields ...string) *ChunithmMusicAvailabilityGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ChunithmMusicAvailabilityGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = chunithmmusicavailability.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MusicID int `json:"music_id,omitempty"`
+//	}
+//
+//	client.ChunithmMusicAvailability.Query().
+//		Select(chunithmmusicavailability.FieldMusicID).
+//		Scan(ctx, &v)
+func (_q *ChunithmMusicAvailabilityQuery) Select(fields ...string) *ChunithmMusicAvailabilitySelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ChunithmMusicAvailabilitySelect{ChunithmMusicAvailabilityQuery: _q}
+	sbuild.label = chunithmmusicavailability.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ChunithmMusicAvailabilitySelect configured with the given aggregations.
+func (_q *ChunithmMusicAvailabilityQuery) Aggregate(fns ...AggregateFunc) *ChunithmMusicAvailabilitySelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ChunithmMusicAvailabilityQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("music: uninitialized interceptor (forgotten import music/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !chunithmmusicavailability.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("music: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *ChunithmMusicAvailabilityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ChunithmMusicAvailability, error) {
+	var (
+		nodes = []*ChunithmMusicAvailability{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ChunithmMusicAvailability).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ChunithmMusicAvailability{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *ChunithmMusicAvailabilityQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ChunithmMusicAvailabilityQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(chunithmmusicavailability.Table, chunithmmusicavailability.Columns, sqlgraph.NewFieldSpec(chunithmmusicavailability.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chunithmmusicavailability.FieldID)
+		for i := range fields {
+			if fields[i] != chunithmmusicavailability.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ChunithmMusicAvailabilityQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(chunithmmusicavailability.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = chunithmmusicavailability.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ChunithmMusicAvailabilityGroupBy is the group-by builder for ChunithmMusicAvailability entities.
+type ChunithmMusicAvailabilityGroupBy struct {
+	selector
+	build *ChunithmMusicAvailabilityQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ChunithmMusicAvailabilityGroupBy) Aggregate(fns ...AggregateFunc) *ChunithmMusicAvailabilityGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ChunithmMusicAvailabilityGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChunithmMusicAvailabilityQuery, *ChunithmMusicAvailabilityGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ChunithmMusicAvailabilityGroupBy) sqlScan(ctx context.Context, root *ChunithmMusicAvailabilityQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ChunithmMusicAvailabilitySelect is the builder for selecting fields of ChunithmMusicAvailability entities.
+type ChunithmMusicAvailabilitySelect struct {
+	*ChunithmMusicAvailabilityQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ChunithmMusicAvailabilitySelect) Aggregate(fns ...AggregateFunc) *ChunithmMusicAvailabilitySelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ChunithmMusicAvailabilitySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChunithmMusicAvailabilityQuery, *ChunithmMusicAvailabilitySelect](ctx, _s.ChunithmMusicAvailabilityQuery, _s, _s.inters, v)
+}
+
+func (_s *ChunithmMusicAvailabilitySelect) sqlScan(ctx context.Context, root *ChunithmMusicAvailabilityQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
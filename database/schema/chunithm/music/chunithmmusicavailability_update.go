@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package music
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicavailability"
+	"haruki-database/database/schema/chunithm/music/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmMusicAvailabilityUpdate is the builder for updating ChunithmMusicAvailability entities.
+type ChunithmMusicAvailabilityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmMusicAvailabilityMutation
+}
+
+// Where appends a list predicates to the ChunithmMusicAvailabilityUpdate builder.
+func (_u *ChunithmMusicAvailabilityUpdate) Where(ps ...predicate.ChunithmMusicAvailability) *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMusicID sets the "music_id" field.
+func (_u *ChunithmMusicAvailabilityUpdate) SetMusicID(v int) *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.ResetMusicID()
+	_u.mutation.SetMusicID(v)
+	return _u
+}
+
+// SetNillableMusicID sets the "music_id" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdate) SetNillableMusicID(v *int) *ChunithmMusicAvailabilityUpdate {
+	if v != nil {
+		_u.SetMusicID(*v)
+	}
+	return _u
+}
+
+// AddMusicID adds value to the "music_id" field.
+func (_u *ChunithmMusicAvailabilityUpdate) AddMusicID(v int) *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.AddMusicID(v)
+	return _u
+}
+
+// SetServer sets the "server" field.
+func (_u *ChunithmMusicAvailabilityUpdate) SetServer(v string) *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.SetServer(v)
+	return _u
+}
+
+// SetNillableServer sets the "server" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdate) SetNillableServer(v *string) *ChunithmMusicAvailabilityUpdate {
+	if v != nil {
+		_u.SetServer(*v)
+	}
+	return _u
+}
+
+// SetReleaseVersion sets the "release_version" field.
+func (_u *ChunithmMusicAvailabilityUpdate) SetReleaseVersion(v string) *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.SetReleaseVersion(v)
+	return _u
+}
+
+// SetNillableReleaseVersion sets the "release_version" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdate) SetNillableReleaseVersion(v *string) *ChunithmMusicAvailabilityUpdate {
+	if v != nil {
+		_u.SetReleaseVersion(*v)
+	}
+	return _u
+}
+
+// ClearReleaseVersion clears the value of the "release_version" field.
+func (_u *ChunithmMusicAvailabilityUpdate) ClearReleaseVersion() *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.ClearReleaseVersion()
+	return _u
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (_u *ChunithmMusicAvailabilityUpdate) SetReleaseDate(v time.Time) *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.SetReleaseDate(v)
+	return _u
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdate) SetNillableReleaseDate(v *time.Time) *ChunithmMusicAvailabilityUpdate {
+	if v != nil {
+		_u.SetReleaseDate(*v)
+	}
+	return _u
+}
+
+// ClearReleaseDate clears the value of the "release_date" field.
+func (_u *ChunithmMusicAvailabilityUpdate) ClearReleaseDate() *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.ClearReleaseDate()
+	return _u
+}
+
+// SetIsDeleted sets the "is_deleted" field.
+func (_u *ChunithmMusicAvailabilityUpdate) SetIsDeleted(v bool) *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.SetIsDeleted(v)
+	return _u
+}
+
+// SetNillableIsDeleted sets the "is_deleted" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdate) SetNillableIsDeleted(v *bool) *ChunithmMusicAvailabilityUpdate {
+	if v != nil {
+		_u.SetIsDeleted(*v)
+	}
+	return _u
+}
+
+// SetDeletedVersion sets the "deleted_version" field.
+func (_u *ChunithmMusicAvailabilityUpdate) SetDeletedVersion(v string) *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.SetDeletedVersion(v)
+	return _u
+}
+
+// SetNillableDeletedVersion sets the "deleted_version" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdate) SetNillableDeletedVersion(v *string) *ChunithmMusicAvailabilityUpdate {
+	if v != nil {
+		_u.SetDeletedVersion(*v)
+	}
+	return _u
+}
+
+// ClearDeletedVersion clears the value of the "deleted_version" field.
+func (_u *ChunithmMusicAvailabilityUpdate) ClearDeletedVersion() *ChunithmMusicAvailabilityUpdate {
+	_u.mutation.ClearDeletedVersion()
+	return _u
+}
+
+// Mutation returns the ChunithmMusicAvailabilityMutation object of the builder.
+func (_u *ChunithmMusicAvailabilityUpdate) Mutation() *ChunithmMusicAvailabilityMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChunithmMusicAvailabilityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmMusicAvailabilityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChunithmMusicAvailabilityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmMusicAvailabilityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmMusicAvailabilityUpdate) check() error {
+	if v, ok := _u.mutation.Server(); ok {
+		if err := chunithmmusicavailability.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`music: validator failed for field "ChunithmMusicAvailability.server": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReleaseVersion(); ok {
+		if err := chunithmmusicavailability.ReleaseVersionValidator(v); err != nil {
+			return &ValidationError{Name: "release_version", err: fmt.Errorf(`music: validator failed for field "ChunithmMusicAvailability.release_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedVersion(); ok {
+		if err := chunithmmusicavailability.DeletedVersionValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_version", err: fmt.Errorf(`music: validator failed for field "ChunithmMusicAvailability.deleted_version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmMusicAvailabilityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmmusicavailability.Table, chunithmmusicavailability.Columns, sqlgraph.NewFieldSpec(chunithmmusicavailability.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MusicID(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMusicID(); ok {
+		_spec.AddField(chunithmmusicavailability.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Server(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldServer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReleaseVersion(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldReleaseVersion, field.TypeString, value)
+	}
+	if _u.mutation.ReleaseVersionCleared() {
+		_spec.ClearField(chunithmmusicavailability.FieldReleaseVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReleaseDate(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldReleaseDate, field.TypeTime, value)
+	}
+	if _u.mutation.ReleaseDateCleared() {
+		_spec.ClearField(chunithmmusicavailability.FieldReleaseDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IsDeleted(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldIsDeleted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeletedVersion(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldDeletedVersion, field.TypeString, value)
+	}
+	if _u.mutation.DeletedVersionCleared() {
+		_spec.ClearField(chunithmmusicavailability.FieldDeletedVersion, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmmusicavailability.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChunithmMusicAvailabilityUpdateOne is the builder for updating a single ChunithmMusicAvailability entity.
+type ChunithmMusicAvailabilityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChunithmMusicAvailabilityMutation
+}
+
+// SetMusicID sets the "music_id" field.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetMusicID(v int) *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.ResetMusicID()
+	_u.mutation.SetMusicID(v)
+	return _u
+}
+
+// SetNillableMusicID sets the "music_id" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetNillableMusicID(v *int) *ChunithmMusicAvailabilityUpdateOne {
+	if v != nil {
+		_u.SetMusicID(*v)
+	}
+	return _u
+}
+
+// AddMusicID adds value to the "music_id" field.
+func (_u *ChunithmMusicAvailabilityUpdateOne) AddMusicID(v int) *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.AddMusicID(v)
+	return _u
+}
+
+// SetServer sets the "server" field.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetServer(v string) *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.SetServer(v)
+	return _u
+}
+
+// SetNillableServer sets the "server" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetNillableServer(v *string) *ChunithmMusicAvailabilityUpdateOne {
+	if v != nil {
+		_u.SetServer(*v)
+	}
+	return _u
+}
+
+// SetReleaseVersion sets the "release_version" field.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetReleaseVersion(v string) *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.SetReleaseVersion(v)
+	return _u
+}
+
+// SetNillableReleaseVersion sets the "release_version" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetNillableReleaseVersion(v *string) *ChunithmMusicAvailabilityUpdateOne {
+	if v != nil {
+		_u.SetReleaseVersion(*v)
+	}
+	return _u
+}
+
+// ClearReleaseVersion clears the value of the "release_version" field.
+func (_u *ChunithmMusicAvailabilityUpdateOne) ClearReleaseVersion() *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.ClearReleaseVersion()
+	return _u
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetReleaseDate(v time.Time) *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.SetReleaseDate(v)
+	return _u
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetNillableReleaseDate(v *time.Time) *ChunithmMusicAvailabilityUpdateOne {
+	if v != nil {
+		_u.SetReleaseDate(*v)
+	}
+	return _u
+}
+
+// ClearReleaseDate clears the value of the "release_date" field.
+func (_u *ChunithmMusicAvailabilityUpdateOne) ClearReleaseDate() *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.ClearReleaseDate()
+	return _u
+}
+
+// SetIsDeleted sets the "is_deleted" field.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetIsDeleted(v bool) *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.SetIsDeleted(v)
+	return _u
+}
+
+// SetNillableIsDeleted sets the "is_deleted" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetNillableIsDeleted(v *bool) *ChunithmMusicAvailabilityUpdateOne {
+	if v != nil {
+		_u.SetIsDeleted(*v)
+	}
+	return _u
+}
+
+// SetDeletedVersion sets the "deleted_version" field.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetDeletedVersion(v string) *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.SetDeletedVersion(v)
+	return _u
+}
+
+// SetNillableDeletedVersion sets the "deleted_version" field if the given value is not nil.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SetNillableDeletedVersion(v *string) *ChunithmMusicAvailabilityUpdateOne {
+	if v != nil {
+		_u.SetDeletedVersion(*v)
+	}
+	return _u
+}
+
+// ClearDeletedVersion clears the value of the "deleted_version" field.
+func (_u *ChunithmMusicAvailabilityUpdateOne) ClearDeletedVersion() *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.ClearDeletedVersion()
+	return _u
+}
+
+// Mutation returns the ChunithmMusicAvailabilityMutation object of the builder.
+func (_u *ChunithmMusicAvailabilityUpdateOne) Mutation() *ChunithmMusicAvailabilityMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChunithmMusicAvailabilityUpdate builder.
+func (_u *ChunithmMusicAvailabilityUpdateOne) Where(ps ...predicate.ChunithmMusicAvailability) *ChunithmMusicAvailabilityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChunithmMusicAvailabilityUpdateOne) Select(field string, fields ...string) *ChunithmMusicAvailabilityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChunithmMusicAvailability entity.
+func (_u *ChunithmMusicAvailabilityUpdateOne) Save(ctx context.Context) (*ChunithmMusicAvailability, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmMusicAvailabilityUpdateOne) SaveX(ctx context.Context) *ChunithmMusicAvailability {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChunithmMusicAvailabilityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmMusicAvailabilityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmMusicAvailabilityUpdateOne) check() error {
+	if v, ok := _u.mutation.Server(); ok {
+		if err := chunithmmusicavailability.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`music: validator failed for field "ChunithmMusicAvailability.server": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReleaseVersion(); ok {
+		if err := chunithmmusicavailability.ReleaseVersionValidator(v); err != nil {
+			return &ValidationError{Name: "release_version", err: fmt.Errorf(`music: validator failed for field "ChunithmMusicAvailability.release_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedVersion(); ok {
+		if err := chunithmmusicavailability.DeletedVersionValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_version", err: fmt.Errorf(`music: validator failed for field "ChunithmMusicAvailability.deleted_version": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmMusicAvailabilityUpdateOne) sqlSave(ctx context.Context) (_node *ChunithmMusicAvailability, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmmusicavailability.Table, chunithmmusicavailability.Columns, sqlgraph.NewFieldSpec(chunithmmusicavailability.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`music: missing "ChunithmMusicAvailability.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chunithmmusicavailability.FieldID)
+		for _, f := range fields {
+			if !chunithmmusicavailability.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("music: invalid field %q for query", f)}
+			}
+			if f != chunithmmusicavailability.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MusicID(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMusicID(); ok {
+		_spec.AddField(chunithmmusicavailability.FieldMusicID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Server(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldServer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReleaseVersion(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldReleaseVersion, field.TypeString, value)
+	}
+	if _u.mutation.ReleaseVersionCleared() {
+		_spec.ClearField(chunithmmusicavailability.FieldReleaseVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReleaseDate(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldReleaseDate, field.TypeTime, value)
+	}
+	if _u.mutation.ReleaseDateCleared() {
+		_spec.ClearField(chunithmmusicavailability.FieldReleaseDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.IsDeleted(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldIsDeleted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeletedVersion(); ok {
+		_spec.SetField(chunithmmusicavailability.FieldDeletedVersion, field.TypeString, value)
+	}
+	if _u.mutation.DeletedVersionCleared() {
+		_spec.ClearField(chunithmmusicavailability.FieldDeletedVersion, field.TypeString)
+	}
+	_node = &ChunithmMusicAvailability{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmmusicavailability.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
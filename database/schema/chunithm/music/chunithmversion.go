@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package music
+
+import (
+	"fmt"
+	"haruki-database/database/schema/chunithm/music/chunithmversion"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ChunithmVersion is the model entity for the ChunithmVersion schema.
+type ChunithmVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version string `json:"version,omitempty"`
+	// Name holds the value of the "name" field.
+	Name *string `json:"name,omitempty"`
+	// Position of the version in release history, ascending
+	ReleaseOrder int `json:"release_order,omitempty"`
+	// ReleaseDate holds the value of the "release_date" field.
+	ReleaseDate  *time.Time `json:"release_date,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChunithmVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chunithmversion.FieldID, chunithmversion.FieldReleaseOrder:
+			values[i] = new(sql.NullInt64)
+		case chunithmversion.FieldVersion, chunithmversion.FieldName:
+			values[i] = new(sql.NullString)
+		case chunithmversion.FieldReleaseDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChunithmVersion fields.
+func (_m *ChunithmVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chunithmversion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case chunithmversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = value.String
+			}
+		case chunithmversion.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = new(string)
+				*_m.Name = value.String
+			}
+		case chunithmversion.FieldReleaseOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field release_order", values[i])
+			} else if value.Valid {
+				_m.ReleaseOrder = int(value.Int64)
+			}
+		case chunithmversion.FieldReleaseDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field release_date", values[i])
+			} else if value.Valid {
+				_m.ReleaseDate = new(time.Time)
+				*_m.ReleaseDate = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChunithmVersion.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChunithmVersion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ChunithmVersion.
+// Note that you need to call ChunithmVersion.Unwrap() before calling this method if this ChunithmVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChunithmVersion) Update() *ChunithmVersionUpdateOne {
+	return NewChunithmVersionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChunithmVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChunithmVersion) Unwrap() *ChunithmVersion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("music: ChunithmVersion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChunithmVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChunithmVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("version=")
+	builder.WriteString(_m.Version)
+	builder.WriteString(", ")
+	if v := _m.Name; v != nil {
+		builder.WriteString("name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("release_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReleaseOrder))
+	builder.WriteString(", ")
+	if v := _m.ReleaseDate; v != nil {
+		builder.WriteString("release_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChunithmVersions is a parsable slice of ChunithmVersion.
+type ChunithmVersions []*ChunithmVersion
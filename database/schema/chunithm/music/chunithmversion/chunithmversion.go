@@ -0,0 +1,78 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chunithmversion
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the chunithmversion type in the database.
+	Label = "chunithm_version"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldReleaseOrder holds the string denoting the release_order field in the database.
+	FieldReleaseOrder = "release_order"
+	// FieldReleaseDate holds the string denoting the release_date field in the database.
+	FieldReleaseDate = "release_date"
+	// Table holds the table name of the chunithmversion in the database.
+	Table = "versions"
+)
+
+// Columns holds all SQL columns for chunithmversion fields.
+var Columns = []string{
+	FieldID,
+	FieldVersion,
+	FieldName,
+	FieldReleaseOrder,
+	FieldReleaseDate,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ChunithmVersion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByReleaseOrder orders the results by the release_order field.
+func ByReleaseOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseOrder, opts...).ToFunc()
+}
+
+// ByReleaseDate orders the results by the release_date field.
+func ByReleaseDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseDate, opts...).ToFunc()
+}
@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chunithmversion
+
+import (
+	"haruki-database/database/schema/chunithm/music/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldLTE(FieldID, id))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEQ(FieldName, v))
+}
+
+// ReleaseOrder applies equality check predicate on the "release_order" field. It's identical to ReleaseOrderEQ.
+func ReleaseOrder(v int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEQ(FieldReleaseOrder, v))
+}
+
+// ReleaseDate applies equality check predicate on the "release_date" field. It's identical to ReleaseDateEQ.
+func ReleaseDate(v time.Time) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEQ(FieldReleaseDate, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldContainsFold(FieldName, v))
+}
+
+// ReleaseOrderEQ applies the EQ predicate on the "release_order" field.
+func ReleaseOrderEQ(v int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEQ(FieldReleaseOrder, v))
+}
+
+// ReleaseOrderNEQ applies the NEQ predicate on the "release_order" field.
+func ReleaseOrderNEQ(v int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNEQ(FieldReleaseOrder, v))
+}
+
+// ReleaseOrderIn applies the In predicate on the "release_order" field.
+func ReleaseOrderIn(vs ...int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldIn(FieldReleaseOrder, vs...))
+}
+
+// ReleaseOrderNotIn applies the NotIn predicate on the "release_order" field.
+func ReleaseOrderNotIn(vs ...int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNotIn(FieldReleaseOrder, vs...))
+}
+
+// ReleaseOrderGT applies the GT predicate on the "release_order" field.
+func ReleaseOrderGT(v int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldGT(FieldReleaseOrder, v))
+}
+
+// ReleaseOrderGTE applies the GTE predicate on the "release_order" field.
+func ReleaseOrderGTE(v int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldGTE(FieldReleaseOrder, v))
+}
+
+// ReleaseOrderLT applies the LT predicate on the "release_order" field.
+func ReleaseOrderLT(v int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldLT(FieldReleaseOrder, v))
+}
+
+// ReleaseOrderLTE applies the LTE predicate on the "release_order" field.
+func ReleaseOrderLTE(v int) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldLTE(FieldReleaseOrder, v))
+}
+
+// ReleaseDateEQ applies the EQ predicate on the "release_date" field.
+func ReleaseDateEQ(v time.Time) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldEQ(FieldReleaseDate, v))
+}
+
+// ReleaseDateNEQ applies the NEQ predicate on the "release_date" field.
+func ReleaseDateNEQ(v time.Time) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNEQ(FieldReleaseDate, v))
+}
+
+// ReleaseDateIn applies the In predicate on the "release_date" field.
+func ReleaseDateIn(vs ...time.Time) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldIn(FieldReleaseDate, vs...))
+}
+
+// ReleaseDateNotIn applies the NotIn predicate on the "release_date" field.
+func ReleaseDateNotIn(vs ...time.Time) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNotIn(FieldReleaseDate, vs...))
+}
+
+// ReleaseDateGT applies the GT predicate on the "release_date" field.
+func ReleaseDateGT(v time.Time) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldGT(FieldReleaseDate, v))
+}
+
+// ReleaseDateGTE applies the GTE predicate on the "release_date" field.
+func ReleaseDateGTE(v time.Time) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldGTE(FieldReleaseDate, v))
+}
+
+// ReleaseDateLT applies the LT predicate on the "release_date" field.
+func ReleaseDateLT(v time.Time) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldLT(FieldReleaseDate, v))
+}
+
+// ReleaseDateLTE applies the LTE predicate on the "release_date" field.
+func ReleaseDateLTE(v time.Time) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldLTE(FieldReleaseDate, v))
+}
+
+// ReleaseDateIsNil applies the IsNil predicate on the "release_date" field.
+func ReleaseDateIsNil() predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldIsNull(FieldReleaseDate))
+}
+
+// ReleaseDateNotNil applies the NotNil predicate on the "release_date" field.
+func ReleaseDateNotNil() predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.FieldNotNull(FieldReleaseDate))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChunithmVersion) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChunithmVersion) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChunithmVersion) predicate.ChunithmVersion {
+	return predicate.ChunithmVersion(sql.NotPredicates(p))
+}
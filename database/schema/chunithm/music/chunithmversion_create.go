@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package music
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/music/chunithmversion"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmVersionCreate is the builder for creating a ChunithmVersion entity.
+type ChunithmVersionCreate struct {
+	config
+	mutation *ChunithmVersionMutation
+	hooks    []Hook
+}
+
+// SetVersion sets the "version" field.
+func (_c *ChunithmVersionCreate) SetVersion(v string) *ChunithmVersionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ChunithmVersionCreate) SetName(v string) *ChunithmVersionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *ChunithmVersionCreate) SetNillableName(v *string) *ChunithmVersionCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetReleaseOrder sets the "release_order" field.
+func (_c *ChunithmVersionCreate) SetReleaseOrder(v int) *ChunithmVersionCreate {
+	_c.mutation.SetReleaseOrder(v)
+	return _c
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (_c *ChunithmVersionCreate) SetReleaseDate(v time.Time) *ChunithmVersionCreate {
+	_c.mutation.SetReleaseDate(v)
+	return _c
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (_c *ChunithmVersionCreate) SetNillableReleaseDate(v *time.Time) *ChunithmVersionCreate {
+	if v != nil {
+		_c.SetReleaseDate(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ChunithmVersionMutation object of the builder.
+func (_c *ChunithmVersionCreate) Mutation() *ChunithmVersionMutation {
+	return _c.mutation
+}
+
+// Save creates the ChunithmVersion in the database.
+func (_c *ChunithmVersionCreate) Save(ctx context.Context) (*ChunithmVersion, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChunithmVersionCreate) SaveX(ctx context.Context) *ChunithmVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmVersionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmVersionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChunithmVersionCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`music: missing required field "ChunithmVersion.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := chunithmversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`music: validator failed for field "ChunithmVersion.version": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := chunithmversion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`music: validator failed for field "ChunithmVersion.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReleaseOrder(); !ok {
+		return &ValidationError{Name: "release_order", err: errors.New(`music: missing required field "ChunithmVersion.release_order"`)}
+	}
+	return nil
+}
+
+func (_c *ChunithmVersionCreate) sqlSave(ctx context.Context) (*ChunithmVersion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChunithmVersionCreate) createSpec() (*ChunithmVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChunithmVersion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chunithmversion.Table, sqlgraph.NewFieldSpec(chunithmversion.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(chunithmversion.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(chunithmversion.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := _c.mutation.ReleaseOrder(); ok {
+		_spec.SetField(chunithmversion.FieldReleaseOrder, field.TypeInt, value)
+		_node.ReleaseOrder = value
+	}
+	if value, ok := _c.mutation.ReleaseDate(); ok {
+		_spec.SetField(chunithmversion.FieldReleaseDate, field.TypeTime, value)
+		_node.ReleaseDate = &value
+	}
+	return _node, _spec
+}
+
+// ChunithmVersionCreateBulk is the builder for creating many ChunithmVersion entities in bulk.
+type ChunithmVersionCreateBulk struct {
+	config
+	err      error
+	builders []*ChunithmVersionCreate
+}
+
+// Save creates the ChunithmVersion entities in the database.
+func (_c *ChunithmVersionCreateBulk) Save(ctx context.Context) ([]*ChunithmVersion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChunithmVersion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChunithmVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChunithmVersionCreateBulk) SaveX(ctx context.Context) []*ChunithmVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChunithmVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChunithmVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
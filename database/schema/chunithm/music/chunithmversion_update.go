@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package music
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/chunithm/music/chunithmversion"
+	"haruki-database/database/schema/chunithm/music/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChunithmVersionUpdate is the builder for updating ChunithmVersion entities.
+type ChunithmVersionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChunithmVersionMutation
+}
+
+// Where appends a list predicates to the ChunithmVersionUpdate builder.
+func (_u *ChunithmVersionUpdate) Where(ps ...predicate.ChunithmVersion) *ChunithmVersionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *ChunithmVersionUpdate) SetVersion(v string) *ChunithmVersionUpdate {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ChunithmVersionUpdate) SetNillableVersion(v *string) *ChunithmVersionUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ChunithmVersionUpdate) SetName(v string) *ChunithmVersionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ChunithmVersionUpdate) SetNillableName(v *string) *ChunithmVersionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *ChunithmVersionUpdate) ClearName() *ChunithmVersionUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetReleaseOrder sets the "release_order" field.
+func (_u *ChunithmVersionUpdate) SetReleaseOrder(v int) *ChunithmVersionUpdate {
+	_u.mutation.ResetReleaseOrder()
+	_u.mutation.SetReleaseOrder(v)
+	return _u
+}
+
+// SetNillableReleaseOrder sets the "release_order" field if the given value is not nil.
+func (_u *ChunithmVersionUpdate) SetNillableReleaseOrder(v *int) *ChunithmVersionUpdate {
+	if v != nil {
+		_u.SetReleaseOrder(*v)
+	}
+	return _u
+}
+
+// AddReleaseOrder adds value to the "release_order" field.
+func (_u *ChunithmVersionUpdate) AddReleaseOrder(v int) *ChunithmVersionUpdate {
+	_u.mutation.AddReleaseOrder(v)
+	return _u
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (_u *ChunithmVersionUpdate) SetReleaseDate(v time.Time) *ChunithmVersionUpdate {
+	_u.mutation.SetReleaseDate(v)
+	return _u
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (_u *ChunithmVersionUpdate) SetNillableReleaseDate(v *time.Time) *ChunithmVersionUpdate {
+	if v != nil {
+		_u.SetReleaseDate(*v)
+	}
+	return _u
+}
+
+// ClearReleaseDate clears the value of the "release_date" field.
+func (_u *ChunithmVersionUpdate) ClearReleaseDate() *ChunithmVersionUpdate {
+	_u.mutation.ClearReleaseDate()
+	return _u
+}
+
+// Mutation returns the ChunithmVersionMutation object of the builder.
+func (_u *ChunithmVersionUpdate) Mutation() *ChunithmVersionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChunithmVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChunithmVersionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmVersionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmVersionUpdate) check() error {
+	if v, ok := _u.mutation.Version(); ok {
+		if err := chunithmversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`music: validator failed for field "ChunithmVersion.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := chunithmversion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`music: validator failed for field "ChunithmVersion.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmversion.Table, chunithmversion.Columns, sqlgraph.NewFieldSpec(chunithmversion.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(chunithmversion.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(chunithmversion.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(chunithmversion.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReleaseOrder(); ok {
+		_spec.SetField(chunithmversion.FieldReleaseOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReleaseOrder(); ok {
+		_spec.AddField(chunithmversion.FieldReleaseOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReleaseDate(); ok {
+		_spec.SetField(chunithmversion.FieldReleaseDate, field.TypeTime, value)
+	}
+	if _u.mutation.ReleaseDateCleared() {
+		_spec.ClearField(chunithmversion.FieldReleaseDate, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChunithmVersionUpdateOne is the builder for updating a single ChunithmVersion entity.
+type ChunithmVersionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChunithmVersionMutation
+}
+
+// SetVersion sets the "version" field.
+func (_u *ChunithmVersionUpdateOne) SetVersion(v string) *ChunithmVersionUpdateOne {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ChunithmVersionUpdateOne) SetNillableVersion(v *string) *ChunithmVersionUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ChunithmVersionUpdateOne) SetName(v string) *ChunithmVersionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ChunithmVersionUpdateOne) SetNillableName(v *string) *ChunithmVersionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *ChunithmVersionUpdateOne) ClearName() *ChunithmVersionUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetReleaseOrder sets the "release_order" field.
+func (_u *ChunithmVersionUpdateOne) SetReleaseOrder(v int) *ChunithmVersionUpdateOne {
+	_u.mutation.ResetReleaseOrder()
+	_u.mutation.SetReleaseOrder(v)
+	return _u
+}
+
+// SetNillableReleaseOrder sets the "release_order" field if the given value is not nil.
+func (_u *ChunithmVersionUpdateOne) SetNillableReleaseOrder(v *int) *ChunithmVersionUpdateOne {
+	if v != nil {
+		_u.SetReleaseOrder(*v)
+	}
+	return _u
+}
+
+// AddReleaseOrder adds value to the "release_order" field.
+func (_u *ChunithmVersionUpdateOne) AddReleaseOrder(v int) *ChunithmVersionUpdateOne {
+	_u.mutation.AddReleaseOrder(v)
+	return _u
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (_u *ChunithmVersionUpdateOne) SetReleaseDate(v time.Time) *ChunithmVersionUpdateOne {
+	_u.mutation.SetReleaseDate(v)
+	return _u
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (_u *ChunithmVersionUpdateOne) SetNillableReleaseDate(v *time.Time) *ChunithmVersionUpdateOne {
+	if v != nil {
+		_u.SetReleaseDate(*v)
+	}
+	return _u
+}
+
+// ClearReleaseDate clears the value of the "release_date" field.
+func (_u *ChunithmVersionUpdateOne) ClearReleaseDate() *ChunithmVersionUpdateOne {
+	_u.mutation.ClearReleaseDate()
+	return _u
+}
+
+// Mutation returns the ChunithmVersionMutation object of the builder.
+func (_u *ChunithmVersionUpdateOne) Mutation() *ChunithmVersionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChunithmVersionUpdate builder.
+func (_u *ChunithmVersionUpdateOne) Where(ps ...predicate.ChunithmVersion) *ChunithmVersionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChunithmVersionUpdateOne) Select(field string, fields ...string) *ChunithmVersionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChunithmVersion entity.
+func (_u *ChunithmVersionUpdateOne) Save(ctx context.Context) (*ChunithmVersion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChunithmVersionUpdateOne) SaveX(ctx context.Context) *ChunithmVersion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChunithmVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChunithmVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChunithmVersionUpdateOne) check() error {
+	if v, ok := _u.mutation.Version(); ok {
+		if err := chunithmversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`music: validator failed for field "ChunithmVersion.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := chunithmversion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`music: validator failed for field "ChunithmVersion.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChunithmVersionUpdateOne) sqlSave(ctx context.Context) (_node *ChunithmVersion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chunithmversion.Table, chunithmversion.Columns, sqlgraph.NewFieldSpec(chunithmversion.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`music: missing "ChunithmVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chunithmversion.FieldID)
+		for _, f := range fields {
+			if !chunithmversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("music: invalid field %q for query", f)}
+			}
+			if f != chunithmversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(chunithmversion.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(chunithmversion.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(chunithmversion.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReleaseOrder(); ok {
+		_spec.SetField(chunithmversion.FieldReleaseOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReleaseOrder(); ok {
+		_spec.AddField(chunithmversion.FieldReleaseOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReleaseDate(); ok {
+		_spec.SetField(chunithmversion.FieldReleaseDate, field.TypeTime, value)
+	}
+	if _u.mutation.ReleaseDateCleared() {
+		_spec.ClearField(chunithmversion.FieldReleaseDate, field.TypeTime)
+	}
+	_node = &ChunithmVersion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chunithmversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,7 +13,9 @@ import (
 
 	"haruki-database/database/schema/chunithm/music/chunithmchartdata"
 	"haruki-database/database/schema/chunithm/music/chunithmmusic"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicavailability"
 	"haruki-database/database/schema/chunithm/music/chunithmmusicdifficulty"
+	"haruki-database/database/schema/chunithm/music/chunithmversion"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -29,8 +31,12 @@ type Client struct {
 	ChunithmChartData *ChunithmChartDataClient
 	// ChunithmMusic is the client for interacting with the ChunithmMusic builders.
 	ChunithmMusic *ChunithmMusicClient
+	// ChunithmMusicAvailability is the client for interacting with the ChunithmMusicAvailability builders.
+	ChunithmMusicAvailability *ChunithmMusicAvailabilityClient
 	// ChunithmMusicDifficulty is the client for interacting with the ChunithmMusicDifficulty builders.
 	ChunithmMusicDifficulty *ChunithmMusicDifficultyClient
+	// ChunithmVersion is the client for interacting with the ChunithmVersion builders.
+	ChunithmVersion *ChunithmVersionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -44,7 +50,9 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.ChunithmChartData = NewChunithmChartDataClient(c.config)
 	c.ChunithmMusic = NewChunithmMusicClient(c.config)
+	c.ChunithmMusicAvailability = NewChunithmMusicAvailabilityClient(c.config)
 	c.ChunithmMusicDifficulty = NewChunithmMusicDifficultyClient(c.config)
+	c.ChunithmVersion = NewChunithmVersionClient(c.config)
 }
 
 type (
@@ -135,11 +143,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                     ctx,
-		config:                  cfg,
-		ChunithmChartData:       NewChunithmChartDataClient(cfg),
-		ChunithmMusic:           NewChunithmMusicClient(cfg),
-		ChunithmMusicDifficulty: NewChunithmMusicDifficultyClient(cfg),
+		ctx:                       ctx,
+		config:                    cfg,
+		ChunithmChartData:         NewChunithmChartDataClient(cfg),
+		ChunithmMusic:             NewChunithmMusicClient(cfg),
+		ChunithmMusicAvailability: NewChunithmMusicAvailabilityClient(cfg),
+		ChunithmMusicDifficulty:   NewChunithmMusicDifficultyClient(cfg),
+		ChunithmVersion:           NewChunithmVersionClient(cfg),
 	}, nil
 }
 
@@ -157,11 +167,13 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                     ctx,
-		config:                  cfg,
-		ChunithmChartData:       NewChunithmChartDataClient(cfg),
-		ChunithmMusic:           NewChunithmMusicClient(cfg),
-		ChunithmMusicDifficulty: NewChunithmMusicDifficultyClient(cfg),
+		ctx:                       ctx,
+		config:                    cfg,
+		ChunithmChartData:         NewChunithmChartDataClient(cfg),
+		ChunithmMusic:             NewChunithmMusicClient(cfg),
+		ChunithmMusicAvailability: NewChunithmMusicAvailabilityClient(cfg),
+		ChunithmMusicDifficulty:   NewChunithmMusicDifficultyClient(cfg),
+		ChunithmVersion:           NewChunithmVersionClient(cfg),
 	}, nil
 }
 
@@ -192,7 +204,9 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	c.ChunithmChartData.Use(hooks...)
 	c.ChunithmMusic.Use(hooks...)
+	c.ChunithmMusicAvailability.Use(hooks...)
 	c.ChunithmMusicDifficulty.Use(hooks...)
+	c.ChunithmVersion.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
@@ -200,7 +214,9 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.ChunithmChartData.Intercept(interceptors...)
 	c.ChunithmMusic.Intercept(interceptors...)
+	c.ChunithmMusicAvailability.Intercept(interceptors...)
 	c.ChunithmMusicDifficulty.Intercept(interceptors...)
+	c.ChunithmVersion.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -210,8 +226,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ChunithmChartData.mutate(ctx, m)
 	case *ChunithmMusicMutation:
 		return c.ChunithmMusic.mutate(ctx, m)
+	case *ChunithmMusicAvailabilityMutation:
+		return c.ChunithmMusicAvailability.mutate(ctx, m)
 	case *ChunithmMusicDifficultyMutation:
 		return c.ChunithmMusicDifficulty.mutate(ctx, m)
+	case *ChunithmVersionMutation:
+		return c.ChunithmVersion.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("music: unknown mutation type %T", m)
 	}
@@ -483,6 +503,139 @@ func (c *ChunithmMusicClient) mutate(ctx context.Context, m *ChunithmMusicMutati
 	}
 }
 
+// ChunithmMusicAvailabilityClient is a client for the ChunithmMusicAvailability schema.
+type ChunithmMusicAvailabilityClient struct {
+	config
+}
+
+// NewChunithmMusicAvailabilityClient returns a client for the ChunithmMusicAvailability from the given config.
+func NewChunithmMusicAvailabilityClient(c config) *ChunithmMusicAvailabilityClient {
+	return &ChunithmMusicAvailabilityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chunithmmusicavailability.Hooks(f(g(h())))`.
+func (c *ChunithmMusicAvailabilityClient) Use(hooks ...Hook) {
+	c.hooks.ChunithmMusicAvailability = append(c.hooks.ChunithmMusicAvailability, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chunithmmusicavailability.Intercept(f(g(h())))`.
+func (c *ChunithmMusicAvailabilityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChunithmMusicAvailability = append(c.inters.ChunithmMusicAvailability, interceptors...)
+}
+
+// Create returns a builder for creating a ChunithmMusicAvailability entity.
+func (c *ChunithmMusicAvailabilityClient) Create() *ChunithmMusicAvailabilityCreate {
+	mutation := newChunithmMusicAvailabilityMutation(c.config, OpCreate)
+	return &ChunithmMusicAvailabilityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChunithmMusicAvailability entities.
+func (c *ChunithmMusicAvailabilityClient) CreateBulk(builders ...*ChunithmMusicAvailabilityCreate) *ChunithmMusicAvailabilityCreateBulk {
+	return &ChunithmMusicAvailabilityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChunithmMusicAvailabilityClient) MapCreateBulk(slice any, setFunc func(*ChunithmMusicAvailabilityCreate, int)) *ChunithmMusicAvailabilityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChunithmMusicAvailabilityCreateBulk{err: fmt.Errorf("calling to ChunithmMusicAvailabilityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChunithmMusicAvailabilityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChunithmMusicAvailabilityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChunithmMusicAvailability.
+func (c *ChunithmMusicAvailabilityClient) Update() *ChunithmMusicAvailabilityUpdate {
+	mutation := newChunithmMusicAvailabilityMutation(c.config, OpUpdate)
+	return &ChunithmMusicAvailabilityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChunithmMusicAvailabilityClient) UpdateOne(_m *ChunithmMusicAvailability) *ChunithmMusicAvailabilityUpdateOne {
+	mutation := newChunithmMusicAvailabilityMutation(c.config, OpUpdateOne, withChunithmMusicAvailability(_m))
+	return &ChunithmMusicAvailabilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChunithmMusicAvailabilityClient) UpdateOneID(id int) *ChunithmMusicAvailabilityUpdateOne {
+	mutation := newChunithmMusicAvailabilityMutation(c.config, OpUpdateOne, withChunithmMusicAvailabilityID(id))
+	return &ChunithmMusicAvailabilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChunithmMusicAvailability.
+func (c *ChunithmMusicAvailabilityClient) Delete() *ChunithmMusicAvailabilityDelete {
+	mutation := newChunithmMusicAvailabilityMutation(c.config, OpDelete)
+	return &ChunithmMusicAvailabilityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChunithmMusicAvailabilityClient) DeleteOne(_m *ChunithmMusicAvailability) *ChunithmMusicAvailabilityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChunithmMusicAvailabilityClient) DeleteOneID(id int) *ChunithmMusicAvailabilityDeleteOne {
+	builder := c.Delete().Where(chunithmmusicavailability.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChunithmMusicAvailabilityDeleteOne{builder}
+}
+
+// Query returns a query builder for ChunithmMusicAvailability.
+func (c *ChunithmMusicAvailabilityClient) Query() *ChunithmMusicAvailabilityQuery {
+	return &ChunithmMusicAvailabilityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChunithmMusicAvailability},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChunithmMusicAvailability entity by its id.
+func (c *ChunithmMusicAvailabilityClient) Get(ctx context.Context, id int) (*ChunithmMusicAvailability, error) {
+	return c.Query().Where(chunithmmusicavailability.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChunithmMusicAvailabilityClient) GetX(ctx context.Context, id int) *ChunithmMusicAvailability {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChunithmMusicAvailabilityClient) Hooks() []Hook {
+	return c.hooks.ChunithmMusicAvailability
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChunithmMusicAvailabilityClient) Interceptors() []Interceptor {
+	return c.inters.ChunithmMusicAvailability
+}
+
+func (c *ChunithmMusicAvailabilityClient) mutate(ctx context.Context, m *ChunithmMusicAvailabilityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChunithmMusicAvailabilityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChunithmMusicAvailabilityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChunithmMusicAvailabilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChunithmMusicAvailabilityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("music: unknown ChunithmMusicAvailability mutation op: %q", m.Op())
+	}
+}
+
 // ChunithmMusicDifficultyClient is a client for the ChunithmMusicDifficulty schema.
 type ChunithmMusicDifficultyClient struct {
 	config
@@ -616,12 +769,147 @@ func (c *ChunithmMusicDifficultyClient) mutate(ctx context.Context, m *ChunithmM
 	}
 }
 
+// ChunithmVersionClient is a client for the ChunithmVersion schema.
+type ChunithmVersionClient struct {
+	config
+}
+
+// NewChunithmVersionClient returns a client for the ChunithmVersion from the given config.
+func NewChunithmVersionClient(c config) *ChunithmVersionClient {
+	return &ChunithmVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chunithmversion.Hooks(f(g(h())))`.
+func (c *ChunithmVersionClient) Use(hooks ...Hook) {
+	c.hooks.ChunithmVersion = append(c.hooks.ChunithmVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chunithmversion.Intercept(f(g(h())))`.
+func (c *ChunithmVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChunithmVersion = append(c.inters.ChunithmVersion, interceptors...)
+}
+
+// Create returns a builder for creating a ChunithmVersion entity.
+func (c *ChunithmVersionClient) Create() *ChunithmVersionCreate {
+	mutation := newChunithmVersionMutation(c.config, OpCreate)
+	return &ChunithmVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChunithmVersion entities.
+func (c *ChunithmVersionClient) CreateBulk(builders ...*ChunithmVersionCreate) *ChunithmVersionCreateBulk {
+	return &ChunithmVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChunithmVersionClient) MapCreateBulk(slice any, setFunc func(*ChunithmVersionCreate, int)) *ChunithmVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChunithmVersionCreateBulk{err: fmt.Errorf("calling to ChunithmVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChunithmVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChunithmVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChunithmVersion.
+func (c *ChunithmVersionClient) Update() *ChunithmVersionUpdate {
+	mutation := newChunithmVersionMutation(c.config, OpUpdate)
+	return &ChunithmVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChunithmVersionClient) UpdateOne(_m *ChunithmVersion) *ChunithmVersionUpdateOne {
+	mutation := newChunithmVersionMutation(c.config, OpUpdateOne, withChunithmVersion(_m))
+	return &ChunithmVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChunithmVersionClient) UpdateOneID(id int) *ChunithmVersionUpdateOne {
+	mutation := newChunithmVersionMutation(c.config, OpUpdateOne, withChunithmVersionID(id))
+	return &ChunithmVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChunithmVersion.
+func (c *ChunithmVersionClient) Delete() *ChunithmVersionDelete {
+	mutation := newChunithmVersionMutation(c.config, OpDelete)
+	return &ChunithmVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChunithmVersionClient) DeleteOne(_m *ChunithmVersion) *ChunithmVersionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChunithmVersionClient) DeleteOneID(id int) *ChunithmVersionDeleteOne {
+	builder := c.Delete().Where(chunithmversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChunithmVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for ChunithmVersion.
+func (c *ChunithmVersionClient) Query() *ChunithmVersionQuery {
+	return &ChunithmVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChunithmVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChunithmVersion entity by its id.
+func (c *ChunithmVersionClient) Get(ctx context.Context, id int) (*ChunithmVersion, error) {
+	return c.Query().Where(chunithmversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChunithmVersionClient) GetX(ctx context.Context, id int) *ChunithmVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChunithmVersionClient) Hooks() []Hook {
+	return c.hooks.ChunithmVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChunithmVersionClient) Interceptors() []Interceptor {
+	return c.inters.ChunithmVersion
+}
+
+func (c *ChunithmVersionClient) mutate(ctx context.Context, m *ChunithmVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChunithmVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChunithmVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChunithmVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChunithmVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("music: unknown ChunithmVersion mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ChunithmChartData, ChunithmMusic, ChunithmMusicDifficulty []ent.Hook
+		ChunithmChartData, ChunithmMusic, ChunithmMusicAvailability,
+		ChunithmMusicDifficulty, ChunithmVersion []ent.Hook
 	}
 	inters struct {
-		ChunithmChartData, ChunithmMusic, ChunithmMusicDifficulty []ent.Interceptor
+		ChunithmChartData, ChunithmMusic, ChunithmMusicAvailability,
+		ChunithmMusicDifficulty, ChunithmVersion []ent.Interceptor
 	}
 )
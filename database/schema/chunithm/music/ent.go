@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"haruki-database/database/schema/chunithm/music/chunithmchartdata"
 	"haruki-database/database/schema/chunithm/music/chunithmmusic"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicavailability"
 	"haruki-database/database/schema/chunithm/music/chunithmmusicdifficulty"
+	"haruki-database/database/schema/chunithm/music/chunithmversion"
 	"reflect"
 	"sync"
 
@@ -75,9 +77,11 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chunithmchartdata.Table:       chunithmchartdata.ValidColumn,
-			chunithmmusic.Table:           chunithmmusic.ValidColumn,
-			chunithmmusicdifficulty.Table: chunithmmusicdifficulty.ValidColumn,
+			chunithmchartdata.Table:         chunithmchartdata.ValidColumn,
+			chunithmmusic.Table:             chunithmmusic.ValidColumn,
+			chunithmmusicavailability.Table: chunithmmusicavailability.ValidColumn,
+			chunithmmusicdifficulty.Table:   chunithmmusicdifficulty.ValidColumn,
+			chunithmversion.Table:           chunithmversion.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
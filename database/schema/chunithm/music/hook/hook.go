@@ -32,6 +32,18 @@ func (f ChunithmMusicFunc) Mutate(ctx context.Context, m music.Mutation) (music.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *music.ChunithmMusicMutation", m)
 }
 
+// The ChunithmMusicAvailabilityFunc type is an adapter to allow the use of ordinary
+// function as ChunithmMusicAvailability mutator.
+type ChunithmMusicAvailabilityFunc func(context.Context, *music.ChunithmMusicAvailabilityMutation) (music.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChunithmMusicAvailabilityFunc) Mutate(ctx context.Context, m music.Mutation) (music.Value, error) {
+	if mv, ok := m.(*music.ChunithmMusicAvailabilityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *music.ChunithmMusicAvailabilityMutation", m)
+}
+
 // The ChunithmMusicDifficultyFunc type is an adapter to allow the use of ordinary
 // function as ChunithmMusicDifficulty mutator.
 type ChunithmMusicDifficultyFunc func(context.Context, *music.ChunithmMusicDifficultyMutation) (music.Value, error)
@@ -44,6 +56,18 @@ func (f ChunithmMusicDifficultyFunc) Mutate(ctx context.Context, m music.Mutatio
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *music.ChunithmMusicDifficultyMutation", m)
 }
 
+// The ChunithmVersionFunc type is an adapter to allow the use of ordinary
+// function as ChunithmVersion mutator.
+type ChunithmVersionFunc func(context.Context, *music.ChunithmVersionMutation) (music.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChunithmVersionFunc) Mutate(ctx context.Context, m music.Mutation) (music.Value, error) {
+	if mv, ok := m.(*music.ChunithmVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *music.ChunithmVersionMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, music.Mutation) bool
 
@@ -22,12 +22,20 @@ var (
 		{Name: "air_count", Type: field.TypeInt, Nullable: true},
 		{Name: "flick_count", Type: field.TypeInt, Nullable: true},
 		{Name: "total_count", Type: field.TypeInt, Nullable: true},
+		{Name: "duration", Type: field.TypeFloat64, Nullable: true},
 	}
 	// ChartDataTable holds the schema information for the "chart_data" table.
 	ChartDataTable = &schema.Table{
 		Name:       "chart_data",
 		Columns:    ChartDataColumns,
 		PrimaryKey: []*schema.Column{ChartDataColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chunithmchartdata_music_id_difficulty",
+				Unique:  true,
+				Columns: []*schema.Column{ChartDataColumns[1], ChartDataColumns[2]},
+			},
+		},
 	}
 	// MusicColumns holds the columns for the "music" table.
 	MusicColumns = []*schema.Column{
@@ -47,6 +55,34 @@ var (
 		Columns:    MusicColumns,
 		PrimaryKey: []*schema.Column{MusicColumns[0]},
 	}
+	// MusicAvailabilityColumns holds the columns for the "music_availability" table.
+	MusicAvailabilityColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "music_id", Type: field.TypeInt},
+		{Name: "server", Type: field.TypeString, Size: 10},
+		{Name: "release_version", Type: field.TypeString, Nullable: true, Size: 10},
+		{Name: "release_date", Type: field.TypeTime, Nullable: true},
+		{Name: "is_deleted", Type: field.TypeBool, Default: false},
+		{Name: "deleted_version", Type: field.TypeString, Nullable: true, Size: 10},
+	}
+	// MusicAvailabilityTable holds the schema information for the "music_availability" table.
+	MusicAvailabilityTable = &schema.Table{
+		Name:       "music_availability",
+		Columns:    MusicAvailabilityColumns,
+		PrimaryKey: []*schema.Column{MusicAvailabilityColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chunithmmusicavailability_music_id_server",
+				Unique:  true,
+				Columns: []*schema.Column{MusicAvailabilityColumns[1], MusicAvailabilityColumns[2]},
+			},
+			{
+				Name:    "chunithmmusicavailability_server",
+				Unique:  false,
+				Columns: []*schema.Column{MusicAvailabilityColumns[2]},
+			},
+		},
+	}
 	// MusicDifficultiesColumns holds the columns for the "music_difficulties" table.
 	MusicDifficultiesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -63,12 +99,35 @@ var (
 		Name:       "music_difficulties",
 		Columns:    MusicDifficultiesColumns,
 		PrimaryKey: []*schema.Column{MusicDifficultiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chunithmmusicdifficulty_music_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{MusicDifficultiesColumns[1], MusicDifficultiesColumns[2]},
+			},
+		},
+	}
+	// VersionsColumns holds the columns for the "versions" table.
+	VersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version", Type: field.TypeString, Unique: true, Size: 10},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "release_order", Type: field.TypeInt, Unique: true},
+		{Name: "release_date", Type: field.TypeTime, Nullable: true},
+	}
+	// VersionsTable holds the schema information for the "versions" table.
+	VersionsTable = &schema.Table{
+		Name:       "versions",
+		Columns:    VersionsColumns,
+		PrimaryKey: []*schema.Column{VersionsColumns[0]},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ChartDataTable,
 		MusicTable,
+		MusicAvailabilityTable,
 		MusicDifficultiesTable,
+		VersionsTable,
 	}
 )
 
@@ -79,7 +138,13 @@ func init() {
 	MusicTable.Annotation = &entsql.Annotation{
 		Table: "music",
 	}
+	MusicAvailabilityTable.Annotation = &entsql.Annotation{
+		Table: "music_availability",
+	}
 	MusicDifficultiesTable.Annotation = &entsql.Annotation{
 		Table: "music_difficulties",
 	}
+	VersionsTable.Annotation = &entsql.Annotation{
+		Table: "versions",
+	}
 }
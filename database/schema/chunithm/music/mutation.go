@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"haruki-database/database/schema/chunithm/music/chunithmchartdata"
 	"haruki-database/database/schema/chunithm/music/chunithmmusic"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicavailability"
 	"haruki-database/database/schema/chunithm/music/chunithmmusicdifficulty"
+	"haruki-database/database/schema/chunithm/music/chunithmversion"
 	"haruki-database/database/schema/chunithm/music/predicate"
 	"sync"
 	"time"
@@ -26,9 +28,11 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChunithmChartData       = "ChunithmChartData"
-	TypeChunithmMusic           = "ChunithmMusic"
-	TypeChunithmMusicDifficulty = "ChunithmMusicDifficulty"
+	TypeChunithmChartData         = "ChunithmChartData"
+	TypeChunithmMusic             = "ChunithmMusic"
+	TypeChunithmMusicAvailability = "ChunithmMusicAvailability"
+	TypeChunithmMusicDifficulty   = "ChunithmMusicDifficulty"
+	TypeChunithmVersion           = "ChunithmVersion"
 )
 
 // ChunithmChartDataMutation represents an operation that mutates the ChunithmChartData nodes in the graph.
@@ -56,6 +60,8 @@ type ChunithmChartDataMutation struct {
 	addflick_count *int
 	total_count    *int
 	addtotal_count *int
+	duration       *float64
+	addduration    *float64
 	clearedFields  map[string]struct{}
 	done           bool
 	oldValue       func(context.Context) (*ChunithmChartData, error)
@@ -811,6 +817,76 @@ func (m *ChunithmChartDataMutation) ResetTotalCount() {
 	delete(m.clearedFields, chunithmchartdata.FieldTotalCount)
 }
 
+// SetDuration sets the "duration" field.
+func (m *ChunithmChartDataMutation) SetDuration(f float64) {
+	m.duration = &f
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *ChunithmChartDataMutation) Duration() (r float64, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuration returns the old "duration" field's value of the ChunithmChartData entity.
+// If the ChunithmChartData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmChartDataMutation) OldDuration(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds f to the "duration" field.
+func (m *ChunithmChartDataMutation) AddDuration(f float64) {
+	if m.addduration != nil {
+		*m.addduration += f
+	} else {
+		m.addduration = &f
+	}
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *ChunithmChartDataMutation) AddedDuration() (r float64, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (m *ChunithmChartDataMutation) ClearDuration() {
+	m.duration = nil
+	m.addduration = nil
+	m.clearedFields[chunithmchartdata.FieldDuration] = struct{}{}
+}
+
+// DurationCleared returns if the "duration" field was cleared in this mutation.
+func (m *ChunithmChartDataMutation) DurationCleared() bool {
+	_, ok := m.clearedFields[chunithmchartdata.FieldDuration]
+	return ok
+}
+
+// ResetDuration resets all changes to the "duration" field.
+func (m *ChunithmChartDataMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+	delete(m.clearedFields, chunithmchartdata.FieldDuration)
+}
+
 // Where appends a list predicates to the ChunithmChartDataMutation builder.
 func (m *ChunithmChartDataMutation) Where(ps ...predicate.ChunithmChartData) {
 	m.predicates = append(m.predicates, ps...)
@@ -845,7 +921,7 @@ func (m *ChunithmChartDataMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ChunithmChartDataMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 11)
 	if m.music_id != nil {
 		fields = append(fields, chunithmchartdata.FieldMusicID)
 	}
@@ -876,6 +952,9 @@ func (m *ChunithmChartDataMutation) Fields() []string {
 	if m.total_count != nil {
 		fields = append(fields, chunithmchartdata.FieldTotalCount)
 	}
+	if m.duration != nil {
+		fields = append(fields, chunithmchartdata.FieldDuration)
+	}
 	return fields
 }
 
@@ -904,6 +983,8 @@ func (m *ChunithmChartDataMutation) Field(name string) (ent.Value, bool) {
 		return m.FlickCount()
 	case chunithmchartdata.FieldTotalCount:
 		return m.TotalCount()
+	case chunithmchartdata.FieldDuration:
+		return m.Duration()
 	}
 	return nil, false
 }
@@ -933,6 +1014,8 @@ func (m *ChunithmChartDataMutation) OldField(ctx context.Context, name string) (
 		return m.OldFlickCount(ctx)
 	case chunithmchartdata.FieldTotalCount:
 		return m.OldTotalCount(ctx)
+	case chunithmchartdata.FieldDuration:
+		return m.OldDuration(ctx)
 	}
 	return nil, fmt.Errorf("unknown ChunithmChartData field %s", name)
 }
@@ -1012,6 +1095,13 @@ func (m *ChunithmChartDataMutation) SetField(name string, value ent.Value) error
 		}
 		m.SetTotalCount(v)
 		return nil
+	case chunithmchartdata.FieldDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
 	}
 	return fmt.Errorf("unknown ChunithmChartData field %s", name)
 }
@@ -1047,6 +1137,9 @@ func (m *ChunithmChartDataMutation) AddedFields() []string {
 	if m.addtotal_count != nil {
 		fields = append(fields, chunithmchartdata.FieldTotalCount)
 	}
+	if m.addduration != nil {
+		fields = append(fields, chunithmchartdata.FieldDuration)
+	}
 	return fields
 }
 
@@ -1073,6 +1166,8 @@ func (m *ChunithmChartDataMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedFlickCount()
 	case chunithmchartdata.FieldTotalCount:
 		return m.AddedTotalCount()
+	case chunithmchartdata.FieldDuration:
+		return m.AddedDuration()
 	}
 	return nil, false
 }
@@ -1145,6 +1240,13 @@ func (m *ChunithmChartDataMutation) AddField(name string, value ent.Value) error
 		}
 		m.AddTotalCount(v)
 		return nil
+	case chunithmchartdata.FieldDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
 	}
 	return fmt.Errorf("unknown ChunithmChartData numeric field %s", name)
 }
@@ -1177,6 +1279,9 @@ func (m *ChunithmChartDataMutation) ClearedFields() []string {
 	if m.FieldCleared(chunithmchartdata.FieldTotalCount) {
 		fields = append(fields, chunithmchartdata.FieldTotalCount)
 	}
+	if m.FieldCleared(chunithmchartdata.FieldDuration) {
+		fields = append(fields, chunithmchartdata.FieldDuration)
+	}
 	return fields
 }
 
@@ -1215,6 +1320,9 @@ func (m *ChunithmChartDataMutation) ClearField(name string) error {
 	case chunithmchartdata.FieldTotalCount:
 		m.ClearTotalCount()
 		return nil
+	case chunithmchartdata.FieldDuration:
+		m.ClearDuration()
+		return nil
 	}
 	return fmt.Errorf("unknown ChunithmChartData nullable field %s", name)
 }
@@ -1253,6 +1361,9 @@ func (m *ChunithmChartDataMutation) ResetField(name string) error {
 	case chunithmchartdata.FieldTotalCount:
 		m.ResetTotalCount()
 		return nil
+	case chunithmchartdata.FieldDuration:
+		m.ResetDuration()
+		return nil
 	}
 	return fmt.Errorf("unknown ChunithmChartData field %s", name)
 }
@@ -2124,42 +2235,36 @@ func (m *ChunithmMusicMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ChunithmMusic edge %s", name)
 }
 
-// ChunithmMusicDifficultyMutation represents an operation that mutates the ChunithmMusicDifficulty nodes in the graph.
-type ChunithmMusicDifficultyMutation struct {
+// ChunithmMusicAvailabilityMutation represents an operation that mutates the ChunithmMusicAvailability nodes in the graph.
+type ChunithmMusicAvailabilityMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	music_id       *int
-	addmusic_id    *int
-	version        *string
-	diff0_const    *float64
-	adddiff0_const *float64
-	diff1_const    *float64
-	adddiff1_const *float64
-	diff2_const    *float64
-	adddiff2_const *float64
-	diff3_const    *float64
-	adddiff3_const *float64
-	diff4_const    *float64
-	adddiff4_const *float64
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*ChunithmMusicDifficulty, error)
-	predicates     []predicate.ChunithmMusicDifficulty
+	op              Op
+	typ             string
+	id              *int
+	music_id        *int
+	addmusic_id     *int
+	server          *string
+	release_version *string
+	release_date    *time.Time
+	is_deleted      *bool
+	deleted_version *string
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*ChunithmMusicAvailability, error)
+	predicates      []predicate.ChunithmMusicAvailability
 }
 
-var _ ent.Mutation = (*ChunithmMusicDifficultyMutation)(nil)
+var _ ent.Mutation = (*ChunithmMusicAvailabilityMutation)(nil)
 
-// chunithmmusicdifficultyOption allows management of the mutation configuration using functional options.
-type chunithmmusicdifficultyOption func(*ChunithmMusicDifficultyMutation)
+// chunithmmusicavailabilityOption allows management of the mutation configuration using functional options.
+type chunithmmusicavailabilityOption func(*ChunithmMusicAvailabilityMutation)
 
-// newChunithmMusicDifficultyMutation creates new mutation for the ChunithmMusicDifficulty entity.
-func newChunithmMusicDifficultyMutation(c config, op Op, opts ...chunithmmusicdifficultyOption) *ChunithmMusicDifficultyMutation {
-	m := &ChunithmMusicDifficultyMutation{
+// newChunithmMusicAvailabilityMutation creates new mutation for the ChunithmMusicAvailability entity.
+func newChunithmMusicAvailabilityMutation(c config, op Op, opts ...chunithmmusicavailabilityOption) *ChunithmMusicAvailabilityMutation {
+	m := &ChunithmMusicAvailabilityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChunithmMusicDifficulty,
+		typ:           TypeChunithmMusicAvailability,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2168,20 +2273,20 @@ func newChunithmMusicDifficultyMutation(c config, op Op, opts ...chunithmmusicdi
 	return m
 }
 
-// withChunithmMusicDifficultyID sets the ID field of the mutation.
-func withChunithmMusicDifficultyID(id int) chunithmmusicdifficultyOption {
-	return func(m *ChunithmMusicDifficultyMutation) {
+// withChunithmMusicAvailabilityID sets the ID field of the mutation.
+func withChunithmMusicAvailabilityID(id int) chunithmmusicavailabilityOption {
+	return func(m *ChunithmMusicAvailabilityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ChunithmMusicDifficulty
+			value *ChunithmMusicAvailability
 		)
-		m.oldValue = func(ctx context.Context) (*ChunithmMusicDifficulty, error) {
+		m.oldValue = func(ctx context.Context) (*ChunithmMusicAvailability, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ChunithmMusicDifficulty.Get(ctx, id)
+					value, err = m.Client().ChunithmMusicAvailability.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2190,10 +2295,10 @@ func withChunithmMusicDifficultyID(id int) chunithmmusicdifficultyOption {
 	}
 }
 
-// withChunithmMusicDifficulty sets the old ChunithmMusicDifficulty of the mutation.
-func withChunithmMusicDifficulty(node *ChunithmMusicDifficulty) chunithmmusicdifficultyOption {
-	return func(m *ChunithmMusicDifficultyMutation) {
-		m.oldValue = func(context.Context) (*ChunithmMusicDifficulty, error) {
+// withChunithmMusicAvailability sets the old ChunithmMusicAvailability of the mutation.
+func withChunithmMusicAvailability(node *ChunithmMusicAvailability) chunithmmusicavailabilityOption {
+	return func(m *ChunithmMusicAvailabilityMutation) {
+		m.oldValue = func(context.Context) (*ChunithmMusicAvailability, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2202,7 +2307,7 @@ func withChunithmMusicDifficulty(node *ChunithmMusicDifficulty) chunithmmusicdif
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChunithmMusicDifficultyMutation) Client() *Client {
+func (m ChunithmMusicAvailabilityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2210,7 +2315,7 @@ func (m ChunithmMusicDifficultyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChunithmMusicDifficultyMutation) Tx() (*Tx, error) {
+func (m ChunithmMusicAvailabilityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("music: mutation is not running in a transaction")
 	}
@@ -2221,7 +2326,7 @@ func (m ChunithmMusicDifficultyMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChunithmMusicDifficultyMutation) ID() (id int, exists bool) {
+func (m *ChunithmMusicAvailabilityMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2232,7 +2337,7 @@ func (m *ChunithmMusicDifficultyMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChunithmMusicDifficultyMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ChunithmMusicAvailabilityMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2241,20 +2346,20 @@ func (m *ChunithmMusicDifficultyMutation) IDs(ctx context.Context) ([]int, error
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ChunithmMusicDifficulty.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChunithmMusicAvailability.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetMusicID sets the "music_id" field.
-func (m *ChunithmMusicDifficultyMutation) SetMusicID(i int) {
+func (m *ChunithmMusicAvailabilityMutation) SetMusicID(i int) {
 	m.music_id = &i
 	m.addmusic_id = nil
 }
 
 // MusicID returns the value of the "music_id" field in the mutation.
-func (m *ChunithmMusicDifficultyMutation) MusicID() (r int, exists bool) {
+func (m *ChunithmMusicAvailabilityMutation) MusicID() (r int, exists bool) {
 	v := m.music_id
 	if v == nil {
 		return
@@ -2262,10 +2367,10 @@ func (m *ChunithmMusicDifficultyMutation) MusicID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldMusicID returns the old "music_id" field's value of the ChunithmMusicDifficulty entity.
-// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// OldMusicID returns the old "music_id" field's value of the ChunithmMusicAvailability entity.
+// If the ChunithmMusicAvailability object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmMusicDifficultyMutation) OldMusicID(ctx context.Context) (v int, err error) {
+func (m *ChunithmMusicAvailabilityMutation) OldMusicID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMusicID is only allowed on UpdateOne operations")
 	}
@@ -2280,7 +2385,7 @@ func (m *ChunithmMusicDifficultyMutation) OldMusicID(ctx context.Context) (v int
 }
 
 // AddMusicID adds i to the "music_id" field.
-func (m *ChunithmMusicDifficultyMutation) AddMusicID(i int) {
+func (m *ChunithmMusicAvailabilityMutation) AddMusicID(i int) {
 	if m.addmusic_id != nil {
 		*m.addmusic_id += i
 	} else {
@@ -2289,7 +2394,7 @@ func (m *ChunithmMusicDifficultyMutation) AddMusicID(i int) {
 }
 
 // AddedMusicID returns the value that was added to the "music_id" field in this mutation.
-func (m *ChunithmMusicDifficultyMutation) AddedMusicID() (r int, exists bool) {
+func (m *ChunithmMusicAvailabilityMutation) AddedMusicID() (r int, exists bool) {
 	v := m.addmusic_id
 	if v == nil {
 		return
@@ -2298,406 +2403,1798 @@ func (m *ChunithmMusicDifficultyMutation) AddedMusicID() (r int, exists bool) {
 }
 
 // ResetMusicID resets all changes to the "music_id" field.
-func (m *ChunithmMusicDifficultyMutation) ResetMusicID() {
+func (m *ChunithmMusicAvailabilityMutation) ResetMusicID() {
 	m.music_id = nil
 	m.addmusic_id = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *ChunithmMusicDifficultyMutation) SetVersion(s string) {
-	m.version = &s
+// SetServer sets the "server" field.
+func (m *ChunithmMusicAvailabilityMutation) SetServer(s string) {
+	m.server = &s
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *ChunithmMusicDifficultyMutation) Version() (r string, exists bool) {
-	v := m.version
+// Server returns the value of the "server" field in the mutation.
+func (m *ChunithmMusicAvailabilityMutation) Server() (r string, exists bool) {
+	v := m.server
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the ChunithmMusicDifficulty entity.
-// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// OldServer returns the old "server" field's value of the ChunithmMusicAvailability entity.
+// If the ChunithmMusicAvailability object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmMusicDifficultyMutation) OldVersion(ctx context.Context) (v string, err error) {
+func (m *ChunithmMusicAvailabilityMutation) OldServer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldServer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldServer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldServer: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.Server, nil
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *ChunithmMusicDifficultyMutation) ResetVersion() {
-	m.version = nil
+// ResetServer resets all changes to the "server" field.
+func (m *ChunithmMusicAvailabilityMutation) ResetServer() {
+	m.server = nil
 }
 
-// SetDiff0Const sets the "diff0_const" field.
-func (m *ChunithmMusicDifficultyMutation) SetDiff0Const(f float64) {
-	m.diff0_const = &f
-	m.adddiff0_const = nil
+// SetReleaseVersion sets the "release_version" field.
+func (m *ChunithmMusicAvailabilityMutation) SetReleaseVersion(s string) {
+	m.release_version = &s
 }
 
-// Diff0Const returns the value of the "diff0_const" field in the mutation.
-func (m *ChunithmMusicDifficultyMutation) Diff0Const() (r float64, exists bool) {
-	v := m.diff0_const
+// ReleaseVersion returns the value of the "release_version" field in the mutation.
+func (m *ChunithmMusicAvailabilityMutation) ReleaseVersion() (r string, exists bool) {
+	v := m.release_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDiff0Const returns the old "diff0_const" field's value of the ChunithmMusicDifficulty entity.
-// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// OldReleaseVersion returns the old "release_version" field's value of the ChunithmMusicAvailability entity.
+// If the ChunithmMusicAvailability object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmMusicDifficultyMutation) OldDiff0Const(ctx context.Context) (v *float64, err error) {
+func (m *ChunithmMusicAvailabilityMutation) OldReleaseVersion(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiff0Const is only allowed on UpdateOne operations")
+		return v, errors.New("OldReleaseVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiff0Const requires an ID field in the mutation")
+		return v, errors.New("OldReleaseVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiff0Const: %w", err)
-	}
-	return oldValue.Diff0Const, nil
-}
-
-// AddDiff0Const adds f to the "diff0_const" field.
-func (m *ChunithmMusicDifficultyMutation) AddDiff0Const(f float64) {
-	if m.adddiff0_const != nil {
-		*m.adddiff0_const += f
-	} else {
-		m.adddiff0_const = &f
-	}
-}
-
-// AddedDiff0Const returns the value that was added to the "diff0_const" field in this mutation.
-func (m *ChunithmMusicDifficultyMutation) AddedDiff0Const() (r float64, exists bool) {
-	v := m.adddiff0_const
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldReleaseVersion: %w", err)
 	}
-	return *v, true
+	return oldValue.ReleaseVersion, nil
 }
 
-// ClearDiff0Const clears the value of the "diff0_const" field.
-func (m *ChunithmMusicDifficultyMutation) ClearDiff0Const() {
-	m.diff0_const = nil
-	m.adddiff0_const = nil
-	m.clearedFields[chunithmmusicdifficulty.FieldDiff0Const] = struct{}{}
+// ClearReleaseVersion clears the value of the "release_version" field.
+func (m *ChunithmMusicAvailabilityMutation) ClearReleaseVersion() {
+	m.release_version = nil
+	m.clearedFields[chunithmmusicavailability.FieldReleaseVersion] = struct{}{}
 }
 
-// Diff0ConstCleared returns if the "diff0_const" field was cleared in this mutation.
-func (m *ChunithmMusicDifficultyMutation) Diff0ConstCleared() bool {
-	_, ok := m.clearedFields[chunithmmusicdifficulty.FieldDiff0Const]
+// ReleaseVersionCleared returns if the "release_version" field was cleared in this mutation.
+func (m *ChunithmMusicAvailabilityMutation) ReleaseVersionCleared() bool {
+	_, ok := m.clearedFields[chunithmmusicavailability.FieldReleaseVersion]
 	return ok
 }
 
-// ResetDiff0Const resets all changes to the "diff0_const" field.
-func (m *ChunithmMusicDifficultyMutation) ResetDiff0Const() {
-	m.diff0_const = nil
-	m.adddiff0_const = nil
-	delete(m.clearedFields, chunithmmusicdifficulty.FieldDiff0Const)
+// ResetReleaseVersion resets all changes to the "release_version" field.
+func (m *ChunithmMusicAvailabilityMutation) ResetReleaseVersion() {
+	m.release_version = nil
+	delete(m.clearedFields, chunithmmusicavailability.FieldReleaseVersion)
 }
 
-// SetDiff1Const sets the "diff1_const" field.
-func (m *ChunithmMusicDifficultyMutation) SetDiff1Const(f float64) {
-	m.diff1_const = &f
-	m.adddiff1_const = nil
+// SetReleaseDate sets the "release_date" field.
+func (m *ChunithmMusicAvailabilityMutation) SetReleaseDate(t time.Time) {
+	m.release_date = &t
 }
 
-// Diff1Const returns the value of the "diff1_const" field in the mutation.
-func (m *ChunithmMusicDifficultyMutation) Diff1Const() (r float64, exists bool) {
-	v := m.diff1_const
+// ReleaseDate returns the value of the "release_date" field in the mutation.
+func (m *ChunithmMusicAvailabilityMutation) ReleaseDate() (r time.Time, exists bool) {
+	v := m.release_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDiff1Const returns the old "diff1_const" field's value of the ChunithmMusicDifficulty entity.
-// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// OldReleaseDate returns the old "release_date" field's value of the ChunithmMusicAvailability entity.
+// If the ChunithmMusicAvailability object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmMusicDifficultyMutation) OldDiff1Const(ctx context.Context) (v *float64, err error) {
+func (m *ChunithmMusicAvailabilityMutation) OldReleaseDate(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiff1Const is only allowed on UpdateOne operations")
+		return v, errors.New("OldReleaseDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiff1Const requires an ID field in the mutation")
+		return v, errors.New("OldReleaseDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiff1Const: %w", err)
-	}
-	return oldValue.Diff1Const, nil
-}
-
-// AddDiff1Const adds f to the "diff1_const" field.
-func (m *ChunithmMusicDifficultyMutation) AddDiff1Const(f float64) {
-	if m.adddiff1_const != nil {
-		*m.adddiff1_const += f
-	} else {
-		m.adddiff1_const = &f
-	}
-}
-
-// AddedDiff1Const returns the value that was added to the "diff1_const" field in this mutation.
-func (m *ChunithmMusicDifficultyMutation) AddedDiff1Const() (r float64, exists bool) {
-	v := m.adddiff1_const
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldReleaseDate: %w", err)
 	}
-	return *v, true
+	return oldValue.ReleaseDate, nil
 }
 
-// ClearDiff1Const clears the value of the "diff1_const" field.
-func (m *ChunithmMusicDifficultyMutation) ClearDiff1Const() {
-	m.diff1_const = nil
-	m.adddiff1_const = nil
-	m.clearedFields[chunithmmusicdifficulty.FieldDiff1Const] = struct{}{}
+// ClearReleaseDate clears the value of the "release_date" field.
+func (m *ChunithmMusicAvailabilityMutation) ClearReleaseDate() {
+	m.release_date = nil
+	m.clearedFields[chunithmmusicavailability.FieldReleaseDate] = struct{}{}
 }
 
-// Diff1ConstCleared returns if the "diff1_const" field was cleared in this mutation.
-func (m *ChunithmMusicDifficultyMutation) Diff1ConstCleared() bool {
-	_, ok := m.clearedFields[chunithmmusicdifficulty.FieldDiff1Const]
+// ReleaseDateCleared returns if the "release_date" field was cleared in this mutation.
+func (m *ChunithmMusicAvailabilityMutation) ReleaseDateCleared() bool {
+	_, ok := m.clearedFields[chunithmmusicavailability.FieldReleaseDate]
 	return ok
 }
 
-// ResetDiff1Const resets all changes to the "diff1_const" field.
-func (m *ChunithmMusicDifficultyMutation) ResetDiff1Const() {
-	m.diff1_const = nil
-	m.adddiff1_const = nil
-	delete(m.clearedFields, chunithmmusicdifficulty.FieldDiff1Const)
+// ResetReleaseDate resets all changes to the "release_date" field.
+func (m *ChunithmMusicAvailabilityMutation) ResetReleaseDate() {
+	m.release_date = nil
+	delete(m.clearedFields, chunithmmusicavailability.FieldReleaseDate)
 }
 
-// SetDiff2Const sets the "diff2_const" field.
-func (m *ChunithmMusicDifficultyMutation) SetDiff2Const(f float64) {
-	m.diff2_const = &f
-	m.adddiff2_const = nil
+// SetIsDeleted sets the "is_deleted" field.
+func (m *ChunithmMusicAvailabilityMutation) SetIsDeleted(b bool) {
+	m.is_deleted = &b
 }
 
-// Diff2Const returns the value of the "diff2_const" field in the mutation.
-func (m *ChunithmMusicDifficultyMutation) Diff2Const() (r float64, exists bool) {
-	v := m.diff2_const
+// IsDeleted returns the value of the "is_deleted" field in the mutation.
+func (m *ChunithmMusicAvailabilityMutation) IsDeleted() (r bool, exists bool) {
+	v := m.is_deleted
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDiff2Const returns the old "diff2_const" field's value of the ChunithmMusicDifficulty entity.
-// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// OldIsDeleted returns the old "is_deleted" field's value of the ChunithmMusicAvailability entity.
+// If the ChunithmMusicAvailability object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmMusicDifficultyMutation) OldDiff2Const(ctx context.Context) (v *float64, err error) {
+func (m *ChunithmMusicAvailabilityMutation) OldIsDeleted(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiff2Const is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsDeleted is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiff2Const requires an ID field in the mutation")
+		return v, errors.New("OldIsDeleted requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiff2Const: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsDeleted: %w", err)
 	}
-	return oldValue.Diff2Const, nil
+	return oldValue.IsDeleted, nil
 }
 
-// AddDiff2Const adds f to the "diff2_const" field.
-func (m *ChunithmMusicDifficultyMutation) AddDiff2Const(f float64) {
+// ResetIsDeleted resets all changes to the "is_deleted" field.
+func (m *ChunithmMusicAvailabilityMutation) ResetIsDeleted() {
+	m.is_deleted = nil
+}
+
+// SetDeletedVersion sets the "deleted_version" field.
+func (m *ChunithmMusicAvailabilityMutation) SetDeletedVersion(s string) {
+	m.deleted_version = &s
+}
+
+// DeletedVersion returns the value of the "deleted_version" field in the mutation.
+func (m *ChunithmMusicAvailabilityMutation) DeletedVersion() (r string, exists bool) {
+	v := m.deleted_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedVersion returns the old "deleted_version" field's value of the ChunithmMusicAvailability entity.
+// If the ChunithmMusicAvailability object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmMusicAvailabilityMutation) OldDeletedVersion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedVersion: %w", err)
+	}
+	return oldValue.DeletedVersion, nil
+}
+
+// ClearDeletedVersion clears the value of the "deleted_version" field.
+func (m *ChunithmMusicAvailabilityMutation) ClearDeletedVersion() {
+	m.deleted_version = nil
+	m.clearedFields[chunithmmusicavailability.FieldDeletedVersion] = struct{}{}
+}
+
+// DeletedVersionCleared returns if the "deleted_version" field was cleared in this mutation.
+func (m *ChunithmMusicAvailabilityMutation) DeletedVersionCleared() bool {
+	_, ok := m.clearedFields[chunithmmusicavailability.FieldDeletedVersion]
+	return ok
+}
+
+// ResetDeletedVersion resets all changes to the "deleted_version" field.
+func (m *ChunithmMusicAvailabilityMutation) ResetDeletedVersion() {
+	m.deleted_version = nil
+	delete(m.clearedFields, chunithmmusicavailability.FieldDeletedVersion)
+}
+
+// Where appends a list predicates to the ChunithmMusicAvailabilityMutation builder.
+func (m *ChunithmMusicAvailabilityMutation) Where(ps ...predicate.ChunithmMusicAvailability) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChunithmMusicAvailabilityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChunithmMusicAvailabilityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChunithmMusicAvailability, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChunithmMusicAvailabilityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChunithmMusicAvailabilityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChunithmMusicAvailability).
+func (m *ChunithmMusicAvailabilityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChunithmMusicAvailabilityMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.music_id != nil {
+		fields = append(fields, chunithmmusicavailability.FieldMusicID)
+	}
+	if m.server != nil {
+		fields = append(fields, chunithmmusicavailability.FieldServer)
+	}
+	if m.release_version != nil {
+		fields = append(fields, chunithmmusicavailability.FieldReleaseVersion)
+	}
+	if m.release_date != nil {
+		fields = append(fields, chunithmmusicavailability.FieldReleaseDate)
+	}
+	if m.is_deleted != nil {
+		fields = append(fields, chunithmmusicavailability.FieldIsDeleted)
+	}
+	if m.deleted_version != nil {
+		fields = append(fields, chunithmmusicavailability.FieldDeletedVersion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChunithmMusicAvailabilityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmmusicavailability.FieldMusicID:
+		return m.MusicID()
+	case chunithmmusicavailability.FieldServer:
+		return m.Server()
+	case chunithmmusicavailability.FieldReleaseVersion:
+		return m.ReleaseVersion()
+	case chunithmmusicavailability.FieldReleaseDate:
+		return m.ReleaseDate()
+	case chunithmmusicavailability.FieldIsDeleted:
+		return m.IsDeleted()
+	case chunithmmusicavailability.FieldDeletedVersion:
+		return m.DeletedVersion()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChunithmMusicAvailabilityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chunithmmusicavailability.FieldMusicID:
+		return m.OldMusicID(ctx)
+	case chunithmmusicavailability.FieldServer:
+		return m.OldServer(ctx)
+	case chunithmmusicavailability.FieldReleaseVersion:
+		return m.OldReleaseVersion(ctx)
+	case chunithmmusicavailability.FieldReleaseDate:
+		return m.OldReleaseDate(ctx)
+	case chunithmmusicavailability.FieldIsDeleted:
+		return m.OldIsDeleted(ctx)
+	case chunithmmusicavailability.FieldDeletedVersion:
+		return m.OldDeletedVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChunithmMusicAvailability field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmMusicAvailabilityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chunithmmusicavailability.FieldMusicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMusicID(v)
+		return nil
+	case chunithmmusicavailability.FieldServer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServer(v)
+		return nil
+	case chunithmmusicavailability.FieldReleaseVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleaseVersion(v)
+		return nil
+	case chunithmmusicavailability.FieldReleaseDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleaseDate(v)
+		return nil
+	case chunithmmusicavailability.FieldIsDeleted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDeleted(v)
+		return nil
+	case chunithmmusicavailability.FieldDeletedVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmMusicAvailability field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChunithmMusicAvailabilityMutation) AddedFields() []string {
+	var fields []string
+	if m.addmusic_id != nil {
+		fields = append(fields, chunithmmusicavailability.FieldMusicID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChunithmMusicAvailabilityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmmusicavailability.FieldMusicID:
+		return m.AddedMusicID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmMusicAvailabilityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chunithmmusicavailability.FieldMusicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMusicID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmMusicAvailability numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChunithmMusicAvailabilityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(chunithmmusicavailability.FieldReleaseVersion) {
+		fields = append(fields, chunithmmusicavailability.FieldReleaseVersion)
+	}
+	if m.FieldCleared(chunithmmusicavailability.FieldReleaseDate) {
+		fields = append(fields, chunithmmusicavailability.FieldReleaseDate)
+	}
+	if m.FieldCleared(chunithmmusicavailability.FieldDeletedVersion) {
+		fields = append(fields, chunithmmusicavailability.FieldDeletedVersion)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChunithmMusicAvailabilityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChunithmMusicAvailabilityMutation) ClearField(name string) error {
+	switch name {
+	case chunithmmusicavailability.FieldReleaseVersion:
+		m.ClearReleaseVersion()
+		return nil
+	case chunithmmusicavailability.FieldReleaseDate:
+		m.ClearReleaseDate()
+		return nil
+	case chunithmmusicavailability.FieldDeletedVersion:
+		m.ClearDeletedVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmMusicAvailability nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChunithmMusicAvailabilityMutation) ResetField(name string) error {
+	switch name {
+	case chunithmmusicavailability.FieldMusicID:
+		m.ResetMusicID()
+		return nil
+	case chunithmmusicavailability.FieldServer:
+		m.ResetServer()
+		return nil
+	case chunithmmusicavailability.FieldReleaseVersion:
+		m.ResetReleaseVersion()
+		return nil
+	case chunithmmusicavailability.FieldReleaseDate:
+		m.ResetReleaseDate()
+		return nil
+	case chunithmmusicavailability.FieldIsDeleted:
+		m.ResetIsDeleted()
+		return nil
+	case chunithmmusicavailability.FieldDeletedVersion:
+		m.ResetDeletedVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmMusicAvailability field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChunithmMusicAvailabilityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChunithmMusicAvailabilityMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChunithmMusicAvailabilityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChunithmMusicAvailabilityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChunithmMusicAvailabilityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChunithmMusicAvailabilityMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChunithmMusicAvailabilityMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmMusicAvailability unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChunithmMusicAvailabilityMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmMusicAvailability edge %s", name)
+}
+
+// ChunithmMusicDifficultyMutation represents an operation that mutates the ChunithmMusicDifficulty nodes in the graph.
+type ChunithmMusicDifficultyMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	music_id       *int
+	addmusic_id    *int
+	version        *string
+	diff0_const    *float64
+	adddiff0_const *float64
+	diff1_const    *float64
+	adddiff1_const *float64
+	diff2_const    *float64
+	adddiff2_const *float64
+	diff3_const    *float64
+	adddiff3_const *float64
+	diff4_const    *float64
+	adddiff4_const *float64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ChunithmMusicDifficulty, error)
+	predicates     []predicate.ChunithmMusicDifficulty
+}
+
+var _ ent.Mutation = (*ChunithmMusicDifficultyMutation)(nil)
+
+// chunithmmusicdifficultyOption allows management of the mutation configuration using functional options.
+type chunithmmusicdifficultyOption func(*ChunithmMusicDifficultyMutation)
+
+// newChunithmMusicDifficultyMutation creates new mutation for the ChunithmMusicDifficulty entity.
+func newChunithmMusicDifficultyMutation(c config, op Op, opts ...chunithmmusicdifficultyOption) *ChunithmMusicDifficultyMutation {
+	m := &ChunithmMusicDifficultyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChunithmMusicDifficulty,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChunithmMusicDifficultyID sets the ID field of the mutation.
+func withChunithmMusicDifficultyID(id int) chunithmmusicdifficultyOption {
+	return func(m *ChunithmMusicDifficultyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChunithmMusicDifficulty
+		)
+		m.oldValue = func(ctx context.Context) (*ChunithmMusicDifficulty, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChunithmMusicDifficulty.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChunithmMusicDifficulty sets the old ChunithmMusicDifficulty of the mutation.
+func withChunithmMusicDifficulty(node *ChunithmMusicDifficulty) chunithmmusicdifficultyOption {
+	return func(m *ChunithmMusicDifficultyMutation) {
+		m.oldValue = func(context.Context) (*ChunithmMusicDifficulty, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChunithmMusicDifficultyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChunithmMusicDifficultyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("music: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChunithmMusicDifficultyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChunithmMusicDifficultyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChunithmMusicDifficulty.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMusicID sets the "music_id" field.
+func (m *ChunithmMusicDifficultyMutation) SetMusicID(i int) {
+	m.music_id = &i
+	m.addmusic_id = nil
+}
+
+// MusicID returns the value of the "music_id" field in the mutation.
+func (m *ChunithmMusicDifficultyMutation) MusicID() (r int, exists bool) {
+	v := m.music_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMusicID returns the old "music_id" field's value of the ChunithmMusicDifficulty entity.
+// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmMusicDifficultyMutation) OldMusicID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMusicID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMusicID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMusicID: %w", err)
+	}
+	return oldValue.MusicID, nil
+}
+
+// AddMusicID adds i to the "music_id" field.
+func (m *ChunithmMusicDifficultyMutation) AddMusicID(i int) {
+	if m.addmusic_id != nil {
+		*m.addmusic_id += i
+	} else {
+		m.addmusic_id = &i
+	}
+}
+
+// AddedMusicID returns the value that was added to the "music_id" field in this mutation.
+func (m *ChunithmMusicDifficultyMutation) AddedMusicID() (r int, exists bool) {
+	v := m.addmusic_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMusicID resets all changes to the "music_id" field.
+func (m *ChunithmMusicDifficultyMutation) ResetMusicID() {
+	m.music_id = nil
+	m.addmusic_id = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *ChunithmMusicDifficultyMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ChunithmMusicDifficultyMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the ChunithmMusicDifficulty entity.
+// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmMusicDifficultyMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ChunithmMusicDifficultyMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetDiff0Const sets the "diff0_const" field.
+func (m *ChunithmMusicDifficultyMutation) SetDiff0Const(f float64) {
+	m.diff0_const = &f
+	m.adddiff0_const = nil
+}
+
+// Diff0Const returns the value of the "diff0_const" field in the mutation.
+func (m *ChunithmMusicDifficultyMutation) Diff0Const() (r float64, exists bool) {
+	v := m.diff0_const
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiff0Const returns the old "diff0_const" field's value of the ChunithmMusicDifficulty entity.
+// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmMusicDifficultyMutation) OldDiff0Const(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiff0Const is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiff0Const requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiff0Const: %w", err)
+	}
+	return oldValue.Diff0Const, nil
+}
+
+// AddDiff0Const adds f to the "diff0_const" field.
+func (m *ChunithmMusicDifficultyMutation) AddDiff0Const(f float64) {
+	if m.adddiff0_const != nil {
+		*m.adddiff0_const += f
+	} else {
+		m.adddiff0_const = &f
+	}
+}
+
+// AddedDiff0Const returns the value that was added to the "diff0_const" field in this mutation.
+func (m *ChunithmMusicDifficultyMutation) AddedDiff0Const() (r float64, exists bool) {
+	v := m.adddiff0_const
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDiff0Const clears the value of the "diff0_const" field.
+func (m *ChunithmMusicDifficultyMutation) ClearDiff0Const() {
+	m.diff0_const = nil
+	m.adddiff0_const = nil
+	m.clearedFields[chunithmmusicdifficulty.FieldDiff0Const] = struct{}{}
+}
+
+// Diff0ConstCleared returns if the "diff0_const" field was cleared in this mutation.
+func (m *ChunithmMusicDifficultyMutation) Diff0ConstCleared() bool {
+	_, ok := m.clearedFields[chunithmmusicdifficulty.FieldDiff0Const]
+	return ok
+}
+
+// ResetDiff0Const resets all changes to the "diff0_const" field.
+func (m *ChunithmMusicDifficultyMutation) ResetDiff0Const() {
+	m.diff0_const = nil
+	m.adddiff0_const = nil
+	delete(m.clearedFields, chunithmmusicdifficulty.FieldDiff0Const)
+}
+
+// SetDiff1Const sets the "diff1_const" field.
+func (m *ChunithmMusicDifficultyMutation) SetDiff1Const(f float64) {
+	m.diff1_const = &f
+	m.adddiff1_const = nil
+}
+
+// Diff1Const returns the value of the "diff1_const" field in the mutation.
+func (m *ChunithmMusicDifficultyMutation) Diff1Const() (r float64, exists bool) {
+	v := m.diff1_const
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiff1Const returns the old "diff1_const" field's value of the ChunithmMusicDifficulty entity.
+// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmMusicDifficultyMutation) OldDiff1Const(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiff1Const is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiff1Const requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiff1Const: %w", err)
+	}
+	return oldValue.Diff1Const, nil
+}
+
+// AddDiff1Const adds f to the "diff1_const" field.
+func (m *ChunithmMusicDifficultyMutation) AddDiff1Const(f float64) {
+	if m.adddiff1_const != nil {
+		*m.adddiff1_const += f
+	} else {
+		m.adddiff1_const = &f
+	}
+}
+
+// AddedDiff1Const returns the value that was added to the "diff1_const" field in this mutation.
+func (m *ChunithmMusicDifficultyMutation) AddedDiff1Const() (r float64, exists bool) {
+	v := m.adddiff1_const
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDiff1Const clears the value of the "diff1_const" field.
+func (m *ChunithmMusicDifficultyMutation) ClearDiff1Const() {
+	m.diff1_const = nil
+	m.adddiff1_const = nil
+	m.clearedFields[chunithmmusicdifficulty.FieldDiff1Const] = struct{}{}
+}
+
+// Diff1ConstCleared returns if the "diff1_const" field was cleared in this mutation.
+func (m *ChunithmMusicDifficultyMutation) Diff1ConstCleared() bool {
+	_, ok := m.clearedFields[chunithmmusicdifficulty.FieldDiff1Const]
+	return ok
+}
+
+// ResetDiff1Const resets all changes to the "diff1_const" field.
+func (m *ChunithmMusicDifficultyMutation) ResetDiff1Const() {
+	m.diff1_const = nil
+	m.adddiff1_const = nil
+	delete(m.clearedFields, chunithmmusicdifficulty.FieldDiff1Const)
+}
+
+// SetDiff2Const sets the "diff2_const" field.
+func (m *ChunithmMusicDifficultyMutation) SetDiff2Const(f float64) {
+	m.diff2_const = &f
+	m.adddiff2_const = nil
+}
+
+// Diff2Const returns the value of the "diff2_const" field in the mutation.
+func (m *ChunithmMusicDifficultyMutation) Diff2Const() (r float64, exists bool) {
+	v := m.diff2_const
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiff2Const returns the old "diff2_const" field's value of the ChunithmMusicDifficulty entity.
+// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmMusicDifficultyMutation) OldDiff2Const(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiff2Const is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiff2Const requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiff2Const: %w", err)
+	}
+	return oldValue.Diff2Const, nil
+}
+
+// AddDiff2Const adds f to the "diff2_const" field.
+func (m *ChunithmMusicDifficultyMutation) AddDiff2Const(f float64) {
+	if m.adddiff2_const != nil {
+		*m.adddiff2_const += f
+	} else {
+		m.adddiff2_const = &f
+	}
+}
+
+// AddedDiff2Const returns the value that was added to the "diff2_const" field in this mutation.
+func (m *ChunithmMusicDifficultyMutation) AddedDiff2Const() (r float64, exists bool) {
+	v := m.adddiff2_const
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDiff2Const clears the value of the "diff2_const" field.
+func (m *ChunithmMusicDifficultyMutation) ClearDiff2Const() {
+	m.diff2_const = nil
+	m.adddiff2_const = nil
+	m.clearedFields[chunithmmusicdifficulty.FieldDiff2Const] = struct{}{}
+}
+
+// Diff2ConstCleared returns if the "diff2_const" field was cleared in this mutation.
+func (m *ChunithmMusicDifficultyMutation) Diff2ConstCleared() bool {
+	_, ok := m.clearedFields[chunithmmusicdifficulty.FieldDiff2Const]
+	return ok
+}
+
+// ResetDiff2Const resets all changes to the "diff2_const" field.
+func (m *ChunithmMusicDifficultyMutation) ResetDiff2Const() {
+	m.diff2_const = nil
+	m.adddiff2_const = nil
+	delete(m.clearedFields, chunithmmusicdifficulty.FieldDiff2Const)
+}
+
+// SetDiff3Const sets the "diff3_const" field.
+func (m *ChunithmMusicDifficultyMutation) SetDiff3Const(f float64) {
+	m.diff3_const = &f
+	m.adddiff3_const = nil
+}
+
+// Diff3Const returns the value of the "diff3_const" field in the mutation.
+func (m *ChunithmMusicDifficultyMutation) Diff3Const() (r float64, exists bool) {
+	v := m.diff3_const
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiff3Const returns the old "diff3_const" field's value of the ChunithmMusicDifficulty entity.
+// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmMusicDifficultyMutation) OldDiff3Const(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiff3Const is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiff3Const requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiff3Const: %w", err)
+	}
+	return oldValue.Diff3Const, nil
+}
+
+// AddDiff3Const adds f to the "diff3_const" field.
+func (m *ChunithmMusicDifficultyMutation) AddDiff3Const(f float64) {
+	if m.adddiff3_const != nil {
+		*m.adddiff3_const += f
+	} else {
+		m.adddiff3_const = &f
+	}
+}
+
+// AddedDiff3Const returns the value that was added to the "diff3_const" field in this mutation.
+func (m *ChunithmMusicDifficultyMutation) AddedDiff3Const() (r float64, exists bool) {
+	v := m.adddiff3_const
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDiff3Const clears the value of the "diff3_const" field.
+func (m *ChunithmMusicDifficultyMutation) ClearDiff3Const() {
+	m.diff3_const = nil
+	m.adddiff3_const = nil
+	m.clearedFields[chunithmmusicdifficulty.FieldDiff3Const] = struct{}{}
+}
+
+// Diff3ConstCleared returns if the "diff3_const" field was cleared in this mutation.
+func (m *ChunithmMusicDifficultyMutation) Diff3ConstCleared() bool {
+	_, ok := m.clearedFields[chunithmmusicdifficulty.FieldDiff3Const]
+	return ok
+}
+
+// ResetDiff3Const resets all changes to the "diff3_const" field.
+func (m *ChunithmMusicDifficultyMutation) ResetDiff3Const() {
+	m.diff3_const = nil
+	m.adddiff3_const = nil
+	delete(m.clearedFields, chunithmmusicdifficulty.FieldDiff3Const)
+}
+
+// SetDiff4Const sets the "diff4_const" field.
+func (m *ChunithmMusicDifficultyMutation) SetDiff4Const(f float64) {
+	m.diff4_const = &f
+	m.adddiff4_const = nil
+}
+
+// Diff4Const returns the value of the "diff4_const" field in the mutation.
+func (m *ChunithmMusicDifficultyMutation) Diff4Const() (r float64, exists bool) {
+	v := m.diff4_const
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiff4Const returns the old "diff4_const" field's value of the ChunithmMusicDifficulty entity.
+// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmMusicDifficultyMutation) OldDiff4Const(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiff4Const is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiff4Const requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiff4Const: %w", err)
+	}
+	return oldValue.Diff4Const, nil
+}
+
+// AddDiff4Const adds f to the "diff4_const" field.
+func (m *ChunithmMusicDifficultyMutation) AddDiff4Const(f float64) {
+	if m.adddiff4_const != nil {
+		*m.adddiff4_const += f
+	} else {
+		m.adddiff4_const = &f
+	}
+}
+
+// AddedDiff4Const returns the value that was added to the "diff4_const" field in this mutation.
+func (m *ChunithmMusicDifficultyMutation) AddedDiff4Const() (r float64, exists bool) {
+	v := m.adddiff4_const
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDiff4Const clears the value of the "diff4_const" field.
+func (m *ChunithmMusicDifficultyMutation) ClearDiff4Const() {
+	m.diff4_const = nil
+	m.adddiff4_const = nil
+	m.clearedFields[chunithmmusicdifficulty.FieldDiff4Const] = struct{}{}
+}
+
+// Diff4ConstCleared returns if the "diff4_const" field was cleared in this mutation.
+func (m *ChunithmMusicDifficultyMutation) Diff4ConstCleared() bool {
+	_, ok := m.clearedFields[chunithmmusicdifficulty.FieldDiff4Const]
+	return ok
+}
+
+// ResetDiff4Const resets all changes to the "diff4_const" field.
+func (m *ChunithmMusicDifficultyMutation) ResetDiff4Const() {
+	m.diff4_const = nil
+	m.adddiff4_const = nil
+	delete(m.clearedFields, chunithmmusicdifficulty.FieldDiff4Const)
+}
+
+// Where appends a list predicates to the ChunithmMusicDifficultyMutation builder.
+func (m *ChunithmMusicDifficultyMutation) Where(ps ...predicate.ChunithmMusicDifficulty) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChunithmMusicDifficultyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChunithmMusicDifficultyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChunithmMusicDifficulty, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChunithmMusicDifficultyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChunithmMusicDifficultyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChunithmMusicDifficulty).
+func (m *ChunithmMusicDifficultyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChunithmMusicDifficultyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.music_id != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldMusicID)
+	}
+	if m.version != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldVersion)
+	}
+	if m.diff0_const != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff0Const)
+	}
+	if m.diff1_const != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff1Const)
+	}
+	if m.diff2_const != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff2Const)
+	}
+	if m.diff3_const != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff3Const)
+	}
+	if m.diff4_const != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff4Const)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChunithmMusicDifficultyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmmusicdifficulty.FieldMusicID:
+		return m.MusicID()
+	case chunithmmusicdifficulty.FieldVersion:
+		return m.Version()
+	case chunithmmusicdifficulty.FieldDiff0Const:
+		return m.Diff0Const()
+	case chunithmmusicdifficulty.FieldDiff1Const:
+		return m.Diff1Const()
+	case chunithmmusicdifficulty.FieldDiff2Const:
+		return m.Diff2Const()
+	case chunithmmusicdifficulty.FieldDiff3Const:
+		return m.Diff3Const()
+	case chunithmmusicdifficulty.FieldDiff4Const:
+		return m.Diff4Const()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChunithmMusicDifficultyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chunithmmusicdifficulty.FieldMusicID:
+		return m.OldMusicID(ctx)
+	case chunithmmusicdifficulty.FieldVersion:
+		return m.OldVersion(ctx)
+	case chunithmmusicdifficulty.FieldDiff0Const:
+		return m.OldDiff0Const(ctx)
+	case chunithmmusicdifficulty.FieldDiff1Const:
+		return m.OldDiff1Const(ctx)
+	case chunithmmusicdifficulty.FieldDiff2Const:
+		return m.OldDiff2Const(ctx)
+	case chunithmmusicdifficulty.FieldDiff3Const:
+		return m.OldDiff3Const(ctx)
+	case chunithmmusicdifficulty.FieldDiff4Const:
+		return m.OldDiff4Const(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChunithmMusicDifficulty field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmMusicDifficultyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chunithmmusicdifficulty.FieldMusicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMusicID(v)
+		return nil
+	case chunithmmusicdifficulty.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case chunithmmusicdifficulty.FieldDiff0Const:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiff0Const(v)
+		return nil
+	case chunithmmusicdifficulty.FieldDiff1Const:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiff1Const(v)
+		return nil
+	case chunithmmusicdifficulty.FieldDiff2Const:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiff2Const(v)
+		return nil
+	case chunithmmusicdifficulty.FieldDiff3Const:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiff3Const(v)
+		return nil
+	case chunithmmusicdifficulty.FieldDiff4Const:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiff4Const(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmMusicDifficulty field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChunithmMusicDifficultyMutation) AddedFields() []string {
+	var fields []string
+	if m.addmusic_id != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldMusicID)
+	}
+	if m.adddiff0_const != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff0Const)
+	}
+	if m.adddiff1_const != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff1Const)
+	}
 	if m.adddiff2_const != nil {
-		*m.adddiff2_const += f
-	} else {
-		m.adddiff2_const = &f
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff2Const)
+	}
+	if m.adddiff3_const != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff3Const)
+	}
+	if m.adddiff4_const != nil {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff4Const)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChunithmMusicDifficultyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chunithmmusicdifficulty.FieldMusicID:
+		return m.AddedMusicID()
+	case chunithmmusicdifficulty.FieldDiff0Const:
+		return m.AddedDiff0Const()
+	case chunithmmusicdifficulty.FieldDiff1Const:
+		return m.AddedDiff1Const()
+	case chunithmmusicdifficulty.FieldDiff2Const:
+		return m.AddedDiff2Const()
+	case chunithmmusicdifficulty.FieldDiff3Const:
+		return m.AddedDiff3Const()
+	case chunithmmusicdifficulty.FieldDiff4Const:
+		return m.AddedDiff4Const()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChunithmMusicDifficultyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chunithmmusicdifficulty.FieldMusicID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMusicID(v)
+		return nil
+	case chunithmmusicdifficulty.FieldDiff0Const:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiff0Const(v)
+		return nil
+	case chunithmmusicdifficulty.FieldDiff1Const:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiff1Const(v)
+		return nil
+	case chunithmmusicdifficulty.FieldDiff2Const:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiff2Const(v)
+		return nil
+	case chunithmmusicdifficulty.FieldDiff3Const:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiff3Const(v)
+		return nil
+	case chunithmmusicdifficulty.FieldDiff4Const:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiff4Const(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmMusicDifficulty numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChunithmMusicDifficultyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(chunithmmusicdifficulty.FieldDiff0Const) {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff0Const)
+	}
+	if m.FieldCleared(chunithmmusicdifficulty.FieldDiff1Const) {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff1Const)
+	}
+	if m.FieldCleared(chunithmmusicdifficulty.FieldDiff2Const) {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff2Const)
+	}
+	if m.FieldCleared(chunithmmusicdifficulty.FieldDiff3Const) {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff3Const)
+	}
+	if m.FieldCleared(chunithmmusicdifficulty.FieldDiff4Const) {
+		fields = append(fields, chunithmmusicdifficulty.FieldDiff4Const)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChunithmMusicDifficultyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChunithmMusicDifficultyMutation) ClearField(name string) error {
+	switch name {
+	case chunithmmusicdifficulty.FieldDiff0Const:
+		m.ClearDiff0Const()
+		return nil
+	case chunithmmusicdifficulty.FieldDiff1Const:
+		m.ClearDiff1Const()
+		return nil
+	case chunithmmusicdifficulty.FieldDiff2Const:
+		m.ClearDiff2Const()
+		return nil
+	case chunithmmusicdifficulty.FieldDiff3Const:
+		m.ClearDiff3Const()
+		return nil
+	case chunithmmusicdifficulty.FieldDiff4Const:
+		m.ClearDiff4Const()
+		return nil
+	}
+	return fmt.Errorf("unknown ChunithmMusicDifficulty nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChunithmMusicDifficultyMutation) ResetField(name string) error {
+	switch name {
+	case chunithmmusicdifficulty.FieldMusicID:
+		m.ResetMusicID()
+		return nil
+	case chunithmmusicdifficulty.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case chunithmmusicdifficulty.FieldDiff0Const:
+		m.ResetDiff0Const()
+		return nil
+	case chunithmmusicdifficulty.FieldDiff1Const:
+		m.ResetDiff1Const()
+		return nil
+	case chunithmmusicdifficulty.FieldDiff2Const:
+		m.ResetDiff2Const()
+		return nil
+	case chunithmmusicdifficulty.FieldDiff3Const:
+		m.ResetDiff3Const()
+		return nil
+	case chunithmmusicdifficulty.FieldDiff4Const:
+		m.ResetDiff4Const()
+		return nil
 	}
+	return fmt.Errorf("unknown ChunithmMusicDifficulty field %s", name)
 }
 
-// AddedDiff2Const returns the value that was added to the "diff2_const" field in this mutation.
-func (m *ChunithmMusicDifficultyMutation) AddedDiff2Const() (r float64, exists bool) {
-	v := m.adddiff2_const
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChunithmMusicDifficultyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChunithmMusicDifficultyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChunithmMusicDifficultyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChunithmMusicDifficultyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChunithmMusicDifficultyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChunithmMusicDifficultyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChunithmMusicDifficultyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmMusicDifficulty unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChunithmMusicDifficultyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmMusicDifficulty edge %s", name)
+}
+
+// ChunithmVersionMutation represents an operation that mutates the ChunithmVersion nodes in the graph.
+type ChunithmVersionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	version          *string
+	name             *string
+	release_order    *int
+	addrelease_order *int
+	release_date     *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*ChunithmVersion, error)
+	predicates       []predicate.ChunithmVersion
+}
+
+var _ ent.Mutation = (*ChunithmVersionMutation)(nil)
+
+// chunithmversionOption allows management of the mutation configuration using functional options.
+type chunithmversionOption func(*ChunithmVersionMutation)
+
+// newChunithmVersionMutation creates new mutation for the ChunithmVersion entity.
+func newChunithmVersionMutation(c config, op Op, opts ...chunithmversionOption) *ChunithmVersionMutation {
+	m := &ChunithmVersionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChunithmVersion,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ClearDiff2Const clears the value of the "diff2_const" field.
-func (m *ChunithmMusicDifficultyMutation) ClearDiff2Const() {
-	m.diff2_const = nil
-	m.adddiff2_const = nil
-	m.clearedFields[chunithmmusicdifficulty.FieldDiff2Const] = struct{}{}
+// withChunithmVersionID sets the ID field of the mutation.
+func withChunithmVersionID(id int) chunithmversionOption {
+	return func(m *ChunithmVersionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChunithmVersion
+		)
+		m.oldValue = func(ctx context.Context) (*ChunithmVersion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChunithmVersion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// Diff2ConstCleared returns if the "diff2_const" field was cleared in this mutation.
-func (m *ChunithmMusicDifficultyMutation) Diff2ConstCleared() bool {
-	_, ok := m.clearedFields[chunithmmusicdifficulty.FieldDiff2Const]
-	return ok
+// withChunithmVersion sets the old ChunithmVersion of the mutation.
+func withChunithmVersion(node *ChunithmVersion) chunithmversionOption {
+	return func(m *ChunithmVersionMutation) {
+		m.oldValue = func(context.Context) (*ChunithmVersion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetDiff2Const resets all changes to the "diff2_const" field.
-func (m *ChunithmMusicDifficultyMutation) ResetDiff2Const() {
-	m.diff2_const = nil
-	m.adddiff2_const = nil
-	delete(m.clearedFields, chunithmmusicdifficulty.FieldDiff2Const)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChunithmVersionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetDiff3Const sets the "diff3_const" field.
-func (m *ChunithmMusicDifficultyMutation) SetDiff3Const(f float64) {
-	m.diff3_const = &f
-	m.adddiff3_const = nil
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChunithmVersionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("music: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Diff3Const returns the value of the "diff3_const" field in the mutation.
-func (m *ChunithmMusicDifficultyMutation) Diff3Const() (r float64, exists bool) {
-	v := m.diff3_const
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChunithmVersionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChunithmVersionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChunithmVersion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVersion sets the "version" field.
+func (m *ChunithmVersionMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ChunithmVersionMutation) Version() (r string, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDiff3Const returns the old "diff3_const" field's value of the ChunithmMusicDifficulty entity.
-// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the ChunithmVersion entity.
+// If the ChunithmVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmMusicDifficultyMutation) OldDiff3Const(ctx context.Context) (v *float64, err error) {
+func (m *ChunithmVersionMutation) OldVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiff3Const is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiff3Const requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiff3Const: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Diff3Const, nil
+	return oldValue.Version, nil
 }
 
-// AddDiff3Const adds f to the "diff3_const" field.
-func (m *ChunithmMusicDifficultyMutation) AddDiff3Const(f float64) {
-	if m.adddiff3_const != nil {
-		*m.adddiff3_const += f
-	} else {
-		m.adddiff3_const = &f
-	}
+// ResetVersion resets all changes to the "version" field.
+func (m *ChunithmVersionMutation) ResetVersion() {
+	m.version = nil
 }
 
-// AddedDiff3Const returns the value that was added to the "diff3_const" field in this mutation.
-func (m *ChunithmMusicDifficultyMutation) AddedDiff3Const() (r float64, exists bool) {
-	v := m.adddiff3_const
+// SetName sets the "name" field.
+func (m *ChunithmVersionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ChunithmVersionMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearDiff3Const clears the value of the "diff3_const" field.
-func (m *ChunithmMusicDifficultyMutation) ClearDiff3Const() {
-	m.diff3_const = nil
-	m.adddiff3_const = nil
-	m.clearedFields[chunithmmusicdifficulty.FieldDiff3Const] = struct{}{}
+// OldName returns the old "name" field's value of the ChunithmVersion entity.
+// If the ChunithmVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmVersionMutation) OldName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// Diff3ConstCleared returns if the "diff3_const" field was cleared in this mutation.
-func (m *ChunithmMusicDifficultyMutation) Diff3ConstCleared() bool {
-	_, ok := m.clearedFields[chunithmmusicdifficulty.FieldDiff3Const]
+// ClearName clears the value of the "name" field.
+func (m *ChunithmVersionMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[chunithmversion.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *ChunithmVersionMutation) NameCleared() bool {
+	_, ok := m.clearedFields[chunithmversion.FieldName]
 	return ok
 }
 
-// ResetDiff3Const resets all changes to the "diff3_const" field.
-func (m *ChunithmMusicDifficultyMutation) ResetDiff3Const() {
-	m.diff3_const = nil
-	m.adddiff3_const = nil
-	delete(m.clearedFields, chunithmmusicdifficulty.FieldDiff3Const)
+// ResetName resets all changes to the "name" field.
+func (m *ChunithmVersionMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, chunithmversion.FieldName)
 }
 
-// SetDiff4Const sets the "diff4_const" field.
-func (m *ChunithmMusicDifficultyMutation) SetDiff4Const(f float64) {
-	m.diff4_const = &f
-	m.adddiff4_const = nil
+// SetReleaseOrder sets the "release_order" field.
+func (m *ChunithmVersionMutation) SetReleaseOrder(i int) {
+	m.release_order = &i
+	m.addrelease_order = nil
 }
 
-// Diff4Const returns the value of the "diff4_const" field in the mutation.
-func (m *ChunithmMusicDifficultyMutation) Diff4Const() (r float64, exists bool) {
-	v := m.diff4_const
+// ReleaseOrder returns the value of the "release_order" field in the mutation.
+func (m *ChunithmVersionMutation) ReleaseOrder() (r int, exists bool) {
+	v := m.release_order
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDiff4Const returns the old "diff4_const" field's value of the ChunithmMusicDifficulty entity.
-// If the ChunithmMusicDifficulty object wasn't provided to the builder, the object is fetched from the database.
+// OldReleaseOrder returns the old "release_order" field's value of the ChunithmVersion entity.
+// If the ChunithmVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChunithmMusicDifficultyMutation) OldDiff4Const(ctx context.Context) (v *float64, err error) {
+func (m *ChunithmVersionMutation) OldReleaseOrder(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiff4Const is only allowed on UpdateOne operations")
+		return v, errors.New("OldReleaseOrder is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiff4Const requires an ID field in the mutation")
+		return v, errors.New("OldReleaseOrder requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiff4Const: %w", err)
+		return v, fmt.Errorf("querying old value for OldReleaseOrder: %w", err)
 	}
-	return oldValue.Diff4Const, nil
+	return oldValue.ReleaseOrder, nil
 }
 
-// AddDiff4Const adds f to the "diff4_const" field.
-func (m *ChunithmMusicDifficultyMutation) AddDiff4Const(f float64) {
-	if m.adddiff4_const != nil {
-		*m.adddiff4_const += f
+// AddReleaseOrder adds i to the "release_order" field.
+func (m *ChunithmVersionMutation) AddReleaseOrder(i int) {
+	if m.addrelease_order != nil {
+		*m.addrelease_order += i
 	} else {
-		m.adddiff4_const = &f
+		m.addrelease_order = &i
+	}
+}
+
+// AddedReleaseOrder returns the value that was added to the "release_order" field in this mutation.
+func (m *ChunithmVersionMutation) AddedReleaseOrder() (r int, exists bool) {
+	v := m.addrelease_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReleaseOrder resets all changes to the "release_order" field.
+func (m *ChunithmVersionMutation) ResetReleaseOrder() {
+	m.release_order = nil
+	m.addrelease_order = nil
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (m *ChunithmVersionMutation) SetReleaseDate(t time.Time) {
+	m.release_date = &t
+}
+
+// ReleaseDate returns the value of the "release_date" field in the mutation.
+func (m *ChunithmVersionMutation) ReleaseDate() (r time.Time, exists bool) {
+	v := m.release_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleaseDate returns the old "release_date" field's value of the ChunithmVersion entity.
+// If the ChunithmVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChunithmVersionMutation) OldReleaseDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleaseDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleaseDate requires an ID field in the mutation")
 	}
-}
-
-// AddedDiff4Const returns the value that was added to the "diff4_const" field in this mutation.
-func (m *ChunithmMusicDifficultyMutation) AddedDiff4Const() (r float64, exists bool) {
-	v := m.adddiff4_const
-	if v == nil {
-		return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleaseDate: %w", err)
 	}
-	return *v, true
+	return oldValue.ReleaseDate, nil
 }
 
-// ClearDiff4Const clears the value of the "diff4_const" field.
-func (m *ChunithmMusicDifficultyMutation) ClearDiff4Const() {
-	m.diff4_const = nil
-	m.adddiff4_const = nil
-	m.clearedFields[chunithmmusicdifficulty.FieldDiff4Const] = struct{}{}
+// ClearReleaseDate clears the value of the "release_date" field.
+func (m *ChunithmVersionMutation) ClearReleaseDate() {
+	m.release_date = nil
+	m.clearedFields[chunithmversion.FieldReleaseDate] = struct{}{}
 }
 
-// Diff4ConstCleared returns if the "diff4_const" field was cleared in this mutation.
-func (m *ChunithmMusicDifficultyMutation) Diff4ConstCleared() bool {
-	_, ok := m.clearedFields[chunithmmusicdifficulty.FieldDiff4Const]
+// ReleaseDateCleared returns if the "release_date" field was cleared in this mutation.
+func (m *ChunithmVersionMutation) ReleaseDateCleared() bool {
+	_, ok := m.clearedFields[chunithmversion.FieldReleaseDate]
 	return ok
 }
 
-// ResetDiff4Const resets all changes to the "diff4_const" field.
-func (m *ChunithmMusicDifficultyMutation) ResetDiff4Const() {
-	m.diff4_const = nil
-	m.adddiff4_const = nil
-	delete(m.clearedFields, chunithmmusicdifficulty.FieldDiff4Const)
+// ResetReleaseDate resets all changes to the "release_date" field.
+func (m *ChunithmVersionMutation) ResetReleaseDate() {
+	m.release_date = nil
+	delete(m.clearedFields, chunithmversion.FieldReleaseDate)
 }
 
-// Where appends a list predicates to the ChunithmMusicDifficultyMutation builder.
-func (m *ChunithmMusicDifficultyMutation) Where(ps ...predicate.ChunithmMusicDifficulty) {
+// Where appends a list predicates to the ChunithmVersionMutation builder.
+func (m *ChunithmVersionMutation) Where(ps ...predicate.ChunithmVersion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ChunithmMusicDifficultyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChunithmVersionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChunithmMusicDifficultyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ChunithmMusicDifficulty, len(ps))
+func (m *ChunithmVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChunithmVersion, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2705,45 +4202,36 @@ func (m *ChunithmMusicDifficultyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ChunithmMusicDifficultyMutation) Op() Op {
+func (m *ChunithmVersionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ChunithmMusicDifficultyMutation) SetOp(op Op) {
+func (m *ChunithmVersionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ChunithmMusicDifficulty).
-func (m *ChunithmMusicDifficultyMutation) Type() string {
+// Type returns the node type of this mutation (ChunithmVersion).
+func (m *ChunithmVersionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChunithmMusicDifficultyMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.music_id != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldMusicID)
-	}
+func (m *ChunithmVersionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.version != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldVersion)
-	}
-	if m.diff0_const != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff0Const)
+		fields = append(fields, chunithmversion.FieldVersion)
 	}
-	if m.diff1_const != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff1Const)
-	}
-	if m.diff2_const != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff2Const)
+	if m.name != nil {
+		fields = append(fields, chunithmversion.FieldName)
 	}
-	if m.diff3_const != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff3Const)
+	if m.release_order != nil {
+		fields = append(fields, chunithmversion.FieldReleaseOrder)
 	}
-	if m.diff4_const != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff4Const)
+	if m.release_date != nil {
+		fields = append(fields, chunithmversion.FieldReleaseDate)
 	}
 	return fields
 }
@@ -2751,22 +4239,16 @@ func (m *ChunithmMusicDifficultyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChunithmMusicDifficultyMutation) Field(name string) (ent.Value, bool) {
+func (m *ChunithmVersionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case chunithmmusicdifficulty.FieldMusicID:
-		return m.MusicID()
-	case chunithmmusicdifficulty.FieldVersion:
+	case chunithmversion.FieldVersion:
 		return m.Version()
-	case chunithmmusicdifficulty.FieldDiff0Const:
-		return m.Diff0Const()
-	case chunithmmusicdifficulty.FieldDiff1Const:
-		return m.Diff1Const()
-	case chunithmmusicdifficulty.FieldDiff2Const:
-		return m.Diff2Const()
-	case chunithmmusicdifficulty.FieldDiff3Const:
-		return m.Diff3Const()
-	case chunithmmusicdifficulty.FieldDiff4Const:
-		return m.Diff4Const()
+	case chunithmversion.FieldName:
+		return m.Name()
+	case chunithmversion.FieldReleaseOrder:
+		return m.ReleaseOrder()
+	case chunithmversion.FieldReleaseDate:
+		return m.ReleaseDate()
 	}
 	return nil, false
 }
@@ -2774,105 +4256,63 @@ func (m *ChunithmMusicDifficultyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChunithmMusicDifficultyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChunithmVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case chunithmmusicdifficulty.FieldMusicID:
-		return m.OldMusicID(ctx)
-	case chunithmmusicdifficulty.FieldVersion:
+	case chunithmversion.FieldVersion:
 		return m.OldVersion(ctx)
-	case chunithmmusicdifficulty.FieldDiff0Const:
-		return m.OldDiff0Const(ctx)
-	case chunithmmusicdifficulty.FieldDiff1Const:
-		return m.OldDiff1Const(ctx)
-	case chunithmmusicdifficulty.FieldDiff2Const:
-		return m.OldDiff2Const(ctx)
-	case chunithmmusicdifficulty.FieldDiff3Const:
-		return m.OldDiff3Const(ctx)
-	case chunithmmusicdifficulty.FieldDiff4Const:
-		return m.OldDiff4Const(ctx)
+	case chunithmversion.FieldName:
+		return m.OldName(ctx)
+	case chunithmversion.FieldReleaseOrder:
+		return m.OldReleaseOrder(ctx)
+	case chunithmversion.FieldReleaseDate:
+		return m.OldReleaseDate(ctx)
 	}
-	return nil, fmt.Errorf("unknown ChunithmMusicDifficulty field %s", name)
+	return nil, fmt.Errorf("unknown ChunithmVersion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChunithmMusicDifficultyMutation) SetField(name string, value ent.Value) error {
+func (m *ChunithmVersionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case chunithmmusicdifficulty.FieldMusicID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMusicID(v)
-		return nil
-	case chunithmmusicdifficulty.FieldVersion:
+	case chunithmversion.FieldVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetVersion(v)
 		return nil
-	case chunithmmusicdifficulty.FieldDiff0Const:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiff0Const(v)
-		return nil
-	case chunithmmusicdifficulty.FieldDiff1Const:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiff1Const(v)
-		return nil
-	case chunithmmusicdifficulty.FieldDiff2Const:
-		v, ok := value.(float64)
+	case chunithmversion.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDiff2Const(v)
+		m.SetName(v)
 		return nil
-	case chunithmmusicdifficulty.FieldDiff3Const:
-		v, ok := value.(float64)
+	case chunithmversion.FieldReleaseOrder:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDiff3Const(v)
+		m.SetReleaseOrder(v)
 		return nil
-	case chunithmmusicdifficulty.FieldDiff4Const:
-		v, ok := value.(float64)
+	case chunithmversion.FieldReleaseDate:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDiff4Const(v)
+		m.SetReleaseDate(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmMusicDifficulty field %s", name)
+	return fmt.Errorf("unknown ChunithmVersion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChunithmMusicDifficultyMutation) AddedFields() []string {
+func (m *ChunithmVersionMutation) AddedFields() []string {
 	var fields []string
-	if m.addmusic_id != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldMusicID)
-	}
-	if m.adddiff0_const != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff0Const)
-	}
-	if m.adddiff1_const != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff1Const)
-	}
-	if m.adddiff2_const != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff2Const)
-	}
-	if m.adddiff3_const != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff3Const)
-	}
-	if m.adddiff4_const != nil {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff4Const)
+	if m.addrelease_order != nil {
+		fields = append(fields, chunithmversion.FieldReleaseOrder)
 	}
 	return fields
 }
@@ -2880,20 +4320,10 @@ func (m *ChunithmMusicDifficultyMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChunithmMusicDifficultyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChunithmVersionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case chunithmmusicdifficulty.FieldMusicID:
-		return m.AddedMusicID()
-	case chunithmmusicdifficulty.FieldDiff0Const:
-		return m.AddedDiff0Const()
-	case chunithmmusicdifficulty.FieldDiff1Const:
-		return m.AddedDiff1Const()
-	case chunithmmusicdifficulty.FieldDiff2Const:
-		return m.AddedDiff2Const()
-	case chunithmmusicdifficulty.FieldDiff3Const:
-		return m.AddedDiff3Const()
-	case chunithmmusicdifficulty.FieldDiff4Const:
-		return m.AddedDiff4Const()
+	case chunithmversion.FieldReleaseOrder:
+		return m.AddedReleaseOrder()
 	}
 	return nil, false
 }
@@ -2901,179 +4331,117 @@ func (m *ChunithmMusicDifficultyMutation) AddedField(name string) (ent.Value, bo
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChunithmMusicDifficultyMutation) AddField(name string, value ent.Value) error {
+func (m *ChunithmVersionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case chunithmmusicdifficulty.FieldMusicID:
+	case chunithmversion.FieldReleaseOrder:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMusicID(v)
-		return nil
-	case chunithmmusicdifficulty.FieldDiff0Const:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiff0Const(v)
-		return nil
-	case chunithmmusicdifficulty.FieldDiff1Const:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiff1Const(v)
-		return nil
-	case chunithmmusicdifficulty.FieldDiff2Const:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiff2Const(v)
-		return nil
-	case chunithmmusicdifficulty.FieldDiff3Const:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiff3Const(v)
-		return nil
-	case chunithmmusicdifficulty.FieldDiff4Const:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiff4Const(v)
+		m.AddReleaseOrder(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmMusicDifficulty numeric field %s", name)
+	return fmt.Errorf("unknown ChunithmVersion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChunithmMusicDifficultyMutation) ClearedFields() []string {
+func (m *ChunithmVersionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(chunithmmusicdifficulty.FieldDiff0Const) {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff0Const)
-	}
-	if m.FieldCleared(chunithmmusicdifficulty.FieldDiff1Const) {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff1Const)
-	}
-	if m.FieldCleared(chunithmmusicdifficulty.FieldDiff2Const) {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff2Const)
-	}
-	if m.FieldCleared(chunithmmusicdifficulty.FieldDiff3Const) {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff3Const)
+	if m.FieldCleared(chunithmversion.FieldName) {
+		fields = append(fields, chunithmversion.FieldName)
 	}
-	if m.FieldCleared(chunithmmusicdifficulty.FieldDiff4Const) {
-		fields = append(fields, chunithmmusicdifficulty.FieldDiff4Const)
+	if m.FieldCleared(chunithmversion.FieldReleaseDate) {
+		fields = append(fields, chunithmversion.FieldReleaseDate)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChunithmMusicDifficultyMutation) FieldCleared(name string) bool {
+func (m *ChunithmVersionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChunithmMusicDifficultyMutation) ClearField(name string) error {
+func (m *ChunithmVersionMutation) ClearField(name string) error {
 	switch name {
-	case chunithmmusicdifficulty.FieldDiff0Const:
-		m.ClearDiff0Const()
-		return nil
-	case chunithmmusicdifficulty.FieldDiff1Const:
-		m.ClearDiff1Const()
-		return nil
-	case chunithmmusicdifficulty.FieldDiff2Const:
-		m.ClearDiff2Const()
+	case chunithmversion.FieldName:
+		m.ClearName()
 		return nil
-	case chunithmmusicdifficulty.FieldDiff3Const:
-		m.ClearDiff3Const()
-		return nil
-	case chunithmmusicdifficulty.FieldDiff4Const:
-		m.ClearDiff4Const()
+	case chunithmversion.FieldReleaseDate:
+		m.ClearReleaseDate()
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmMusicDifficulty nullable field %s", name)
+	return fmt.Errorf("unknown ChunithmVersion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChunithmMusicDifficultyMutation) ResetField(name string) error {
+func (m *ChunithmVersionMutation) ResetField(name string) error {
 	switch name {
-	case chunithmmusicdifficulty.FieldMusicID:
-		m.ResetMusicID()
-		return nil
-	case chunithmmusicdifficulty.FieldVersion:
+	case chunithmversion.FieldVersion:
 		m.ResetVersion()
 		return nil
-	case chunithmmusicdifficulty.FieldDiff0Const:
-		m.ResetDiff0Const()
-		return nil
-	case chunithmmusicdifficulty.FieldDiff1Const:
-		m.ResetDiff1Const()
-		return nil
-	case chunithmmusicdifficulty.FieldDiff2Const:
-		m.ResetDiff2Const()
+	case chunithmversion.FieldName:
+		m.ResetName()
 		return nil
-	case chunithmmusicdifficulty.FieldDiff3Const:
-		m.ResetDiff3Const()
+	case chunithmversion.FieldReleaseOrder:
+		m.ResetReleaseOrder()
 		return nil
-	case chunithmmusicdifficulty.FieldDiff4Const:
-		m.ResetDiff4Const()
+	case chunithmversion.FieldReleaseDate:
+		m.ResetReleaseDate()
 		return nil
 	}
-	return fmt.Errorf("unknown ChunithmMusicDifficulty field %s", name)
+	return fmt.Errorf("unknown ChunithmVersion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChunithmMusicDifficultyMutation) AddedEdges() []string {
+func (m *ChunithmVersionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChunithmMusicDifficultyMutation) AddedIDs(name string) []ent.Value {
+func (m *ChunithmVersionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChunithmMusicDifficultyMutation) RemovedEdges() []string {
+func (m *ChunithmVersionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChunithmMusicDifficultyMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChunithmVersionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChunithmMusicDifficultyMutation) ClearedEdges() []string {
+func (m *ChunithmVersionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChunithmMusicDifficultyMutation) EdgeCleared(name string) bool {
+func (m *ChunithmVersionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChunithmMusicDifficultyMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ChunithmMusicDifficulty unique edge %s", name)
+func (m *ChunithmVersionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmVersion unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChunithmMusicDifficultyMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ChunithmMusicDifficulty edge %s", name)
+func (m *ChunithmVersionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ChunithmVersion edge %s", name)
 }
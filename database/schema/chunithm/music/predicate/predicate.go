@@ -12,5 +12,11 @@ type ChunithmChartData func(*sql.Selector)
 // ChunithmMusic is the predicate function for chunithmmusic builders.
 type ChunithmMusic func(*sql.Selector)
 
+// ChunithmMusicAvailability is the predicate function for chunithmmusicavailability builders.
+type ChunithmMusicAvailability func(*sql.Selector)
+
 // ChunithmMusicDifficulty is the predicate function for chunithmmusicdifficulty builders.
 type ChunithmMusicDifficulty func(*sql.Selector)
+
+// ChunithmVersion is the predicate function for chunithmversion builders.
+type ChunithmVersion func(*sql.Selector)
@@ -5,7 +5,9 @@ package music
 import (
 	"haruki-database/database/schema/chunithm/music/chunithmchartdata"
 	"haruki-database/database/schema/chunithm/music/chunithmmusic"
+	"haruki-database/database/schema/chunithm/music/chunithmmusicavailability"
 	"haruki-database/database/schema/chunithm/music/chunithmmusicdifficulty"
+	"haruki-database/database/schema/chunithm/music/chunithmversion"
 	"haruki-database/entsrc/schema/chunithm/music/schema"
 )
 
@@ -45,10 +47,38 @@ func init() {
 	chunithmmusicDescDeletedVersion := chunithmmusicFields[7].Descriptor()
 	// chunithmmusic.DeletedVersionValidator is a validator for the "deleted_version" field. It is called by the builders before save.
 	chunithmmusic.DeletedVersionValidator = chunithmmusicDescDeletedVersion.Validators[0].(func(string) error)
+	chunithmmusicavailabilityFields := schema.ChunithmMusicAvailability{}.Fields()
+	_ = chunithmmusicavailabilityFields
+	// chunithmmusicavailabilityDescServer is the schema descriptor for server field.
+	chunithmmusicavailabilityDescServer := chunithmmusicavailabilityFields[1].Descriptor()
+	// chunithmmusicavailability.ServerValidator is a validator for the "server" field. It is called by the builders before save.
+	chunithmmusicavailability.ServerValidator = chunithmmusicavailabilityDescServer.Validators[0].(func(string) error)
+	// chunithmmusicavailabilityDescReleaseVersion is the schema descriptor for release_version field.
+	chunithmmusicavailabilityDescReleaseVersion := chunithmmusicavailabilityFields[2].Descriptor()
+	// chunithmmusicavailability.ReleaseVersionValidator is a validator for the "release_version" field. It is called by the builders before save.
+	chunithmmusicavailability.ReleaseVersionValidator = chunithmmusicavailabilityDescReleaseVersion.Validators[0].(func(string) error)
+	// chunithmmusicavailabilityDescIsDeleted is the schema descriptor for is_deleted field.
+	chunithmmusicavailabilityDescIsDeleted := chunithmmusicavailabilityFields[4].Descriptor()
+	// chunithmmusicavailability.DefaultIsDeleted holds the default value on creation for the is_deleted field.
+	chunithmmusicavailability.DefaultIsDeleted = chunithmmusicavailabilityDescIsDeleted.Default.(bool)
+	// chunithmmusicavailabilityDescDeletedVersion is the schema descriptor for deleted_version field.
+	chunithmmusicavailabilityDescDeletedVersion := chunithmmusicavailabilityFields[5].Descriptor()
+	// chunithmmusicavailability.DeletedVersionValidator is a validator for the "deleted_version" field. It is called by the builders before save.
+	chunithmmusicavailability.DeletedVersionValidator = chunithmmusicavailabilityDescDeletedVersion.Validators[0].(func(string) error)
 	chunithmmusicdifficultyFields := schema.ChunithmMusicDifficulty{}.Fields()
 	_ = chunithmmusicdifficultyFields
 	// chunithmmusicdifficultyDescVersion is the schema descriptor for version field.
 	chunithmmusicdifficultyDescVersion := chunithmmusicdifficultyFields[1].Descriptor()
 	// chunithmmusicdifficulty.VersionValidator is a validator for the "version" field. It is called by the builders before save.
 	chunithmmusicdifficulty.VersionValidator = chunithmmusicdifficultyDescVersion.Validators[0].(func(string) error)
+	chunithmversionFields := schema.ChunithmVersion{}.Fields()
+	_ = chunithmversionFields
+	// chunithmversionDescVersion is the schema descriptor for version field.
+	chunithmversionDescVersion := chunithmversionFields[0].Descriptor()
+	// chunithmversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	chunithmversion.VersionValidator = chunithmversionDescVersion.Validators[0].(func(string) error)
+	// chunithmversionDescName is the schema descriptor for name field.
+	chunithmversionDescName := chunithmversionFields[1].Descriptor()
+	// chunithmversion.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	chunithmversion.NameValidator = chunithmversionDescName.Validators[0].(func(string) error)
 }
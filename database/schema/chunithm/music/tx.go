@@ -16,8 +16,12 @@ type Tx struct {
 	ChunithmChartData *ChunithmChartDataClient
 	// ChunithmMusic is the client for interacting with the ChunithmMusic builders.
 	ChunithmMusic *ChunithmMusicClient
+	// ChunithmMusicAvailability is the client for interacting with the ChunithmMusicAvailability builders.
+	ChunithmMusicAvailability *ChunithmMusicAvailabilityClient
 	// ChunithmMusicDifficulty is the client for interacting with the ChunithmMusicDifficulty builders.
 	ChunithmMusicDifficulty *ChunithmMusicDifficultyClient
+	// ChunithmVersion is the client for interacting with the ChunithmVersion builders.
+	ChunithmVersion *ChunithmVersionClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,7 +155,9 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.ChunithmChartData = NewChunithmChartDataClient(tx.config)
 	tx.ChunithmMusic = NewChunithmMusicClient(tx.config)
+	tx.ChunithmMusicAvailability = NewChunithmMusicAvailabilityClient(tx.config)
 	tx.ChunithmMusicDifficulty = NewChunithmMusicDifficultyClient(tx.config)
+	tx.ChunithmVersion = NewChunithmVersionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"haruki-database/database/schema/pjsk/alias"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +18,7 @@ type AliasCreate struct {
 	config
 	mutation *AliasMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetAliasType sets the "alias_type" field.
@@ -124,6 +126,7 @@ func (_c *AliasCreate) createSpec() (*Alias, *sqlgraph.CreateSpec) {
 		_node = &Alias{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(alias.Table, sqlgraph.NewFieldSpec(alias.FieldID, field.TypeInt64))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -143,11 +146,233 @@ func (_c *AliasCreate) createSpec() (*Alias, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Alias.Create().
+//		SetAliasType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AliasUpsert) {
+//			SetAliasType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AliasCreate) OnConflict(opts ...sql.ConflictOption) *AliasUpsertOne {
+	_c.conflict = opts
+	return &AliasUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Alias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AliasCreate) OnConflictColumns(columns ...string) *AliasUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AliasUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AliasUpsertOne is the builder for "upsert"-ing
+	//  one Alias node.
+	AliasUpsertOne struct {
+		create *AliasCreate
+	}
+
+	// AliasUpsert is the "OnConflict" setter.
+	AliasUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAliasType sets the "alias_type" field.
+func (u *AliasUpsert) SetAliasType(v string) *AliasUpsert {
+	u.Set(alias.FieldAliasType, v)
+	return u
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *AliasUpsert) UpdateAliasType() *AliasUpsert {
+	u.SetExcluded(alias.FieldAliasType)
+	return u
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *AliasUpsert) SetAliasTypeID(v int) *AliasUpsert {
+	u.Set(alias.FieldAliasTypeID, v)
+	return u
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *AliasUpsert) UpdateAliasTypeID() *AliasUpsert {
+	u.SetExcluded(alias.FieldAliasTypeID)
+	return u
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *AliasUpsert) AddAliasTypeID(v int) *AliasUpsert {
+	u.Add(alias.FieldAliasTypeID, v)
+	return u
+}
+
+// SetAlias sets the "alias" field.
+func (u *AliasUpsert) SetAlias(v string) *AliasUpsert {
+	u.Set(alias.FieldAlias, v)
+	return u
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *AliasUpsert) UpdateAlias() *AliasUpsert {
+	u.SetExcluded(alias.FieldAlias)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Alias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(alias.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AliasUpsertOne) UpdateNewValues() *AliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(alias.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Alias.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AliasUpsertOne) Ignore() *AliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AliasUpsertOne) DoNothing() *AliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AliasCreate.OnConflict
+// documentation for more info.
+func (u *AliasUpsertOne) Update(set func(*AliasUpsert)) *AliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAliasType sets the "alias_type" field.
+func (u *AliasUpsertOne) SetAliasType(v string) *AliasUpsertOne {
+	return u.Update(func(s *AliasUpsert) {
+		s.SetAliasType(v)
+	})
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *AliasUpsertOne) UpdateAliasType() *AliasUpsertOne {
+	return u.Update(func(s *AliasUpsert) {
+		s.UpdateAliasType()
+	})
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *AliasUpsertOne) SetAliasTypeID(v int) *AliasUpsertOne {
+	return u.Update(func(s *AliasUpsert) {
+		s.SetAliasTypeID(v)
+	})
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *AliasUpsertOne) AddAliasTypeID(v int) *AliasUpsertOne {
+	return u.Update(func(s *AliasUpsert) {
+		s.AddAliasTypeID(v)
+	})
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *AliasUpsertOne) UpdateAliasTypeID() *AliasUpsertOne {
+	return u.Update(func(s *AliasUpsert) {
+		s.UpdateAliasTypeID()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *AliasUpsertOne) SetAlias(v string) *AliasUpsertOne {
+	return u.Update(func(s *AliasUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *AliasUpsertOne) UpdateAlias() *AliasUpsertOne {
+	return u.Update(func(s *AliasUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// Exec executes the query.
+func (u *AliasUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for AliasCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AliasUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AliasUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AliasUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // AliasCreateBulk is the builder for creating many Alias entities in bulk.
 type AliasCreateBulk struct {
 	config
 	err      error
 	builders []*AliasCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Alias entities in the database.
@@ -176,6 +401,7 @@ func (_c *AliasCreateBulk) Save(ctx context.Context) ([]*Alias, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -229,3 +455,166 @@ func (_c *AliasCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Alias.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AliasUpsert) {
+//			SetAliasType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AliasCreateBulk) OnConflict(opts ...sql.ConflictOption) *AliasUpsertBulk {
+	_c.conflict = opts
+	return &AliasUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Alias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AliasCreateBulk) OnConflictColumns(columns ...string) *AliasUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AliasUpsertBulk{
+		create: _c,
+	}
+}
+
+// AliasUpsertBulk is the builder for "upsert"-ing
+// a bulk of Alias nodes.
+type AliasUpsertBulk struct {
+	create *AliasCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Alias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(alias.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AliasUpsertBulk) UpdateNewValues() *AliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(alias.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Alias.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AliasUpsertBulk) Ignore() *AliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AliasUpsertBulk) DoNothing() *AliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AliasCreateBulk.OnConflict
+// documentation for more info.
+func (u *AliasUpsertBulk) Update(set func(*AliasUpsert)) *AliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAliasType sets the "alias_type" field.
+func (u *AliasUpsertBulk) SetAliasType(v string) *AliasUpsertBulk {
+	return u.Update(func(s *AliasUpsert) {
+		s.SetAliasType(v)
+	})
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *AliasUpsertBulk) UpdateAliasType() *AliasUpsertBulk {
+	return u.Update(func(s *AliasUpsert) {
+		s.UpdateAliasType()
+	})
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *AliasUpsertBulk) SetAliasTypeID(v int) *AliasUpsertBulk {
+	return u.Update(func(s *AliasUpsert) {
+		s.SetAliasTypeID(v)
+	})
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *AliasUpsertBulk) AddAliasTypeID(v int) *AliasUpsertBulk {
+	return u.Update(func(s *AliasUpsert) {
+		s.AddAliasTypeID(v)
+	})
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *AliasUpsertBulk) UpdateAliasTypeID() *AliasUpsertBulk {
+	return u.Update(func(s *AliasUpsert) {
+		s.UpdateAliasTypeID()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *AliasUpsertBulk) SetAlias(v string) *AliasUpsertBulk {
+	return u.Update(func(s *AliasUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *AliasUpsertBulk) UpdateAlias() *AliasUpsertBulk {
+	return u.Update(func(s *AliasUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// Exec executes the query.
+func (u *AliasUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("pjsk: OnConflict was set for builder %d. Set it on the AliasCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for AliasCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AliasUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"haruki-database/database/schema/pjsk/aliasadmin"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +18,7 @@ type AliasAdminCreate struct {
 	config
 	mutation *AliasAdminMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetHarukiUserID sets the "haruki_user_id" field.
@@ -102,6 +104,7 @@ func (_c *AliasAdminCreate) createSpec() (*AliasAdmin, *sqlgraph.CreateSpec) {
 		_node = &AliasAdmin{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(aliasadmin.Table, sqlgraph.NewFieldSpec(aliasadmin.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.HarukiUserID(); ok {
 		_spec.SetField(aliasadmin.FieldHarukiUserID, field.TypeInt, value)
 		_node.HarukiUserID = value
@@ -113,11 +116,199 @@ func (_c *AliasAdminCreate) createSpec() (*AliasAdmin, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AliasAdmin.Create().
+//		SetHarukiUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AliasAdminUpsert) {
+//			SetHarukiUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AliasAdminCreate) OnConflict(opts ...sql.ConflictOption) *AliasAdminUpsertOne {
+	_c.conflict = opts
+	return &AliasAdminUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AliasAdmin.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AliasAdminCreate) OnConflictColumns(columns ...string) *AliasAdminUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AliasAdminUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AliasAdminUpsertOne is the builder for "upsert"-ing
+	//  one AliasAdmin node.
+	AliasAdminUpsertOne struct {
+		create *AliasAdminCreate
+	}
+
+	// AliasAdminUpsert is the "OnConflict" setter.
+	AliasAdminUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *AliasAdminUpsert) SetHarukiUserID(v int) *AliasAdminUpsert {
+	u.Set(aliasadmin.FieldHarukiUserID, v)
+	return u
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *AliasAdminUpsert) UpdateHarukiUserID() *AliasAdminUpsert {
+	u.SetExcluded(aliasadmin.FieldHarukiUserID)
+	return u
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *AliasAdminUpsert) AddHarukiUserID(v int) *AliasAdminUpsert {
+	u.Add(aliasadmin.FieldHarukiUserID, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AliasAdminUpsert) SetName(v string) *AliasAdminUpsert {
+	u.Set(aliasadmin.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AliasAdminUpsert) UpdateName() *AliasAdminUpsert {
+	u.SetExcluded(aliasadmin.FieldName)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AliasAdmin.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AliasAdminUpsertOne) UpdateNewValues() *AliasAdminUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AliasAdmin.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AliasAdminUpsertOne) Ignore() *AliasAdminUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AliasAdminUpsertOne) DoNothing() *AliasAdminUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AliasAdminCreate.OnConflict
+// documentation for more info.
+func (u *AliasAdminUpsertOne) Update(set func(*AliasAdminUpsert)) *AliasAdminUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AliasAdminUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *AliasAdminUpsertOne) SetHarukiUserID(v int) *AliasAdminUpsertOne {
+	return u.Update(func(s *AliasAdminUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *AliasAdminUpsertOne) AddHarukiUserID(v int) *AliasAdminUpsertOne {
+	return u.Update(func(s *AliasAdminUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *AliasAdminUpsertOne) UpdateHarukiUserID() *AliasAdminUpsertOne {
+	return u.Update(func(s *AliasAdminUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AliasAdminUpsertOne) SetName(v string) *AliasAdminUpsertOne {
+	return u.Update(func(s *AliasAdminUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AliasAdminUpsertOne) UpdateName() *AliasAdminUpsertOne {
+	return u.Update(func(s *AliasAdminUpsert) {
+		s.UpdateName()
+	})
+}
+
+// Exec executes the query.
+func (u *AliasAdminUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for AliasAdminCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AliasAdminUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AliasAdminUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AliasAdminUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // AliasAdminCreateBulk is the builder for creating many AliasAdmin entities in bulk.
 type AliasAdminCreateBulk struct {
 	config
 	err      error
 	builders []*AliasAdminCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the AliasAdmin entities in the database.
@@ -146,6 +337,7 @@ func (_c *AliasAdminCreateBulk) Save(ctx context.Context) ([]*AliasAdmin, error)
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -199,3 +391,142 @@ func (_c *AliasAdminCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AliasAdmin.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AliasAdminUpsert) {
+//			SetHarukiUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AliasAdminCreateBulk) OnConflict(opts ...sql.ConflictOption) *AliasAdminUpsertBulk {
+	_c.conflict = opts
+	return &AliasAdminUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AliasAdmin.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AliasAdminCreateBulk) OnConflictColumns(columns ...string) *AliasAdminUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AliasAdminUpsertBulk{
+		create: _c,
+	}
+}
+
+// AliasAdminUpsertBulk is the builder for "upsert"-ing
+// a bulk of AliasAdmin nodes.
+type AliasAdminUpsertBulk struct {
+	create *AliasAdminCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AliasAdmin.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AliasAdminUpsertBulk) UpdateNewValues() *AliasAdminUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AliasAdmin.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AliasAdminUpsertBulk) Ignore() *AliasAdminUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AliasAdminUpsertBulk) DoNothing() *AliasAdminUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AliasAdminCreateBulk.OnConflict
+// documentation for more info.
+func (u *AliasAdminUpsertBulk) Update(set func(*AliasAdminUpsert)) *AliasAdminUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AliasAdminUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *AliasAdminUpsertBulk) SetHarukiUserID(v int) *AliasAdminUpsertBulk {
+	return u.Update(func(s *AliasAdminUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *AliasAdminUpsertBulk) AddHarukiUserID(v int) *AliasAdminUpsertBulk {
+	return u.Update(func(s *AliasAdminUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *AliasAdminUpsertBulk) UpdateHarukiUserID() *AliasAdminUpsertBulk {
+	return u.Update(func(s *AliasAdminUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AliasAdminUpsertBulk) SetName(v string) *AliasAdminUpsertBulk {
+	return u.Update(func(s *AliasAdminUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AliasAdminUpsertBulk) UpdateName() *AliasAdminUpsertBulk {
+	return u.Update(func(s *AliasAdminUpsert) {
+		s.UpdateName()
+	})
+}
+
+// Exec executes the query.
+func (u *AliasAdminUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("pjsk: OnConflict was set for builder %d. Set it on the AliasAdminCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for AliasAdminCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AliasAdminUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"haruki-database/database/schema/pjsk/pendingalias"
 	"haruki-database/database/schema/pjsk/rejectedalias"
 	"haruki-database/database/schema/pjsk/userbinding"
+	"haruki-database/database/schema/pjsk/userbindinglock"
 	"haruki-database/database/schema/pjsk/userdefaultbinding"
 	"haruki-database/database/schema/pjsk/userpreference"
 
@@ -43,6 +44,8 @@ type Client struct {
 	RejectedAlias *RejectedAliasClient
 	// UserBinding is the client for interacting with the UserBinding builders.
 	UserBinding *UserBindingClient
+	// UserBindingLock is the client for interacting with the UserBindingLock builders.
+	UserBindingLock *UserBindingLockClient
 	// UserDefaultBinding is the client for interacting with the UserDefaultBinding builders.
 	UserDefaultBinding *UserDefaultBindingClient
 	// UserPreference is the client for interacting with the UserPreference builders.
@@ -64,6 +67,7 @@ func (c *Client) init() {
 	c.PendingAlias = NewPendingAliasClient(c.config)
 	c.RejectedAlias = NewRejectedAliasClient(c.config)
 	c.UserBinding = NewUserBindingClient(c.config)
+	c.UserBindingLock = NewUserBindingLockClient(c.config)
 	c.UserDefaultBinding = NewUserDefaultBindingClient(c.config)
 	c.UserPreference = NewUserPreferenceClient(c.config)
 }
@@ -164,6 +168,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		PendingAlias:       NewPendingAliasClient(cfg),
 		RejectedAlias:      NewRejectedAliasClient(cfg),
 		UserBinding:        NewUserBindingClient(cfg),
+		UserBindingLock:    NewUserBindingLockClient(cfg),
 		UserDefaultBinding: NewUserDefaultBindingClient(cfg),
 		UserPreference:     NewUserPreferenceClient(cfg),
 	}, nil
@@ -191,6 +196,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		PendingAlias:       NewPendingAliasClient(cfg),
 		RejectedAlias:      NewRejectedAliasClient(cfg),
 		UserBinding:        NewUserBindingClient(cfg),
+		UserBindingLock:    NewUserBindingLockClient(cfg),
 		UserDefaultBinding: NewUserDefaultBindingClient(cfg),
 		UserPreference:     NewUserPreferenceClient(cfg),
 	}, nil
@@ -223,7 +229,7 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.Alias, c.AliasAdmin, c.GroupAlias, c.PendingAlias, c.RejectedAlias,
-		c.UserBinding, c.UserDefaultBinding, c.UserPreference,
+		c.UserBinding, c.UserBindingLock, c.UserDefaultBinding, c.UserPreference,
 	} {
 		n.Use(hooks...)
 	}
@@ -234,7 +240,7 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.Alias, c.AliasAdmin, c.GroupAlias, c.PendingAlias, c.RejectedAlias,
-		c.UserBinding, c.UserDefaultBinding, c.UserPreference,
+		c.UserBinding, c.UserBindingLock, c.UserDefaultBinding, c.UserPreference,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -255,6 +261,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.RejectedAlias.mutate(ctx, m)
 	case *UserBindingMutation:
 		return c.UserBinding.mutate(ctx, m)
+	case *UserBindingLockMutation:
+		return c.UserBindingLock.mutate(ctx, m)
 	case *UserDefaultBindingMutation:
 		return c.UserDefaultBinding.mutate(ctx, m)
 	case *UserPreferenceMutation:
@@ -1078,6 +1086,139 @@ func (c *UserBindingClient) mutate(ctx context.Context, m *UserBindingMutation)
 	}
 }
 
+// UserBindingLockClient is a client for the UserBindingLock schema.
+type UserBindingLockClient struct {
+	config
+}
+
+// NewUserBindingLockClient returns a client for the UserBindingLock from the given config.
+func NewUserBindingLockClient(c config) *UserBindingLockClient {
+	return &UserBindingLockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userbindinglock.Hooks(f(g(h())))`.
+func (c *UserBindingLockClient) Use(hooks ...Hook) {
+	c.hooks.UserBindingLock = append(c.hooks.UserBindingLock, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userbindinglock.Intercept(f(g(h())))`.
+func (c *UserBindingLockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserBindingLock = append(c.inters.UserBindingLock, interceptors...)
+}
+
+// Create returns a builder for creating a UserBindingLock entity.
+func (c *UserBindingLockClient) Create() *UserBindingLockCreate {
+	mutation := newUserBindingLockMutation(c.config, OpCreate)
+	return &UserBindingLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserBindingLock entities.
+func (c *UserBindingLockClient) CreateBulk(builders ...*UserBindingLockCreate) *UserBindingLockCreateBulk {
+	return &UserBindingLockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserBindingLockClient) MapCreateBulk(slice any, setFunc func(*UserBindingLockCreate, int)) *UserBindingLockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserBindingLockCreateBulk{err: fmt.Errorf("calling to UserBindingLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserBindingLockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserBindingLockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserBindingLock.
+func (c *UserBindingLockClient) Update() *UserBindingLockUpdate {
+	mutation := newUserBindingLockMutation(c.config, OpUpdate)
+	return &UserBindingLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserBindingLockClient) UpdateOne(_m *UserBindingLock) *UserBindingLockUpdateOne {
+	mutation := newUserBindingLockMutation(c.config, OpUpdateOne, withUserBindingLock(_m))
+	return &UserBindingLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserBindingLockClient) UpdateOneID(id int) *UserBindingLockUpdateOne {
+	mutation := newUserBindingLockMutation(c.config, OpUpdateOne, withUserBindingLockID(id))
+	return &UserBindingLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserBindingLock.
+func (c *UserBindingLockClient) Delete() *UserBindingLockDelete {
+	mutation := newUserBindingLockMutation(c.config, OpDelete)
+	return &UserBindingLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserBindingLockClient) DeleteOne(_m *UserBindingLock) *UserBindingLockDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserBindingLockClient) DeleteOneID(id int) *UserBindingLockDeleteOne {
+	builder := c.Delete().Where(userbindinglock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserBindingLockDeleteOne{builder}
+}
+
+// Query returns a query builder for UserBindingLock.
+func (c *UserBindingLockClient) Query() *UserBindingLockQuery {
+	return &UserBindingLockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserBindingLock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserBindingLock entity by its id.
+func (c *UserBindingLockClient) Get(ctx context.Context, id int) (*UserBindingLock, error) {
+	return c.Query().Where(userbindinglock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserBindingLockClient) GetX(ctx context.Context, id int) *UserBindingLock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserBindingLockClient) Hooks() []Hook {
+	return c.hooks.UserBindingLock
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserBindingLockClient) Interceptors() []Interceptor {
+	return c.inters.UserBindingLock
+}
+
+func (c *UserBindingLockClient) mutate(ctx context.Context, m *UserBindingLockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserBindingLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserBindingLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserBindingLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserBindingLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("pjsk: unknown UserBindingLock mutation op: %q", m.Op())
+	}
+}
+
 // UserDefaultBindingClient is a client for the UserDefaultBinding schema.
 type UserDefaultBindingClient struct {
 	config
@@ -1364,10 +1505,10 @@ func (c *UserPreferenceClient) mutate(ctx context.Context, m *UserPreferenceMuta
 type (
 	hooks struct {
 		Alias, AliasAdmin, GroupAlias, PendingAlias, RejectedAlias, UserBinding,
-		UserDefaultBinding, UserPreference []ent.Hook
+		UserBindingLock, UserDefaultBinding, UserPreference []ent.Hook
 	}
 	inters struct {
 		Alias, AliasAdmin, GroupAlias, PendingAlias, RejectedAlias, UserBinding,
-		UserDefaultBinding, UserPreference []ent.Interceptor
+		UserBindingLock, UserDefaultBinding, UserPreference []ent.Interceptor
 	}
 )
@@ -12,6 +12,7 @@ import (
 	"haruki-database/database/schema/pjsk/pendingalias"
 	"haruki-database/database/schema/pjsk/rejectedalias"
 	"haruki-database/database/schema/pjsk/userbinding"
+	"haruki-database/database/schema/pjsk/userbindinglock"
 	"haruki-database/database/schema/pjsk/userdefaultbinding"
 	"haruki-database/database/schema/pjsk/userpreference"
 	"reflect"
@@ -86,6 +87,7 @@ func checkColumn(t, c string) error {
 			pendingalias.Table:       pendingalias.ValidColumn,
 			rejectedalias.Table:      rejectedalias.ValidColumn,
 			userbinding.Table:        userbinding.ValidColumn,
+			userbindinglock.Table:    userbindinglock.ValidColumn,
 			userdefaultbinding.Table: userdefaultbinding.ValidColumn,
 			userpreference.Table:     userpreference.ValidColumn,
 		})
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"haruki-database/database/schema/pjsk/groupalias"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +18,7 @@ type GroupAliasCreate struct {
 	config
 	mutation *GroupAliasMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetPlatform sets the "platform" field.
@@ -144,6 +146,7 @@ func (_c *GroupAliasCreate) createSpec() (*GroupAlias, *sqlgraph.CreateSpec) {
 		_node = &GroupAlias{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(groupalias.Table, sqlgraph.NewFieldSpec(groupalias.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.Platform(); ok {
 		_spec.SetField(groupalias.FieldPlatform, field.TypeString, value)
 		_node.Platform = value
@@ -167,11 +170,277 @@ func (_c *GroupAliasCreate) createSpec() (*GroupAlias, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupAlias.Create().
+//		SetPlatform(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupAliasUpsert) {
+//			SetPlatform(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GroupAliasCreate) OnConflict(opts ...sql.ConflictOption) *GroupAliasUpsertOne {
+	_c.conflict = opts
+	return &GroupAliasUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GroupAliasCreate) OnConflictColumns(columns ...string) *GroupAliasUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GroupAliasUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GroupAliasUpsertOne is the builder for "upsert"-ing
+	//  one GroupAlias node.
+	GroupAliasUpsertOne struct {
+		create *GroupAliasCreate
+	}
+
+	// GroupAliasUpsert is the "OnConflict" setter.
+	GroupAliasUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlatform sets the "platform" field.
+func (u *GroupAliasUpsert) SetPlatform(v string) *GroupAliasUpsert {
+	u.Set(groupalias.FieldPlatform, v)
+	return u
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *GroupAliasUpsert) UpdatePlatform() *GroupAliasUpsert {
+	u.SetExcluded(groupalias.FieldPlatform)
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupAliasUpsert) SetGroupID(v string) *GroupAliasUpsert {
+	u.Set(groupalias.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupAliasUpsert) UpdateGroupID() *GroupAliasUpsert {
+	u.SetExcluded(groupalias.FieldGroupID)
+	return u
+}
+
+// SetAliasType sets the "alias_type" field.
+func (u *GroupAliasUpsert) SetAliasType(v string) *GroupAliasUpsert {
+	u.Set(groupalias.FieldAliasType, v)
+	return u
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *GroupAliasUpsert) UpdateAliasType() *GroupAliasUpsert {
+	u.SetExcluded(groupalias.FieldAliasType)
+	return u
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *GroupAliasUpsert) SetAliasTypeID(v int) *GroupAliasUpsert {
+	u.Set(groupalias.FieldAliasTypeID, v)
+	return u
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *GroupAliasUpsert) UpdateAliasTypeID() *GroupAliasUpsert {
+	u.SetExcluded(groupalias.FieldAliasTypeID)
+	return u
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *GroupAliasUpsert) AddAliasTypeID(v int) *GroupAliasUpsert {
+	u.Add(groupalias.FieldAliasTypeID, v)
+	return u
+}
+
+// SetAlias sets the "alias" field.
+func (u *GroupAliasUpsert) SetAlias(v string) *GroupAliasUpsert {
+	u.Set(groupalias.FieldAlias, v)
+	return u
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *GroupAliasUpsert) UpdateAlias() *GroupAliasUpsert {
+	u.SetExcluded(groupalias.FieldAlias)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.GroupAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GroupAliasUpsertOne) UpdateNewValues() *GroupAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupAlias.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GroupAliasUpsertOne) Ignore() *GroupAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupAliasUpsertOne) DoNothing() *GroupAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupAliasCreate.OnConflict
+// documentation for more info.
+func (u *GroupAliasUpsertOne) Update(set func(*GroupAliasUpsert)) *GroupAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlatform sets the "platform" field.
+func (u *GroupAliasUpsertOne) SetPlatform(v string) *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *GroupAliasUpsertOne) UpdatePlatform() *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupAliasUpsertOne) SetGroupID(v string) *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupAliasUpsertOne) UpdateGroupID() *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetAliasType sets the "alias_type" field.
+func (u *GroupAliasUpsertOne) SetAliasType(v string) *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.SetAliasType(v)
+	})
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *GroupAliasUpsertOne) UpdateAliasType() *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.UpdateAliasType()
+	})
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *GroupAliasUpsertOne) SetAliasTypeID(v int) *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.SetAliasTypeID(v)
+	})
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *GroupAliasUpsertOne) AddAliasTypeID(v int) *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.AddAliasTypeID(v)
+	})
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *GroupAliasUpsertOne) UpdateAliasTypeID() *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.UpdateAliasTypeID()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *GroupAliasUpsertOne) SetAlias(v string) *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *GroupAliasUpsertOne) UpdateAlias() *GroupAliasUpsertOne {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupAliasUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for GroupAliasCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupAliasUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GroupAliasUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GroupAliasUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // GroupAliasCreateBulk is the builder for creating many GroupAlias entities in bulk.
 type GroupAliasCreateBulk struct {
 	config
 	err      error
 	builders []*GroupAliasCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the GroupAlias entities in the database.
@@ -200,6 +469,7 @@ func (_c *GroupAliasCreateBulk) Save(ctx context.Context) ([]*GroupAlias, error)
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -253,3 +523,184 @@ func (_c *GroupAliasCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupAlias.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupAliasUpsert) {
+//			SetPlatform(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GroupAliasCreateBulk) OnConflict(opts ...sql.ConflictOption) *GroupAliasUpsertBulk {
+	_c.conflict = opts
+	return &GroupAliasUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GroupAliasCreateBulk) OnConflictColumns(columns ...string) *GroupAliasUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GroupAliasUpsertBulk{
+		create: _c,
+	}
+}
+
+// GroupAliasUpsertBulk is the builder for "upsert"-ing
+// a bulk of GroupAlias nodes.
+type GroupAliasUpsertBulk struct {
+	create *GroupAliasCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GroupAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GroupAliasUpsertBulk) UpdateNewValues() *GroupAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupAlias.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GroupAliasUpsertBulk) Ignore() *GroupAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupAliasUpsertBulk) DoNothing() *GroupAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupAliasCreateBulk.OnConflict
+// documentation for more info.
+func (u *GroupAliasUpsertBulk) Update(set func(*GroupAliasUpsert)) *GroupAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlatform sets the "platform" field.
+func (u *GroupAliasUpsertBulk) SetPlatform(v string) *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *GroupAliasUpsertBulk) UpdatePlatform() *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupAliasUpsertBulk) SetGroupID(v string) *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupAliasUpsertBulk) UpdateGroupID() *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetAliasType sets the "alias_type" field.
+func (u *GroupAliasUpsertBulk) SetAliasType(v string) *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.SetAliasType(v)
+	})
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *GroupAliasUpsertBulk) UpdateAliasType() *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.UpdateAliasType()
+	})
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *GroupAliasUpsertBulk) SetAliasTypeID(v int) *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.SetAliasTypeID(v)
+	})
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *GroupAliasUpsertBulk) AddAliasTypeID(v int) *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.AddAliasTypeID(v)
+	})
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *GroupAliasUpsertBulk) UpdateAliasTypeID() *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.UpdateAliasTypeID()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *GroupAliasUpsertBulk) SetAlias(v string) *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *GroupAliasUpsertBulk) UpdateAlias() *GroupAliasUpsertBulk {
+	return u.Update(func(s *GroupAliasUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupAliasUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("pjsk: OnConflict was set for builder %d. Set it on the GroupAliasCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for GroupAliasCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupAliasUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
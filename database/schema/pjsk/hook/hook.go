@@ -80,6 +80,18 @@ func (f UserBindingFunc) Mutate(ctx context.Context, m pjsk.Mutation) (pjsk.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *pjsk.UserBindingMutation", m)
 }
 
+// The UserBindingLockFunc type is an adapter to allow the use of ordinary
+// function as UserBindingLock mutator.
+type UserBindingLockFunc func(context.Context, *pjsk.UserBindingLockMutation) (pjsk.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserBindingLockFunc) Mutate(ctx context.Context, m pjsk.Mutation) (pjsk.Value, error) {
+	if mv, ok := m.(*pjsk.UserBindingLockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *pjsk.UserBindingLockMutation", m)
+}
+
 // The UserDefaultBindingFunc type is an adapter to allow the use of ordinary
 // function as UserDefaultBinding mutator.
 type UserDefaultBindingFunc func(context.Context, *pjsk.UserDefaultBindingMutation) (pjsk.Value, error)
@@ -20,6 +20,13 @@ var (
 		Name:       "alias",
 		Columns:    AliasColumns,
 		PrimaryKey: []*schema.Column{AliasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "alias_alias_type_alias_type_id_alias",
+				Unique:  true,
+				Columns: []*schema.Column{AliasColumns[1], AliasColumns[2], AliasColumns[3]},
+			},
+		},
 	}
 	// AliasAdminsColumns holds the columns for the "alias_admins" table.
 	AliasAdminsColumns = []*schema.Column{
@@ -69,6 +76,13 @@ var (
 		Name:       "pending_alias",
 		Columns:    PendingAliasColumns,
 		PrimaryKey: []*schema.Column{PendingAliasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pendingalias_alias_type_alias_type_id_alias",
+				Unique:  true,
+				Columns: []*schema.Column{PendingAliasColumns[1], PendingAliasColumns[2], PendingAliasColumns[3]},
+			},
+		},
 	}
 	// RejectedAliasColumns holds the columns for the "rejected_alias" table.
 	RejectedAliasColumns = []*schema.Column{
@@ -93,6 +107,10 @@ var (
 		{Name: "user_id", Type: field.TypeString, Size: 30},
 		{Name: "server", Type: field.TypeString, Size: 2},
 		{Name: "visible", Type: field.TypeBool, Default: true},
+		{Name: "verified", Type: field.TypeBool, Default: false},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "label", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
 	}
 	// UserBindingsTable holds the schema information for the "user_bindings" table.
 	UserBindingsTable = &schema.Table{
@@ -107,6 +125,25 @@ var (
 			},
 		},
 	}
+	// UserBindingLocksColumns holds the columns for the "user_binding_locks" table.
+	UserBindingLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "haruki_user_id", Type: field.TypeInt},
+		{Name: "locked_at", Type: field.TypeTime},
+	}
+	// UserBindingLocksTable holds the schema information for the "user_binding_locks" table.
+	UserBindingLocksTable = &schema.Table{
+		Name:       "user_binding_locks",
+		Columns:    UserBindingLocksColumns,
+		PrimaryKey: []*schema.Column{UserBindingLocksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userbindinglock_haruki_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserBindingLocksColumns[1]},
+			},
+		},
+	}
 	// UserDefaultBindingsColumns holds the columns for the "user_default_bindings" table.
 	UserDefaultBindingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -124,7 +161,7 @@ var (
 				Symbol:     "user_default_bindings_user_bindings_default_refs",
 				Columns:    []*schema.Column{UserDefaultBindingsColumns[3]},
 				RefColumns: []*schema.Column{UserBindingsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
@@ -163,6 +200,7 @@ var (
 		PendingAliasTable,
 		RejectedAliasTable,
 		UserBindingsTable,
+		UserBindingLocksTable,
 		UserDefaultBindingsTable,
 		UserPreferencesTable,
 	}
@@ -13,6 +13,7 @@ import (
 	"haruki-database/database/schema/pjsk/predicate"
 	"haruki-database/database/schema/pjsk/rejectedalias"
 	"haruki-database/database/schema/pjsk/userbinding"
+	"haruki-database/database/schema/pjsk/userbindinglock"
 	"haruki-database/database/schema/pjsk/userdefaultbinding"
 	"haruki-database/database/schema/pjsk/userpreference"
 	"sync"
@@ -37,6 +38,7 @@ const (
 	TypePendingAlias       = "PendingAlias"
 	TypeRejectedAlias      = "RejectedAlias"
 	TypeUserBinding        = "UserBinding"
+	TypeUserBindingLock    = "UserBindingLock"
 	TypeUserDefaultBinding = "UserDefaultBinding"
 	TypeUserPreference     = "UserPreference"
 )
@@ -2744,6 +2746,11 @@ type UserBindingMutation struct {
 	user_id             *string
 	server              *string
 	visible             *bool
+	verified            *bool
+	verified_at         *time.Time
+	label               *string
+	sort_order          *int
+	addsort_order       *int
 	clearedFields       map[string]struct{}
 	default_refs        map[int]struct{}
 	removeddefault_refs map[int]struct{}
@@ -3021,6 +3028,196 @@ func (m *UserBindingMutation) ResetVisible() {
 	m.visible = nil
 }
 
+// SetVerified sets the "verified" field.
+func (m *UserBindingMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *UserBindingMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the UserBinding entity.
+// If the UserBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserBindingMutation) OldVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *UserBindingMutation) ResetVerified() {
+	m.verified = nil
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (m *UserBindingMutation) SetVerifiedAt(t time.Time) {
+	m.verified_at = &t
+}
+
+// VerifiedAt returns the value of the "verified_at" field in the mutation.
+func (m *UserBindingMutation) VerifiedAt() (r time.Time, exists bool) {
+	v := m.verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifiedAt returns the old "verified_at" field's value of the UserBinding entity.
+// If the UserBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserBindingMutation) OldVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifiedAt: %w", err)
+	}
+	return oldValue.VerifiedAt, nil
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (m *UserBindingMutation) ClearVerifiedAt() {
+	m.verified_at = nil
+	m.clearedFields[userbinding.FieldVerifiedAt] = struct{}{}
+}
+
+// VerifiedAtCleared returns if the "verified_at" field was cleared in this mutation.
+func (m *UserBindingMutation) VerifiedAtCleared() bool {
+	_, ok := m.clearedFields[userbinding.FieldVerifiedAt]
+	return ok
+}
+
+// ResetVerifiedAt resets all changes to the "verified_at" field.
+func (m *UserBindingMutation) ResetVerifiedAt() {
+	m.verified_at = nil
+	delete(m.clearedFields, userbinding.FieldVerifiedAt)
+}
+
+// SetLabel sets the "label" field.
+func (m *UserBindingMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *UserBindingMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the UserBinding entity.
+// If the UserBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserBindingMutation) OldLabel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *UserBindingMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[userbinding.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *UserBindingMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[userbinding.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *UserBindingMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, userbinding.FieldLabel)
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (m *UserBindingMutation) SetSortOrder(i int) {
+	m.sort_order = &i
+	m.addsort_order = nil
+}
+
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *UserBindingMutation) SortOrder() (r int, exists bool) {
+	v := m.sort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSortOrder returns the old "sort_order" field's value of the UserBinding entity.
+// If the UserBinding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserBindingMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
+	}
+	return oldValue.SortOrder, nil
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (m *UserBindingMutation) AddSortOrder(i int) {
+	if m.addsort_order != nil {
+		*m.addsort_order += i
+	} else {
+		m.addsort_order = &i
+	}
+}
+
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *UserBindingMutation) AddedSortOrder() (r int, exists bool) {
+	v := m.addsort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *UserBindingMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
+}
+
 // AddDefaultRefIDs adds the "default_refs" edge to the UserDefaultBinding entity by ids.
 func (m *UserBindingMutation) AddDefaultRefIDs(ids ...int) {
 	if m.default_refs == nil {
@@ -3109,7 +3306,7 @@ func (m *UserBindingMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserBindingMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 8)
 	if m.haruki_user_id != nil {
 		fields = append(fields, userbinding.FieldHarukiUserID)
 	}
@@ -3122,6 +3319,18 @@ func (m *UserBindingMutation) Fields() []string {
 	if m.visible != nil {
 		fields = append(fields, userbinding.FieldVisible)
 	}
+	if m.verified != nil {
+		fields = append(fields, userbinding.FieldVerified)
+	}
+	if m.verified_at != nil {
+		fields = append(fields, userbinding.FieldVerifiedAt)
+	}
+	if m.label != nil {
+		fields = append(fields, userbinding.FieldLabel)
+	}
+	if m.sort_order != nil {
+		fields = append(fields, userbinding.FieldSortOrder)
+	}
 	return fields
 }
 
@@ -3138,6 +3347,14 @@ func (m *UserBindingMutation) Field(name string) (ent.Value, bool) {
 		return m.Server()
 	case userbinding.FieldVisible:
 		return m.Visible()
+	case userbinding.FieldVerified:
+		return m.Verified()
+	case userbinding.FieldVerifiedAt:
+		return m.VerifiedAt()
+	case userbinding.FieldLabel:
+		return m.Label()
+	case userbinding.FieldSortOrder:
+		return m.SortOrder()
 	}
 	return nil, false
 }
@@ -3155,6 +3372,14 @@ func (m *UserBindingMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldServer(ctx)
 	case userbinding.FieldVisible:
 		return m.OldVisible(ctx)
+	case userbinding.FieldVerified:
+		return m.OldVerified(ctx)
+	case userbinding.FieldVerifiedAt:
+		return m.OldVerifiedAt(ctx)
+	case userbinding.FieldLabel:
+		return m.OldLabel(ctx)
+	case userbinding.FieldSortOrder:
+		return m.OldSortOrder(ctx)
 	}
 	return nil, fmt.Errorf("unknown UserBinding field %s", name)
 }
@@ -3192,6 +3417,34 @@ func (m *UserBindingMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetVisible(v)
 		return nil
+	case userbinding.FieldVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerified(v)
+		return nil
+	case userbinding.FieldVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifiedAt(v)
+		return nil
+	case userbinding.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case userbinding.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortOrder(v)
+		return nil
 	}
 	return fmt.Errorf("unknown UserBinding field %s", name)
 }
@@ -3203,6 +3456,9 @@ func (m *UserBindingMutation) AddedFields() []string {
 	if m.addharuki_user_id != nil {
 		fields = append(fields, userbinding.FieldHarukiUserID)
 	}
+	if m.addsort_order != nil {
+		fields = append(fields, userbinding.FieldSortOrder)
+	}
 	return fields
 }
 
@@ -3213,6 +3469,8 @@ func (m *UserBindingMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case userbinding.FieldHarukiUserID:
 		return m.AddedHarukiUserID()
+	case userbinding.FieldSortOrder:
+		return m.AddedSortOrder()
 	}
 	return nil, false
 }
@@ -3229,6 +3487,13 @@ func (m *UserBindingMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddHarukiUserID(v)
 		return nil
+	case userbinding.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
 	}
 	return fmt.Errorf("unknown UserBinding numeric field %s", name)
 }
@@ -3236,7 +3501,14 @@ func (m *UserBindingMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *UserBindingMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(userbinding.FieldVerifiedAt) {
+		fields = append(fields, userbinding.FieldVerifiedAt)
+	}
+	if m.FieldCleared(userbinding.FieldLabel) {
+		fields = append(fields, userbinding.FieldLabel)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -3249,6 +3521,14 @@ func (m *UserBindingMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *UserBindingMutation) ClearField(name string) error {
+	switch name {
+	case userbinding.FieldVerifiedAt:
+		m.ClearVerifiedAt()
+		return nil
+	case userbinding.FieldLabel:
+		m.ClearLabel()
+		return nil
+	}
 	return fmt.Errorf("unknown UserBinding nullable field %s", name)
 }
 
@@ -3268,6 +3548,18 @@ func (m *UserBindingMutation) ResetField(name string) error {
 	case userbinding.FieldVisible:
 		m.ResetVisible()
 		return nil
+	case userbinding.FieldVerified:
+		m.ResetVerified()
+		return nil
+	case userbinding.FieldVerifiedAt:
+		m.ResetVerifiedAt()
+		return nil
+	case userbinding.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case userbinding.FieldSortOrder:
+		m.ResetSortOrder()
+		return nil
 	}
 	return fmt.Errorf("unknown UserBinding field %s", name)
 }
@@ -3356,6 +3648,428 @@ func (m *UserBindingMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown UserBinding edge %s", name)
 }
 
+// UserBindingLockMutation represents an operation that mutates the UserBindingLock nodes in the graph.
+type UserBindingLockMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	haruki_user_id    *int
+	addharuki_user_id *int
+	locked_at         *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*UserBindingLock, error)
+	predicates        []predicate.UserBindingLock
+}
+
+var _ ent.Mutation = (*UserBindingLockMutation)(nil)
+
+// userbindinglockOption allows management of the mutation configuration using functional options.
+type userbindinglockOption func(*UserBindingLockMutation)
+
+// newUserBindingLockMutation creates new mutation for the UserBindingLock entity.
+func newUserBindingLockMutation(c config, op Op, opts ...userbindinglockOption) *UserBindingLockMutation {
+	m := &UserBindingLockMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserBindingLock,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserBindingLockID sets the ID field of the mutation.
+func withUserBindingLockID(id int) userbindinglockOption {
+	return func(m *UserBindingLockMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserBindingLock
+		)
+		m.oldValue = func(ctx context.Context) (*UserBindingLock, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserBindingLock.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserBindingLock sets the old UserBindingLock of the mutation.
+func withUserBindingLock(node *UserBindingLock) userbindinglockOption {
+	return func(m *UserBindingLockMutation) {
+		m.oldValue = func(context.Context) (*UserBindingLock, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserBindingLockMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserBindingLockMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("pjsk: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserBindingLock entities.
+func (m *UserBindingLockMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserBindingLockMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserBindingLockMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserBindingLock.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (m *UserBindingLockMutation) SetHarukiUserID(i int) {
+	m.haruki_user_id = &i
+	m.addharuki_user_id = nil
+}
+
+// HarukiUserID returns the value of the "haruki_user_id" field in the mutation.
+func (m *UserBindingLockMutation) HarukiUserID() (r int, exists bool) {
+	v := m.haruki_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHarukiUserID returns the old "haruki_user_id" field's value of the UserBindingLock entity.
+// If the UserBindingLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserBindingLockMutation) OldHarukiUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHarukiUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHarukiUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHarukiUserID: %w", err)
+	}
+	return oldValue.HarukiUserID, nil
+}
+
+// AddHarukiUserID adds i to the "haruki_user_id" field.
+func (m *UserBindingLockMutation) AddHarukiUserID(i int) {
+	if m.addharuki_user_id != nil {
+		*m.addharuki_user_id += i
+	} else {
+		m.addharuki_user_id = &i
+	}
+}
+
+// AddedHarukiUserID returns the value that was added to the "haruki_user_id" field in this mutation.
+func (m *UserBindingLockMutation) AddedHarukiUserID() (r int, exists bool) {
+	v := m.addharuki_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHarukiUserID resets all changes to the "haruki_user_id" field.
+func (m *UserBindingLockMutation) ResetHarukiUserID() {
+	m.haruki_user_id = nil
+	m.addharuki_user_id = nil
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (m *UserBindingLockMutation) SetLockedAt(t time.Time) {
+	m.locked_at = &t
+}
+
+// LockedAt returns the value of the "locked_at" field in the mutation.
+func (m *UserBindingLockMutation) LockedAt() (r time.Time, exists bool) {
+	v := m.locked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedAt returns the old "locked_at" field's value of the UserBindingLock entity.
+// If the UserBindingLock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserBindingLockMutation) OldLockedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedAt: %w", err)
+	}
+	return oldValue.LockedAt, nil
+}
+
+// ResetLockedAt resets all changes to the "locked_at" field.
+func (m *UserBindingLockMutation) ResetLockedAt() {
+	m.locked_at = nil
+}
+
+// Where appends a list predicates to the UserBindingLockMutation builder.
+func (m *UserBindingLockMutation) Where(ps ...predicate.UserBindingLock) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserBindingLockMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserBindingLockMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserBindingLock, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserBindingLockMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserBindingLockMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserBindingLock).
+func (m *UserBindingLockMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserBindingLockMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.haruki_user_id != nil {
+		fields = append(fields, userbindinglock.FieldHarukiUserID)
+	}
+	if m.locked_at != nil {
+		fields = append(fields, userbindinglock.FieldLockedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserBindingLockMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userbindinglock.FieldHarukiUserID:
+		return m.HarukiUserID()
+	case userbindinglock.FieldLockedAt:
+		return m.LockedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserBindingLockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userbindinglock.FieldHarukiUserID:
+		return m.OldHarukiUserID(ctx)
+	case userbindinglock.FieldLockedAt:
+		return m.OldLockedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserBindingLock field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserBindingLockMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userbindinglock.FieldHarukiUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHarukiUserID(v)
+		return nil
+	case userbindinglock.FieldLockedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserBindingLock field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserBindingLockMutation) AddedFields() []string {
+	var fields []string
+	if m.addharuki_user_id != nil {
+		fields = append(fields, userbindinglock.FieldHarukiUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserBindingLockMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case userbindinglock.FieldHarukiUserID:
+		return m.AddedHarukiUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserBindingLockMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case userbindinglock.FieldHarukiUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHarukiUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserBindingLock numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserBindingLockMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserBindingLockMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserBindingLockMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserBindingLock nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserBindingLockMutation) ResetField(name string) error {
+	switch name {
+	case userbindinglock.FieldHarukiUserID:
+		m.ResetHarukiUserID()
+		return nil
+	case userbindinglock.FieldLockedAt:
+		m.ResetLockedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UserBindingLock field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserBindingLockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserBindingLockMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserBindingLockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserBindingLockMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserBindingLockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserBindingLockMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserBindingLockMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserBindingLock unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserBindingLockMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserBindingLock edge %s", name)
+}
+
 // UserDefaultBindingMutation represents an operation that mutates the UserDefaultBinding nodes in the graph.
 type UserDefaultBindingMutation struct {
 	config
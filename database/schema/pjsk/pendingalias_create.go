@@ -9,6 +9,7 @@ import (
 	"haruki-database/database/schema/pjsk/pendingalias"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -18,6 +19,7 @@ type PendingAliasCreate struct {
 	config
 	mutation *PendingAliasMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetAliasType sets the "alias_type" field.
@@ -148,6 +150,7 @@ func (_c *PendingAliasCreate) createSpec() (*PendingAlias, *sqlgraph.CreateSpec)
 		_node = &PendingAlias{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(pendingalias.Table, sqlgraph.NewFieldSpec(pendingalias.FieldID, field.TypeInt64))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -175,11 +178,285 @@ func (_c *PendingAliasCreate) createSpec() (*PendingAlias, *sqlgraph.CreateSpec)
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PendingAlias.Create().
+//		SetAliasType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PendingAliasUpsert) {
+//			SetAliasType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PendingAliasCreate) OnConflict(opts ...sql.ConflictOption) *PendingAliasUpsertOne {
+	_c.conflict = opts
+	return &PendingAliasUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PendingAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PendingAliasCreate) OnConflictColumns(columns ...string) *PendingAliasUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PendingAliasUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PendingAliasUpsertOne is the builder for "upsert"-ing
+	//  one PendingAlias node.
+	PendingAliasUpsertOne struct {
+		create *PendingAliasCreate
+	}
+
+	// PendingAliasUpsert is the "OnConflict" setter.
+	PendingAliasUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAliasType sets the "alias_type" field.
+func (u *PendingAliasUpsert) SetAliasType(v string) *PendingAliasUpsert {
+	u.Set(pendingalias.FieldAliasType, v)
+	return u
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *PendingAliasUpsert) UpdateAliasType() *PendingAliasUpsert {
+	u.SetExcluded(pendingalias.FieldAliasType)
+	return u
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *PendingAliasUpsert) SetAliasTypeID(v int) *PendingAliasUpsert {
+	u.Set(pendingalias.FieldAliasTypeID, v)
+	return u
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *PendingAliasUpsert) UpdateAliasTypeID() *PendingAliasUpsert {
+	u.SetExcluded(pendingalias.FieldAliasTypeID)
+	return u
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *PendingAliasUpsert) AddAliasTypeID(v int) *PendingAliasUpsert {
+	u.Add(pendingalias.FieldAliasTypeID, v)
+	return u
+}
+
+// SetAlias sets the "alias" field.
+func (u *PendingAliasUpsert) SetAlias(v string) *PendingAliasUpsert {
+	u.Set(pendingalias.FieldAlias, v)
+	return u
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *PendingAliasUpsert) UpdateAlias() *PendingAliasUpsert {
+	u.SetExcluded(pendingalias.FieldAlias)
+	return u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (u *PendingAliasUpsert) SetSubmittedBy(v string) *PendingAliasUpsert {
+	u.Set(pendingalias.FieldSubmittedBy, v)
+	return u
+}
+
+// UpdateSubmittedBy sets the "submitted_by" field to the value that was provided on create.
+func (u *PendingAliasUpsert) UpdateSubmittedBy() *PendingAliasUpsert {
+	u.SetExcluded(pendingalias.FieldSubmittedBy)
+	return u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *PendingAliasUpsert) SetSubmittedAt(v time.Time) *PendingAliasUpsert {
+	u.Set(pendingalias.FieldSubmittedAt, v)
+	return u
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *PendingAliasUpsert) UpdateSubmittedAt() *PendingAliasUpsert {
+	u.SetExcluded(pendingalias.FieldSubmittedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PendingAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pendingalias.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PendingAliasUpsertOne) UpdateNewValues() *PendingAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pendingalias.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PendingAlias.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PendingAliasUpsertOne) Ignore() *PendingAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PendingAliasUpsertOne) DoNothing() *PendingAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PendingAliasCreate.OnConflict
+// documentation for more info.
+func (u *PendingAliasUpsertOne) Update(set func(*PendingAliasUpsert)) *PendingAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PendingAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAliasType sets the "alias_type" field.
+func (u *PendingAliasUpsertOne) SetAliasType(v string) *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.SetAliasType(v)
+	})
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *PendingAliasUpsertOne) UpdateAliasType() *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.UpdateAliasType()
+	})
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *PendingAliasUpsertOne) SetAliasTypeID(v int) *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.SetAliasTypeID(v)
+	})
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *PendingAliasUpsertOne) AddAliasTypeID(v int) *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.AddAliasTypeID(v)
+	})
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *PendingAliasUpsertOne) UpdateAliasTypeID() *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.UpdateAliasTypeID()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *PendingAliasUpsertOne) SetAlias(v string) *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *PendingAliasUpsertOne) UpdateAlias() *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (u *PendingAliasUpsertOne) SetSubmittedBy(v string) *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.SetSubmittedBy(v)
+	})
+}
+
+// UpdateSubmittedBy sets the "submitted_by" field to the value that was provided on create.
+func (u *PendingAliasUpsertOne) UpdateSubmittedBy() *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.UpdateSubmittedBy()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *PendingAliasUpsertOne) SetSubmittedAt(v time.Time) *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *PendingAliasUpsertOne) UpdateSubmittedAt() *PendingAliasUpsertOne {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PendingAliasUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for PendingAliasCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PendingAliasUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PendingAliasUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PendingAliasUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PendingAliasCreateBulk is the builder for creating many PendingAlias entities in bulk.
 type PendingAliasCreateBulk struct {
 	config
 	err      error
 	builders []*PendingAliasCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PendingAlias entities in the database.
@@ -208,6 +485,7 @@ func (_c *PendingAliasCreateBulk) Save(ctx context.Context) ([]*PendingAlias, er
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -261,3 +539,194 @@ func (_c *PendingAliasCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PendingAlias.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PendingAliasUpsert) {
+//			SetAliasType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PendingAliasCreateBulk) OnConflict(opts ...sql.ConflictOption) *PendingAliasUpsertBulk {
+	_c.conflict = opts
+	return &PendingAliasUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PendingAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PendingAliasCreateBulk) OnConflictColumns(columns ...string) *PendingAliasUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PendingAliasUpsertBulk{
+		create: _c,
+	}
+}
+
+// PendingAliasUpsertBulk is the builder for "upsert"-ing
+// a bulk of PendingAlias nodes.
+type PendingAliasUpsertBulk struct {
+	create *PendingAliasCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PendingAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pendingalias.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PendingAliasUpsertBulk) UpdateNewValues() *PendingAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pendingalias.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PendingAlias.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PendingAliasUpsertBulk) Ignore() *PendingAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PendingAliasUpsertBulk) DoNothing() *PendingAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PendingAliasCreateBulk.OnConflict
+// documentation for more info.
+func (u *PendingAliasUpsertBulk) Update(set func(*PendingAliasUpsert)) *PendingAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PendingAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAliasType sets the "alias_type" field.
+func (u *PendingAliasUpsertBulk) SetAliasType(v string) *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.SetAliasType(v)
+	})
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *PendingAliasUpsertBulk) UpdateAliasType() *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.UpdateAliasType()
+	})
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *PendingAliasUpsertBulk) SetAliasTypeID(v int) *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.SetAliasTypeID(v)
+	})
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *PendingAliasUpsertBulk) AddAliasTypeID(v int) *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.AddAliasTypeID(v)
+	})
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *PendingAliasUpsertBulk) UpdateAliasTypeID() *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.UpdateAliasTypeID()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *PendingAliasUpsertBulk) SetAlias(v string) *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *PendingAliasUpsertBulk) UpdateAlias() *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (u *PendingAliasUpsertBulk) SetSubmittedBy(v string) *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.SetSubmittedBy(v)
+	})
+}
+
+// UpdateSubmittedBy sets the "submitted_by" field to the value that was provided on create.
+func (u *PendingAliasUpsertBulk) UpdateSubmittedBy() *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.UpdateSubmittedBy()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *PendingAliasUpsertBulk) SetSubmittedAt(v time.Time) *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *PendingAliasUpsertBulk) UpdateSubmittedAt() *PendingAliasUpsertBulk {
+	return u.Update(func(s *PendingAliasUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PendingAliasUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("pjsk: OnConflict was set for builder %d. Set it on the PendingAliasCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for PendingAliasCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PendingAliasUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
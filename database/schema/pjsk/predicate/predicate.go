@@ -24,6 +24,9 @@ type RejectedAlias func(*sql.Selector)
 // UserBinding is the predicate function for userbinding builders.
 type UserBinding func(*sql.Selector)
 
+// UserBindingLock is the predicate function for userbindinglock builders.
+type UserBindingLock func(*sql.Selector)
+
 // UserDefaultBinding is the predicate function for userdefaultbinding builders.
 type UserDefaultBinding func(*sql.Selector)
 
@@ -9,6 +9,7 @@ import (
 	"haruki-database/database/schema/pjsk/rejectedalias"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -18,6 +19,7 @@ type RejectedAliasCreate struct {
 	config
 	mutation *RejectedAliasMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetAliasType sets the "alias_type" field.
@@ -162,6 +164,7 @@ func (_c *RejectedAliasCreate) createSpec() (*RejectedAlias, *sqlgraph.CreateSpe
 		_node = &RejectedAlias{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(rejectedalias.Table, sqlgraph.NewFieldSpec(rejectedalias.FieldID, field.TypeInt64))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -193,11 +196,311 @@ func (_c *RejectedAliasCreate) createSpec() (*RejectedAlias, *sqlgraph.CreateSpe
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RejectedAlias.Create().
+//		SetAliasType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RejectedAliasUpsert) {
+//			SetAliasType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RejectedAliasCreate) OnConflict(opts ...sql.ConflictOption) *RejectedAliasUpsertOne {
+	_c.conflict = opts
+	return &RejectedAliasUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RejectedAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RejectedAliasCreate) OnConflictColumns(columns ...string) *RejectedAliasUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RejectedAliasUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RejectedAliasUpsertOne is the builder for "upsert"-ing
+	//  one RejectedAlias node.
+	RejectedAliasUpsertOne struct {
+		create *RejectedAliasCreate
+	}
+
+	// RejectedAliasUpsert is the "OnConflict" setter.
+	RejectedAliasUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAliasType sets the "alias_type" field.
+func (u *RejectedAliasUpsert) SetAliasType(v string) *RejectedAliasUpsert {
+	u.Set(rejectedalias.FieldAliasType, v)
+	return u
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *RejectedAliasUpsert) UpdateAliasType() *RejectedAliasUpsert {
+	u.SetExcluded(rejectedalias.FieldAliasType)
+	return u
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *RejectedAliasUpsert) SetAliasTypeID(v int) *RejectedAliasUpsert {
+	u.Set(rejectedalias.FieldAliasTypeID, v)
+	return u
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *RejectedAliasUpsert) UpdateAliasTypeID() *RejectedAliasUpsert {
+	u.SetExcluded(rejectedalias.FieldAliasTypeID)
+	return u
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *RejectedAliasUpsert) AddAliasTypeID(v int) *RejectedAliasUpsert {
+	u.Add(rejectedalias.FieldAliasTypeID, v)
+	return u
+}
+
+// SetAlias sets the "alias" field.
+func (u *RejectedAliasUpsert) SetAlias(v string) *RejectedAliasUpsert {
+	u.Set(rejectedalias.FieldAlias, v)
+	return u
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *RejectedAliasUpsert) UpdateAlias() *RejectedAliasUpsert {
+	u.SetExcluded(rejectedalias.FieldAlias)
+	return u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *RejectedAliasUpsert) SetReviewedBy(v string) *RejectedAliasUpsert {
+	u.Set(rejectedalias.FieldReviewedBy, v)
+	return u
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *RejectedAliasUpsert) UpdateReviewedBy() *RejectedAliasUpsert {
+	u.SetExcluded(rejectedalias.FieldReviewedBy)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *RejectedAliasUpsert) SetReason(v string) *RejectedAliasUpsert {
+	u.Set(rejectedalias.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *RejectedAliasUpsert) UpdateReason() *RejectedAliasUpsert {
+	u.SetExcluded(rejectedalias.FieldReason)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *RejectedAliasUpsert) SetReviewedAt(v time.Time) *RejectedAliasUpsert {
+	u.Set(rejectedalias.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *RejectedAliasUpsert) UpdateReviewedAt() *RejectedAliasUpsert {
+	u.SetExcluded(rejectedalias.FieldReviewedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RejectedAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rejectedalias.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RejectedAliasUpsertOne) UpdateNewValues() *RejectedAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(rejectedalias.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RejectedAlias.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RejectedAliasUpsertOne) Ignore() *RejectedAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RejectedAliasUpsertOne) DoNothing() *RejectedAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RejectedAliasCreate.OnConflict
+// documentation for more info.
+func (u *RejectedAliasUpsertOne) Update(set func(*RejectedAliasUpsert)) *RejectedAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RejectedAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAliasType sets the "alias_type" field.
+func (u *RejectedAliasUpsertOne) SetAliasType(v string) *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetAliasType(v)
+	})
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *RejectedAliasUpsertOne) UpdateAliasType() *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateAliasType()
+	})
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *RejectedAliasUpsertOne) SetAliasTypeID(v int) *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetAliasTypeID(v)
+	})
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *RejectedAliasUpsertOne) AddAliasTypeID(v int) *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.AddAliasTypeID(v)
+	})
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *RejectedAliasUpsertOne) UpdateAliasTypeID() *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateAliasTypeID()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *RejectedAliasUpsertOne) SetAlias(v string) *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *RejectedAliasUpsertOne) UpdateAlias() *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *RejectedAliasUpsertOne) SetReviewedBy(v string) *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *RejectedAliasUpsertOne) UpdateReviewedBy() *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *RejectedAliasUpsertOne) SetReason(v string) *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *RejectedAliasUpsertOne) UpdateReason() *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *RejectedAliasUpsertOne) SetReviewedAt(v time.Time) *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *RejectedAliasUpsertOne) UpdateReviewedAt() *RejectedAliasUpsertOne {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RejectedAliasUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for RejectedAliasCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RejectedAliasUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RejectedAliasUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RejectedAliasUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // RejectedAliasCreateBulk is the builder for creating many RejectedAlias entities in bulk.
 type RejectedAliasCreateBulk struct {
 	config
 	err      error
 	builders []*RejectedAliasCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the RejectedAlias entities in the database.
@@ -226,6 +529,7 @@ func (_c *RejectedAliasCreateBulk) Save(ctx context.Context) ([]*RejectedAlias,
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -279,3 +583,208 @@ func (_c *RejectedAliasCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RejectedAlias.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RejectedAliasUpsert) {
+//			SetAliasType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RejectedAliasCreateBulk) OnConflict(opts ...sql.ConflictOption) *RejectedAliasUpsertBulk {
+	_c.conflict = opts
+	return &RejectedAliasUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RejectedAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RejectedAliasCreateBulk) OnConflictColumns(columns ...string) *RejectedAliasUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RejectedAliasUpsertBulk{
+		create: _c,
+	}
+}
+
+// RejectedAliasUpsertBulk is the builder for "upsert"-ing
+// a bulk of RejectedAlias nodes.
+type RejectedAliasUpsertBulk struct {
+	create *RejectedAliasCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RejectedAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rejectedalias.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RejectedAliasUpsertBulk) UpdateNewValues() *RejectedAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(rejectedalias.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RejectedAlias.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RejectedAliasUpsertBulk) Ignore() *RejectedAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RejectedAliasUpsertBulk) DoNothing() *RejectedAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RejectedAliasCreateBulk.OnConflict
+// documentation for more info.
+func (u *RejectedAliasUpsertBulk) Update(set func(*RejectedAliasUpsert)) *RejectedAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RejectedAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAliasType sets the "alias_type" field.
+func (u *RejectedAliasUpsertBulk) SetAliasType(v string) *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetAliasType(v)
+	})
+}
+
+// UpdateAliasType sets the "alias_type" field to the value that was provided on create.
+func (u *RejectedAliasUpsertBulk) UpdateAliasType() *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateAliasType()
+	})
+}
+
+// SetAliasTypeID sets the "alias_type_id" field.
+func (u *RejectedAliasUpsertBulk) SetAliasTypeID(v int) *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetAliasTypeID(v)
+	})
+}
+
+// AddAliasTypeID adds v to the "alias_type_id" field.
+func (u *RejectedAliasUpsertBulk) AddAliasTypeID(v int) *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.AddAliasTypeID(v)
+	})
+}
+
+// UpdateAliasTypeID sets the "alias_type_id" field to the value that was provided on create.
+func (u *RejectedAliasUpsertBulk) UpdateAliasTypeID() *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateAliasTypeID()
+	})
+}
+
+// SetAlias sets the "alias" field.
+func (u *RejectedAliasUpsertBulk) SetAlias(v string) *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetAlias(v)
+	})
+}
+
+// UpdateAlias sets the "alias" field to the value that was provided on create.
+func (u *RejectedAliasUpsertBulk) UpdateAlias() *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateAlias()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *RejectedAliasUpsertBulk) SetReviewedBy(v string) *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *RejectedAliasUpsertBulk) UpdateReviewedBy() *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *RejectedAliasUpsertBulk) SetReason(v string) *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *RejectedAliasUpsertBulk) UpdateReason() *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *RejectedAliasUpsertBulk) SetReviewedAt(v time.Time) *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *RejectedAliasUpsertBulk) UpdateReviewedAt() *RejectedAliasUpsertBulk {
+	return u.Update(func(s *RejectedAliasUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RejectedAliasUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("pjsk: OnConflict was set for builder %d. Set it on the RejectedAliasCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for RejectedAliasCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RejectedAliasUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
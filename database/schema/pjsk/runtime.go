@@ -98,6 +98,18 @@ func init() {
 	userbindingDescVisible := userbindingFields[4].Descriptor()
 	// userbinding.DefaultVisible holds the default value on creation for the visible field.
 	userbinding.DefaultVisible = userbindingDescVisible.Default.(bool)
+	// userbindingDescVerified is the schema descriptor for verified field.
+	userbindingDescVerified := userbindingFields[5].Descriptor()
+	// userbinding.DefaultVerified holds the default value on creation for the verified field.
+	userbinding.DefaultVerified = userbindingDescVerified.Default.(bool)
+	// userbindingDescLabel is the schema descriptor for label field.
+	userbindingDescLabel := userbindingFields[7].Descriptor()
+	// userbinding.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	userbinding.LabelValidator = userbindingDescLabel.Validators[0].(func(string) error)
+	// userbindingDescSortOrder is the schema descriptor for sort_order field.
+	userbindingDescSortOrder := userbindingFields[8].Descriptor()
+	// userbinding.DefaultSortOrder holds the default value on creation for the sort_order field.
+	userbinding.DefaultSortOrder = userbindingDescSortOrder.Default.(int)
 	userdefaultbindingFields := schema.UserDefaultBinding{}.Fields()
 	_ = userdefaultbindingFields
 	// userdefaultbindingDescServer is the schema descriptor for server field.
@@ -24,6 +24,8 @@ type Tx struct {
 	RejectedAlias *RejectedAliasClient
 	// UserBinding is the client for interacting with the UserBinding builders.
 	UserBinding *UserBindingClient
+	// UserBindingLock is the client for interacting with the UserBindingLock builders.
+	UserBindingLock *UserBindingLockClient
 	// UserDefaultBinding is the client for interacting with the UserDefaultBinding builders.
 	UserDefaultBinding *UserDefaultBindingClient
 	// UserPreference is the client for interacting with the UserPreference builders.
@@ -165,6 +167,7 @@ func (tx *Tx) init() {
 	tx.PendingAlias = NewPendingAliasClient(tx.config)
 	tx.RejectedAlias = NewRejectedAliasClient(tx.config)
 	tx.UserBinding = NewUserBindingClient(tx.config)
+	tx.UserBindingLock = NewUserBindingLockClient(tx.config)
 	tx.UserDefaultBinding = NewUserDefaultBindingClient(tx.config)
 	tx.UserPreference = NewUserPreferenceClient(tx.config)
 }
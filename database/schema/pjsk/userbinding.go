@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"haruki-database/database/schema/pjsk/userbinding"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -24,6 +25,14 @@ type UserBinding struct {
 	Server string `json:"server,omitempty"`
 	// Visible holds the value of the "visible" field.
 	Visible bool `json:"visible,omitempty"`
+	// Ownership proven through a profile challenge
+	Verified bool `json:"verified,omitempty"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	// Label holds the value of the "label" field.
+	Label *string `json:"label,omitempty"`
+	// Display position among the user's bindings of the server
+	SortOrder int `json:"sort_order,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserBindingQuery when eager-loading is set.
 	Edges        UserBindingEdges `json:"edges"`
@@ -53,12 +62,14 @@ func (*UserBinding) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case userbinding.FieldVisible:
+		case userbinding.FieldVisible, userbinding.FieldVerified:
 			values[i] = new(sql.NullBool)
-		case userbinding.FieldID, userbinding.FieldHarukiUserID:
+		case userbinding.FieldID, userbinding.FieldHarukiUserID, userbinding.FieldSortOrder:
 			values[i] = new(sql.NullInt64)
-		case userbinding.FieldUserID, userbinding.FieldServer:
+		case userbinding.FieldUserID, userbinding.FieldServer, userbinding.FieldLabel:
 			values[i] = new(sql.NullString)
+		case userbinding.FieldVerifiedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -104,6 +115,32 @@ func (_m *UserBinding) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Visible = value.Bool
 			}
+		case userbinding.FieldVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field verified", values[i])
+			} else if value.Valid {
+				_m.Verified = value.Bool
+			}
+		case userbinding.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				_m.VerifiedAt = new(time.Time)
+				*_m.VerifiedAt = value.Time
+			}
+		case userbinding.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = new(string)
+				*_m.Label = value.String
+			}
+		case userbinding.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				_m.SortOrder = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -156,6 +193,22 @@ func (_m *UserBinding) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("visible=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Visible))
+	builder.WriteString(", ")
+	builder.WriteString("verified=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Verified))
+	builder.WriteString(", ")
+	if v := _m.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Label; v != nil {
+		builder.WriteString("label=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SortOrder))
 	builder.WriteByte(')')
 	return builder.String()
 }
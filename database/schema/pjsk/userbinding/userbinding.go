@@ -20,6 +20,14 @@ const (
 	FieldServer = "server"
 	// FieldVisible holds the string denoting the visible field in the database.
 	FieldVisible = "visible"
+	// FieldVerified holds the string denoting the verified field in the database.
+	FieldVerified = "verified"
+	// FieldVerifiedAt holds the string denoting the verified_at field in the database.
+	FieldVerifiedAt = "verified_at"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
 	// EdgeDefaultRefs holds the string denoting the default_refs edge name in mutations.
 	EdgeDefaultRefs = "default_refs"
 	// Table holds the table name of the userbinding in the database.
@@ -40,6 +48,10 @@ var Columns = []string{
 	FieldUserID,
 	FieldServer,
 	FieldVisible,
+	FieldVerified,
+	FieldVerifiedAt,
+	FieldLabel,
+	FieldSortOrder,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -59,6 +71,12 @@ var (
 	ServerValidator func(string) error
 	// DefaultVisible holds the default value on creation for the "visible" field.
 	DefaultVisible bool
+	// DefaultVerified holds the default value on creation for the "verified" field.
+	DefaultVerified bool
+	// LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	LabelValidator func(string) error
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder int
 )
 
 // OrderOption defines the ordering options for the UserBinding queries.
@@ -89,6 +107,26 @@ func ByVisible(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVisible, opts...).ToFunc()
 }
 
+// ByVerified orders the results by the verified field.
+func ByVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerified, opts...).ToFunc()
+}
+
+// ByVerifiedAt orders the results by the verified_at field.
+func ByVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedAt, opts...).ToFunc()
+}
+
+// ByLabel orders the results by the label field.
+func ByLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLabel, opts...).ToFunc()
+}
+
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
+
 // ByDefaultRefsCount orders the results by default_refs count.
 func ByDefaultRefsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
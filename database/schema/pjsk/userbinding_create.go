@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"haruki-database/database/schema/pjsk/userbinding"
 	"haruki-database/database/schema/pjsk/userdefaultbinding"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -18,6 +20,7 @@ type UserBindingCreate struct {
 	config
 	mutation *UserBindingMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetHarukiUserID sets the "haruki_user_id" field.
@@ -52,6 +55,62 @@ func (_c *UserBindingCreate) SetNillableVisible(v *bool) *UserBindingCreate {
 	return _c
 }
 
+// SetVerified sets the "verified" field.
+func (_c *UserBindingCreate) SetVerified(v bool) *UserBindingCreate {
+	_c.mutation.SetVerified(v)
+	return _c
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (_c *UserBindingCreate) SetNillableVerified(v *bool) *UserBindingCreate {
+	if v != nil {
+		_c.SetVerified(*v)
+	}
+	return _c
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_c *UserBindingCreate) SetVerifiedAt(v time.Time) *UserBindingCreate {
+	_c.mutation.SetVerifiedAt(v)
+	return _c
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_c *UserBindingCreate) SetNillableVerifiedAt(v *time.Time) *UserBindingCreate {
+	if v != nil {
+		_c.SetVerifiedAt(*v)
+	}
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *UserBindingCreate) SetLabel(v string) *UserBindingCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_c *UserBindingCreate) SetNillableLabel(v *string) *UserBindingCreate {
+	if v != nil {
+		_c.SetLabel(*v)
+	}
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *UserBindingCreate) SetSortOrder(v int) *UserBindingCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_c *UserBindingCreate) SetNillableSortOrder(v *int) *UserBindingCreate {
+	if v != nil {
+		_c.SetSortOrder(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserBindingCreate) SetID(v int) *UserBindingCreate {
 	_c.mutation.SetID(v)
@@ -112,6 +171,14 @@ func (_c *UserBindingCreate) defaults() {
 		v := userbinding.DefaultVisible
 		_c.mutation.SetVisible(v)
 	}
+	if _, ok := _c.mutation.Verified(); !ok {
+		v := userbinding.DefaultVerified
+		_c.mutation.SetVerified(v)
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		v := userbinding.DefaultSortOrder
+		_c.mutation.SetSortOrder(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -138,6 +205,17 @@ func (_c *UserBindingCreate) check() error {
 	if _, ok := _c.mutation.Visible(); !ok {
 		return &ValidationError{Name: "visible", err: errors.New(`pjsk: missing required field "UserBinding.visible"`)}
 	}
+	if _, ok := _c.mutation.Verified(); !ok {
+		return &ValidationError{Name: "verified", err: errors.New(`pjsk: missing required field "UserBinding.verified"`)}
+	}
+	if v, ok := _c.mutation.Label(); ok {
+		if err := userbinding.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`pjsk: validator failed for field "UserBinding.label": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`pjsk: missing required field "UserBinding.sort_order"`)}
+	}
 	return nil
 }
 
@@ -166,6 +244,7 @@ func (_c *UserBindingCreate) createSpec() (*UserBinding, *sqlgraph.CreateSpec) {
 		_node = &UserBinding{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(userbinding.Table, sqlgraph.NewFieldSpec(userbinding.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -186,6 +265,22 @@ func (_c *UserBindingCreate) createSpec() (*UserBinding, *sqlgraph.CreateSpec) {
 		_spec.SetField(userbinding.FieldVisible, field.TypeBool, value)
 		_node.Visible = value
 	}
+	if value, ok := _c.mutation.Verified(); ok {
+		_spec.SetField(userbinding.FieldVerified, field.TypeBool, value)
+		_node.Verified = value
+	}
+	if value, ok := _c.mutation.VerifiedAt(); ok {
+		_spec.SetField(userbinding.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(userbinding.FieldLabel, field.TypeString, value)
+		_node.Label = &value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(userbinding.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
 	if nodes := _c.mutation.DefaultRefsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -205,11 +300,402 @@ func (_c *UserBindingCreate) createSpec() (*UserBinding, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserBinding.Create().
+//		SetHarukiUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserBindingUpsert) {
+//			SetHarukiUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserBindingCreate) OnConflict(opts ...sql.ConflictOption) *UserBindingUpsertOne {
+	_c.conflict = opts
+	return &UserBindingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserBinding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserBindingCreate) OnConflictColumns(columns ...string) *UserBindingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserBindingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserBindingUpsertOne is the builder for "upsert"-ing
+	//  one UserBinding node.
+	UserBindingUpsertOne struct {
+		create *UserBindingCreate
+	}
+
+	// UserBindingUpsert is the "OnConflict" setter.
+	UserBindingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserBindingUpsert) SetHarukiUserID(v int) *UserBindingUpsert {
+	u.Set(userbinding.FieldHarukiUserID, v)
+	return u
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserBindingUpsert) UpdateHarukiUserID() *UserBindingUpsert {
+	u.SetExcluded(userbinding.FieldHarukiUserID)
+	return u
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserBindingUpsert) AddHarukiUserID(v int) *UserBindingUpsert {
+	u.Add(userbinding.FieldHarukiUserID, v)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserBindingUpsert) SetUserID(v string) *UserBindingUpsert {
+	u.Set(userbinding.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserBindingUpsert) UpdateUserID() *UserBindingUpsert {
+	u.SetExcluded(userbinding.FieldUserID)
+	return u
+}
+
+// SetServer sets the "server" field.
+func (u *UserBindingUpsert) SetServer(v string) *UserBindingUpsert {
+	u.Set(userbinding.FieldServer, v)
+	return u
+}
+
+// UpdateServer sets the "server" field to the value that was provided on create.
+func (u *UserBindingUpsert) UpdateServer() *UserBindingUpsert {
+	u.SetExcluded(userbinding.FieldServer)
+	return u
+}
+
+// SetVisible sets the "visible" field.
+func (u *UserBindingUpsert) SetVisible(v bool) *UserBindingUpsert {
+	u.Set(userbinding.FieldVisible, v)
+	return u
+}
+
+// UpdateVisible sets the "visible" field to the value that was provided on create.
+func (u *UserBindingUpsert) UpdateVisible() *UserBindingUpsert {
+	u.SetExcluded(userbinding.FieldVisible)
+	return u
+}
+
+// SetVerified sets the "verified" field.
+func (u *UserBindingUpsert) SetVerified(v bool) *UserBindingUpsert {
+	u.Set(userbinding.FieldVerified, v)
+	return u
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *UserBindingUpsert) UpdateVerified() *UserBindingUpsert {
+	u.SetExcluded(userbinding.FieldVerified)
+	return u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *UserBindingUpsert) SetVerifiedAt(v time.Time) *UserBindingUpsert {
+	u.Set(userbinding.FieldVerifiedAt, v)
+	return u
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *UserBindingUpsert) UpdateVerifiedAt() *UserBindingUpsert {
+	u.SetExcluded(userbinding.FieldVerifiedAt)
+	return u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *UserBindingUpsert) ClearVerifiedAt() *UserBindingUpsert {
+	u.SetNull(userbinding.FieldVerifiedAt)
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *UserBindingUpsert) SetLabel(v string) *UserBindingUpsert {
+	u.Set(userbinding.FieldLabel, v)
+	return u
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *UserBindingUpsert) UpdateLabel() *UserBindingUpsert {
+	u.SetExcluded(userbinding.FieldLabel)
+	return u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *UserBindingUpsert) ClearLabel() *UserBindingUpsert {
+	u.SetNull(userbinding.FieldLabel)
+	return u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *UserBindingUpsert) SetSortOrder(v int) *UserBindingUpsert {
+	u.Set(userbinding.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *UserBindingUpsert) UpdateSortOrder() *UserBindingUpsert {
+	u.SetExcluded(userbinding.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *UserBindingUpsert) AddSortOrder(v int) *UserBindingUpsert {
+	u.Add(userbinding.FieldSortOrder, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserBinding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userbinding.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserBindingUpsertOne) UpdateNewValues() *UserBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(userbinding.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserBinding.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserBindingUpsertOne) Ignore() *UserBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserBindingUpsertOne) DoNothing() *UserBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserBindingCreate.OnConflict
+// documentation for more info.
+func (u *UserBindingUpsertOne) Update(set func(*UserBindingUpsert)) *UserBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserBindingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserBindingUpsertOne) SetHarukiUserID(v int) *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserBindingUpsertOne) AddHarukiUserID(v int) *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserBindingUpsertOne) UpdateHarukiUserID() *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserBindingUpsertOne) SetUserID(v string) *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserBindingUpsertOne) UpdateUserID() *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetServer sets the "server" field.
+func (u *UserBindingUpsertOne) SetServer(v string) *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetServer(v)
+	})
+}
+
+// UpdateServer sets the "server" field to the value that was provided on create.
+func (u *UserBindingUpsertOne) UpdateServer() *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateServer()
+	})
+}
+
+// SetVisible sets the "visible" field.
+func (u *UserBindingUpsertOne) SetVisible(v bool) *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetVisible(v)
+	})
+}
+
+// UpdateVisible sets the "visible" field to the value that was provided on create.
+func (u *UserBindingUpsertOne) UpdateVisible() *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateVisible()
+	})
+}
+
+// SetVerified sets the "verified" field.
+func (u *UserBindingUpsertOne) SetVerified(v bool) *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *UserBindingUpsertOne) UpdateVerified() *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *UserBindingUpsertOne) SetVerifiedAt(v time.Time) *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *UserBindingUpsertOne) UpdateVerifiedAt() *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *UserBindingUpsertOne) ClearVerifiedAt() *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *UserBindingUpsertOne) SetLabel(v string) *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *UserBindingUpsertOne) UpdateLabel() *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *UserBindingUpsertOne) ClearLabel() *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.ClearLabel()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *UserBindingUpsertOne) SetSortOrder(v int) *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *UserBindingUpsertOne) AddSortOrder(v int) *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *UserBindingUpsertOne) UpdateSortOrder() *UserBindingUpsertOne {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// Exec executes the query.
+func (u *UserBindingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for UserBindingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserBindingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserBindingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserBindingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserBindingCreateBulk is the builder for creating many UserBinding entities in bulk.
 type UserBindingCreateBulk struct {
 	config
 	err      error
 	builders []*UserBindingCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the UserBinding entities in the database.
@@ -239,6 +725,7 @@ func (_c *UserBindingCreateBulk) Save(ctx context.Context) ([]*UserBinding, erro
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -292,3 +779,257 @@ func (_c *UserBindingCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserBinding.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserBindingUpsert) {
+//			SetHarukiUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserBindingCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserBindingUpsertBulk {
+	_c.conflict = opts
+	return &UserBindingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserBinding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserBindingCreateBulk) OnConflictColumns(columns ...string) *UserBindingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserBindingUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserBindingUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserBinding nodes.
+type UserBindingUpsertBulk struct {
+	create *UserBindingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserBinding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userbinding.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserBindingUpsertBulk) UpdateNewValues() *UserBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(userbinding.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserBinding.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserBindingUpsertBulk) Ignore() *UserBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserBindingUpsertBulk) DoNothing() *UserBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserBindingCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserBindingUpsertBulk) Update(set func(*UserBindingUpsert)) *UserBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserBindingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserBindingUpsertBulk) SetHarukiUserID(v int) *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserBindingUpsertBulk) AddHarukiUserID(v int) *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserBindingUpsertBulk) UpdateHarukiUserID() *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserBindingUpsertBulk) SetUserID(v string) *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserBindingUpsertBulk) UpdateUserID() *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetServer sets the "server" field.
+func (u *UserBindingUpsertBulk) SetServer(v string) *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetServer(v)
+	})
+}
+
+// UpdateServer sets the "server" field to the value that was provided on create.
+func (u *UserBindingUpsertBulk) UpdateServer() *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateServer()
+	})
+}
+
+// SetVisible sets the "visible" field.
+func (u *UserBindingUpsertBulk) SetVisible(v bool) *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetVisible(v)
+	})
+}
+
+// UpdateVisible sets the "visible" field to the value that was provided on create.
+func (u *UserBindingUpsertBulk) UpdateVisible() *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateVisible()
+	})
+}
+
+// SetVerified sets the "verified" field.
+func (u *UserBindingUpsertBulk) SetVerified(v bool) *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetVerified(v)
+	})
+}
+
+// UpdateVerified sets the "verified" field to the value that was provided on create.
+func (u *UserBindingUpsertBulk) UpdateVerified() *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateVerified()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *UserBindingUpsertBulk) SetVerifiedAt(v time.Time) *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *UserBindingUpsertBulk) UpdateVerifiedAt() *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *UserBindingUpsertBulk) ClearVerifiedAt() *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *UserBindingUpsertBulk) SetLabel(v string) *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *UserBindingUpsertBulk) UpdateLabel() *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *UserBindingUpsertBulk) ClearLabel() *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.ClearLabel()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *UserBindingUpsertBulk) SetSortOrder(v int) *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *UserBindingUpsertBulk) AddSortOrder(v int) *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *UserBindingUpsertBulk) UpdateSortOrder() *UserBindingUpsertBulk {
+	return u.Update(func(s *UserBindingUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// Exec executes the query.
+func (u *UserBindingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("pjsk: OnConflict was set for builder %d. Set it on the UserBindingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for UserBindingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserBindingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
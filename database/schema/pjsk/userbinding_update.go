@@ -9,6 +9,7 @@ import (
 	"haruki-database/database/schema/pjsk/predicate"
 	"haruki-database/database/schema/pjsk/userbinding"
 	"haruki-database/database/schema/pjsk/userdefaultbinding"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -91,6 +92,81 @@ func (_u *UserBindingUpdate) SetNillableVisible(v *bool) *UserBindingUpdate {
 	return _u
 }
 
+// SetVerified sets the "verified" field.
+func (_u *UserBindingUpdate) SetVerified(v bool) *UserBindingUpdate {
+	_u.mutation.SetVerified(v)
+	return _u
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (_u *UserBindingUpdate) SetNillableVerified(v *bool) *UserBindingUpdate {
+	if v != nil {
+		_u.SetVerified(*v)
+	}
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *UserBindingUpdate) SetVerifiedAt(v time.Time) *UserBindingUpdate {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *UserBindingUpdate) SetNillableVerifiedAt(v *time.Time) *UserBindingUpdate {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *UserBindingUpdate) ClearVerifiedAt() *UserBindingUpdate {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *UserBindingUpdate) SetLabel(v string) *UserBindingUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *UserBindingUpdate) SetNillableLabel(v *string) *UserBindingUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *UserBindingUpdate) ClearLabel() *UserBindingUpdate {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *UserBindingUpdate) SetSortOrder(v int) *UserBindingUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *UserBindingUpdate) SetNillableSortOrder(v *int) *UserBindingUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *UserBindingUpdate) AddSortOrder(v int) *UserBindingUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
 // AddDefaultRefIDs adds the "default_refs" edge to the UserDefaultBinding entity by IDs.
 func (_u *UserBindingUpdate) AddDefaultRefIDs(ids ...int) *UserBindingUpdate {
 	_u.mutation.AddDefaultRefIDs(ids...)
@@ -171,6 +247,11 @@ func (_u *UserBindingUpdate) check() error {
 			return &ValidationError{Name: "server", err: fmt.Errorf(`pjsk: validator failed for field "UserBinding.server": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := userbinding.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`pjsk: validator failed for field "UserBinding.label": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -201,6 +282,27 @@ func (_u *UserBindingUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Visible(); ok {
 		_spec.SetField(userbinding.FieldVisible, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Verified(); ok {
+		_spec.SetField(userbinding.FieldVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(userbinding.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(userbinding.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(userbinding.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(userbinding.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(userbinding.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(userbinding.FieldSortOrder, field.TypeInt, value)
+	}
 	if _u.mutation.DefaultRefsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -329,6 +431,81 @@ func (_u *UserBindingUpdateOne) SetNillableVisible(v *bool) *UserBindingUpdateOn
 	return _u
 }
 
+// SetVerified sets the "verified" field.
+func (_u *UserBindingUpdateOne) SetVerified(v bool) *UserBindingUpdateOne {
+	_u.mutation.SetVerified(v)
+	return _u
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (_u *UserBindingUpdateOne) SetNillableVerified(v *bool) *UserBindingUpdateOne {
+	if v != nil {
+		_u.SetVerified(*v)
+	}
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *UserBindingUpdateOne) SetVerifiedAt(v time.Time) *UserBindingUpdateOne {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *UserBindingUpdateOne) SetNillableVerifiedAt(v *time.Time) *UserBindingUpdateOne {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *UserBindingUpdateOne) ClearVerifiedAt() *UserBindingUpdateOne {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *UserBindingUpdateOne) SetLabel(v string) *UserBindingUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *UserBindingUpdateOne) SetNillableLabel(v *string) *UserBindingUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *UserBindingUpdateOne) ClearLabel() *UserBindingUpdateOne {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *UserBindingUpdateOne) SetSortOrder(v int) *UserBindingUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *UserBindingUpdateOne) SetNillableSortOrder(v *int) *UserBindingUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *UserBindingUpdateOne) AddSortOrder(v int) *UserBindingUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
 // AddDefaultRefIDs adds the "default_refs" edge to the UserDefaultBinding entity by IDs.
 func (_u *UserBindingUpdateOne) AddDefaultRefIDs(ids ...int) *UserBindingUpdateOne {
 	_u.mutation.AddDefaultRefIDs(ids...)
@@ -422,6 +599,11 @@ func (_u *UserBindingUpdateOne) check() error {
 			return &ValidationError{Name: "server", err: fmt.Errorf(`pjsk: validator failed for field "UserBinding.server": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := userbinding.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`pjsk: validator failed for field "UserBinding.label": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -469,6 +651,27 @@ func (_u *UserBindingUpdateOne) sqlSave(ctx context.Context) (_node *UserBinding
 	if value, ok := _u.mutation.Visible(); ok {
 		_spec.SetField(userbinding.FieldVisible, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Verified(); ok {
+		_spec.SetField(userbinding.FieldVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(userbinding.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(userbinding.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(userbinding.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(userbinding.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(userbinding.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(userbinding.FieldSortOrder, field.TypeInt, value)
+	}
 	if _u.mutation.DefaultRefsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pjsk
+
+import (
+	"fmt"
+	"haruki-database/database/schema/pjsk/userbindinglock"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// UserBindingLock is the model entity for the UserBindingLock schema.
+type UserBindingLock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Reference to users table
+	HarukiUserID int `json:"haruki_user_id,omitempty"`
+	// Time of the last add that took the lock
+	LockedAt     time.Time `json:"locked_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserBindingLock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userbindinglock.FieldID, userbindinglock.FieldHarukiUserID:
+			values[i] = new(sql.NullInt64)
+		case userbindinglock.FieldLockedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserBindingLock fields.
+func (_m *UserBindingLock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userbindinglock.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case userbindinglock.FieldHarukiUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field haruki_user_id", values[i])
+			} else if value.Valid {
+				_m.HarukiUserID = int(value.Int64)
+			}
+		case userbindinglock.FieldLockedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_at", values[i])
+			} else if value.Valid {
+				_m.LockedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserBindingLock.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserBindingLock) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserBindingLock.
+// Note that you need to call UserBindingLock.Unwrap() before calling this method if this UserBindingLock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserBindingLock) Update() *UserBindingLockUpdateOne {
+	return NewUserBindingLockClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserBindingLock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserBindingLock) Unwrap() *UserBindingLock {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("pjsk: UserBindingLock is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserBindingLock) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserBindingLock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("haruki_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HarukiUserID))
+	builder.WriteString(", ")
+	builder.WriteString("locked_at=")
+	builder.WriteString(_m.LockedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserBindingLocks is a parsable slice of UserBindingLock.
+type UserBindingLocks []*UserBindingLock
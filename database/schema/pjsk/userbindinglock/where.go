@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userbindinglock
+
+import (
+	"haruki-database/database/schema/pjsk/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldLTE(FieldID, id))
+}
+
+// HarukiUserID applies equality check predicate on the "haruki_user_id" field. It's identical to HarukiUserIDEQ.
+func HarukiUserID(v int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldEQ(FieldHarukiUserID, v))
+}
+
+// LockedAt applies equality check predicate on the "locked_at" field. It's identical to LockedAtEQ.
+func LockedAt(v time.Time) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// HarukiUserIDEQ applies the EQ predicate on the "haruki_user_id" field.
+func HarukiUserIDEQ(v int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldEQ(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDNEQ applies the NEQ predicate on the "haruki_user_id" field.
+func HarukiUserIDNEQ(v int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldNEQ(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDIn applies the In predicate on the "haruki_user_id" field.
+func HarukiUserIDIn(vs ...int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldIn(FieldHarukiUserID, vs...))
+}
+
+// HarukiUserIDNotIn applies the NotIn predicate on the "haruki_user_id" field.
+func HarukiUserIDNotIn(vs ...int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldNotIn(FieldHarukiUserID, vs...))
+}
+
+// HarukiUserIDGT applies the GT predicate on the "haruki_user_id" field.
+func HarukiUserIDGT(v int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldGT(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDGTE applies the GTE predicate on the "haruki_user_id" field.
+func HarukiUserIDGTE(v int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldGTE(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDLT applies the LT predicate on the "haruki_user_id" field.
+func HarukiUserIDLT(v int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldLT(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDLTE applies the LTE predicate on the "haruki_user_id" field.
+func HarukiUserIDLTE(v int) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldLTE(FieldHarukiUserID, v))
+}
+
+// LockedAtEQ applies the EQ predicate on the "locked_at" field.
+func LockedAtEQ(v time.Time) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// LockedAtNEQ applies the NEQ predicate on the "locked_at" field.
+func LockedAtNEQ(v time.Time) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldNEQ(FieldLockedAt, v))
+}
+
+// LockedAtIn applies the In predicate on the "locked_at" field.
+func LockedAtIn(vs ...time.Time) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldIn(FieldLockedAt, vs...))
+}
+
+// LockedAtNotIn applies the NotIn predicate on the "locked_at" field.
+func LockedAtNotIn(vs ...time.Time) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldNotIn(FieldLockedAt, vs...))
+}
+
+// LockedAtGT applies the GT predicate on the "locked_at" field.
+func LockedAtGT(v time.Time) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldGT(FieldLockedAt, v))
+}
+
+// LockedAtGTE applies the GTE predicate on the "locked_at" field.
+func LockedAtGTE(v time.Time) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldGTE(FieldLockedAt, v))
+}
+
+// LockedAtLT applies the LT predicate on the "locked_at" field.
+func LockedAtLT(v time.Time) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldLT(FieldLockedAt, v))
+}
+
+// LockedAtLTE applies the LTE predicate on the "locked_at" field.
+func LockedAtLTE(v time.Time) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.FieldLTE(FieldLockedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserBindingLock) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserBindingLock) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserBindingLock) predicate.UserBindingLock {
+	return predicate.UserBindingLock(sql.NotPredicates(p))
+}
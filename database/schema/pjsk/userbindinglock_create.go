@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pjsk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/pjsk/userbindinglock"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserBindingLockCreate is the builder for creating a UserBindingLock entity.
+type UserBindingLockCreate struct {
+	config
+	mutation *UserBindingLockMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_c *UserBindingLockCreate) SetHarukiUserID(v int) *UserBindingLockCreate {
+	_c.mutation.SetHarukiUserID(v)
+	return _c
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_c *UserBindingLockCreate) SetLockedAt(v time.Time) *UserBindingLockCreate {
+	_c.mutation.SetLockedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserBindingLockCreate) SetID(v int) *UserBindingLockCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the UserBindingLockMutation object of the builder.
+func (_c *UserBindingLockCreate) Mutation() *UserBindingLockMutation {
+	return _c.mutation
+}
+
+// Save creates the UserBindingLock in the database.
+func (_c *UserBindingLockCreate) Save(ctx context.Context) (*UserBindingLock, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserBindingLockCreate) SaveX(ctx context.Context) *UserBindingLock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserBindingLockCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserBindingLockCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserBindingLockCreate) check() error {
+	if _, ok := _c.mutation.HarukiUserID(); !ok {
+		return &ValidationError{Name: "haruki_user_id", err: errors.New(`pjsk: missing required field "UserBindingLock.haruki_user_id"`)}
+	}
+	if _, ok := _c.mutation.LockedAt(); !ok {
+		return &ValidationError{Name: "locked_at", err: errors.New(`pjsk: missing required field "UserBindingLock.locked_at"`)}
+	}
+	return nil
+}
+
+func (_c *UserBindingLockCreate) sqlSave(ctx context.Context) (*UserBindingLock, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserBindingLockCreate) createSpec() (*UserBindingLock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserBindingLock{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userbindinglock.Table, sqlgraph.NewFieldSpec(userbindinglock.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.HarukiUserID(); ok {
+		_spec.SetField(userbindinglock.FieldHarukiUserID, field.TypeInt, value)
+		_node.HarukiUserID = value
+	}
+	if value, ok := _c.mutation.LockedAt(); ok {
+		_spec.SetField(userbindinglock.FieldLockedAt, field.TypeTime, value)
+		_node.LockedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserBindingLock.Create().
+//		SetHarukiUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserBindingLockUpsert) {
+//			SetHarukiUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserBindingLockCreate) OnConflict(opts ...sql.ConflictOption) *UserBindingLockUpsertOne {
+	_c.conflict = opts
+	return &UserBindingLockUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserBindingLock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserBindingLockCreate) OnConflictColumns(columns ...string) *UserBindingLockUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserBindingLockUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserBindingLockUpsertOne is the builder for "upsert"-ing
+	//  one UserBindingLock node.
+	UserBindingLockUpsertOne struct {
+		create *UserBindingLockCreate
+	}
+
+	// UserBindingLockUpsert is the "OnConflict" setter.
+	UserBindingLockUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserBindingLockUpsert) SetHarukiUserID(v int) *UserBindingLockUpsert {
+	u.Set(userbindinglock.FieldHarukiUserID, v)
+	return u
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserBindingLockUpsert) UpdateHarukiUserID() *UserBindingLockUpsert {
+	u.SetExcluded(userbindinglock.FieldHarukiUserID)
+	return u
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserBindingLockUpsert) AddHarukiUserID(v int) *UserBindingLockUpsert {
+	u.Add(userbindinglock.FieldHarukiUserID, v)
+	return u
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (u *UserBindingLockUpsert) SetLockedAt(v time.Time) *UserBindingLockUpsert {
+	u.Set(userbindinglock.FieldLockedAt, v)
+	return u
+}
+
+// UpdateLockedAt sets the "locked_at" field to the value that was provided on create.
+func (u *UserBindingLockUpsert) UpdateLockedAt() *UserBindingLockUpsert {
+	u.SetExcluded(userbindinglock.FieldLockedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserBindingLock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userbindinglock.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserBindingLockUpsertOne) UpdateNewValues() *UserBindingLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(userbindinglock.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserBindingLock.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserBindingLockUpsertOne) Ignore() *UserBindingLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserBindingLockUpsertOne) DoNothing() *UserBindingLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserBindingLockCreate.OnConflict
+// documentation for more info.
+func (u *UserBindingLockUpsertOne) Update(set func(*UserBindingLockUpsert)) *UserBindingLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserBindingLockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserBindingLockUpsertOne) SetHarukiUserID(v int) *UserBindingLockUpsertOne {
+	return u.Update(func(s *UserBindingLockUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserBindingLockUpsertOne) AddHarukiUserID(v int) *UserBindingLockUpsertOne {
+	return u.Update(func(s *UserBindingLockUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserBindingLockUpsertOne) UpdateHarukiUserID() *UserBindingLockUpsertOne {
+	return u.Update(func(s *UserBindingLockUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (u *UserBindingLockUpsertOne) SetLockedAt(v time.Time) *UserBindingLockUpsertOne {
+	return u.Update(func(s *UserBindingLockUpsert) {
+		s.SetLockedAt(v)
+	})
+}
+
+// UpdateLockedAt sets the "locked_at" field to the value that was provided on create.
+func (u *UserBindingLockUpsertOne) UpdateLockedAt() *UserBindingLockUpsertOne {
+	return u.Update(func(s *UserBindingLockUpsert) {
+		s.UpdateLockedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserBindingLockUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for UserBindingLockCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserBindingLockUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserBindingLockUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserBindingLockUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserBindingLockCreateBulk is the builder for creating many UserBindingLock entities in bulk.
+type UserBindingLockCreateBulk struct {
+	config
+	err      error
+	builders []*UserBindingLockCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserBindingLock entities in the database.
+func (_c *UserBindingLockCreateBulk) Save(ctx context.Context) ([]*UserBindingLock, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserBindingLock, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserBindingLockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserBindingLockCreateBulk) SaveX(ctx context.Context) []*UserBindingLock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserBindingLockCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserBindingLockCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserBindingLock.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserBindingLockUpsert) {
+//			SetHarukiUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserBindingLockCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserBindingLockUpsertBulk {
+	_c.conflict = opts
+	return &UserBindingLockUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserBindingLock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserBindingLockCreateBulk) OnConflictColumns(columns ...string) *UserBindingLockUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserBindingLockUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserBindingLockUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserBindingLock nodes.
+type UserBindingLockUpsertBulk struct {
+	create *UserBindingLockCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserBindingLock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userbindinglock.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserBindingLockUpsertBulk) UpdateNewValues() *UserBindingLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(userbindinglock.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserBindingLock.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserBindingLockUpsertBulk) Ignore() *UserBindingLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserBindingLockUpsertBulk) DoNothing() *UserBindingLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserBindingLockCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserBindingLockUpsertBulk) Update(set func(*UserBindingLockUpsert)) *UserBindingLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserBindingLockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserBindingLockUpsertBulk) SetHarukiUserID(v int) *UserBindingLockUpsertBulk {
+	return u.Update(func(s *UserBindingLockUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserBindingLockUpsertBulk) AddHarukiUserID(v int) *UserBindingLockUpsertBulk {
+	return u.Update(func(s *UserBindingLockUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserBindingLockUpsertBulk) UpdateHarukiUserID() *UserBindingLockUpsertBulk {
+	return u.Update(func(s *UserBindingLockUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (u *UserBindingLockUpsertBulk) SetLockedAt(v time.Time) *UserBindingLockUpsertBulk {
+	return u.Update(func(s *UserBindingLockUpsert) {
+		s.SetLockedAt(v)
+	})
+}
+
+// UpdateLockedAt sets the "locked_at" field to the value that was provided on create.
+func (u *UserBindingLockUpsertBulk) UpdateLockedAt() *UserBindingLockUpsertBulk {
+	return u.Update(func(s *UserBindingLockUpsert) {
+		s.UpdateLockedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserBindingLockUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("pjsk: OnConflict was set for builder %d. Set it on the UserBindingLockCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for UserBindingLockCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserBindingLockUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pjsk
+
+import (
+	"context"
+	"haruki-database/database/schema/pjsk/predicate"
+	"haruki-database/database/schema/pjsk/userbindinglock"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserBindingLockDelete is the builder for deleting a UserBindingLock entity.
+type UserBindingLockDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserBindingLockMutation
+}
+
+// Where appends a list predicates to the UserBindingLockDelete builder.
+func (_d *UserBindingLockDelete) Where(ps ...predicate.UserBindingLock) *UserBindingLockDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *UserBindingLockDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *UserBindingLockDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *UserBindingLockDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(userbindinglock.Table, sqlgraph.NewFieldSpec(userbindinglock.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// UserBindingLockDeleteOne is the builder for deleting a single UserBindingLock entity.
+type UserBindingLockDeleteOne struct {
+	_d *UserBindingLockDelete
+}
+
+// Where appends a list predicates to the UserBindingLockDelete builder.
+func (_d *UserBindingLockDeleteOne) Where(ps ...predicate.UserBindingLock) *UserBindingLockDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *UserBindingLockDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{userbindinglock.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *UserBindingLockDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pjsk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/pjsk/predicate"
+	"haruki-database/database/schema/pjsk/userbindinglock"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserBindingLockUpdate is the builder for updating UserBindingLock entities.
+type UserBindingLockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserBindingLockMutation
+}
+
+// Where appends a list predicates to the UserBindingLockUpdate builder.
+func (_u *UserBindingLockUpdate) Where(ps ...predicate.UserBindingLock) *UserBindingLockUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_u *UserBindingLockUpdate) SetHarukiUserID(v int) *UserBindingLockUpdate {
+	_u.mutation.ResetHarukiUserID()
+	_u.mutation.SetHarukiUserID(v)
+	return _u
+}
+
+// SetNillableHarukiUserID sets the "haruki_user_id" field if the given value is not nil.
+func (_u *UserBindingLockUpdate) SetNillableHarukiUserID(v *int) *UserBindingLockUpdate {
+	if v != nil {
+		_u.SetHarukiUserID(*v)
+	}
+	return _u
+}
+
+// AddHarukiUserID adds value to the "haruki_user_id" field.
+func (_u *UserBindingLockUpdate) AddHarukiUserID(v int) *UserBindingLockUpdate {
+	_u.mutation.AddHarukiUserID(v)
+	return _u
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_u *UserBindingLockUpdate) SetLockedAt(v time.Time) *UserBindingLockUpdate {
+	_u.mutation.SetLockedAt(v)
+	return _u
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_u *UserBindingLockUpdate) SetNillableLockedAt(v *time.Time) *UserBindingLockUpdate {
+	if v != nil {
+		_u.SetLockedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UserBindingLockMutation object of the builder.
+func (_u *UserBindingLockUpdate) Mutation() *UserBindingLockMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserBindingLockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserBindingLockUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserBindingLockUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserBindingLockUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *UserBindingLockUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userbindinglock.Table, userbindinglock.Columns, sqlgraph.NewFieldSpec(userbindinglock.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HarukiUserID(); ok {
+		_spec.SetField(userbindinglock.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHarukiUserID(); ok {
+		_spec.AddField(userbindinglock.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedAt(); ok {
+		_spec.SetField(userbindinglock.FieldLockedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userbindinglock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserBindingLockUpdateOne is the builder for updating a single UserBindingLock entity.
+type UserBindingLockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserBindingLockMutation
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_u *UserBindingLockUpdateOne) SetHarukiUserID(v int) *UserBindingLockUpdateOne {
+	_u.mutation.ResetHarukiUserID()
+	_u.mutation.SetHarukiUserID(v)
+	return _u
+}
+
+// SetNillableHarukiUserID sets the "haruki_user_id" field if the given value is not nil.
+func (_u *UserBindingLockUpdateOne) SetNillableHarukiUserID(v *int) *UserBindingLockUpdateOne {
+	if v != nil {
+		_u.SetHarukiUserID(*v)
+	}
+	return _u
+}
+
+// AddHarukiUserID adds value to the "haruki_user_id" field.
+func (_u *UserBindingLockUpdateOne) AddHarukiUserID(v int) *UserBindingLockUpdateOne {
+	_u.mutation.AddHarukiUserID(v)
+	return _u
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_u *UserBindingLockUpdateOne) SetLockedAt(v time.Time) *UserBindingLockUpdateOne {
+	_u.mutation.SetLockedAt(v)
+	return _u
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_u *UserBindingLockUpdateOne) SetNillableLockedAt(v *time.Time) *UserBindingLockUpdateOne {
+	if v != nil {
+		_u.SetLockedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UserBindingLockMutation object of the builder.
+func (_u *UserBindingLockUpdateOne) Mutation() *UserBindingLockMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserBindingLockUpdate builder.
+func (_u *UserBindingLockUpdateOne) Where(ps ...predicate.UserBindingLock) *UserBindingLockUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserBindingLockUpdateOne) Select(field string, fields ...string) *UserBindingLockUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserBindingLock entity.
+func (_u *UserBindingLockUpdateOne) Save(ctx context.Context) (*UserBindingLock, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserBindingLockUpdateOne) SaveX(ctx context.Context) *UserBindingLock {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserBindingLockUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserBindingLockUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *UserBindingLockUpdateOne) sqlSave(ctx context.Context) (_node *UserBindingLock, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userbindinglock.Table, userbindinglock.Columns, sqlgraph.NewFieldSpec(userbindinglock.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`pjsk: missing "UserBindingLock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userbindinglock.FieldID)
+		for _, f := range fields {
+			if !userbindinglock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("pjsk: invalid field %q for query", f)}
+			}
+			if f != userbindinglock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HarukiUserID(); ok {
+		_spec.SetField(userbindinglock.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHarukiUserID(); ok {
+		_spec.AddField(userbindinglock.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedAt(); ok {
+		_spec.SetField(userbindinglock.FieldLockedAt, field.TypeTime, value)
+	}
+	_node = &UserBindingLock{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userbindinglock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
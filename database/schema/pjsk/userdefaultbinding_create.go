@@ -9,6 +9,7 @@ import (
 	"haruki-database/database/schema/pjsk/userbinding"
 	"haruki-database/database/schema/pjsk/userdefaultbinding"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -18,6 +19,7 @@ type UserDefaultBindingCreate struct {
 	config
 	mutation *UserDefaultBindingMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetHarukiUserID sets the "haruki_user_id" field.
@@ -128,6 +130,7 @@ func (_c *UserDefaultBindingCreate) createSpec() (*UserDefaultBinding, *sqlgraph
 		_node = &UserDefaultBinding{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(userdefaultbinding.Table, sqlgraph.NewFieldSpec(userdefaultbinding.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -160,11 +163,233 @@ func (_c *UserDefaultBindingCreate) createSpec() (*UserDefaultBinding, *sqlgraph
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserDefaultBinding.Create().
+//		SetHarukiUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserDefaultBindingUpsert) {
+//			SetHarukiUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserDefaultBindingCreate) OnConflict(opts ...sql.ConflictOption) *UserDefaultBindingUpsertOne {
+	_c.conflict = opts
+	return &UserDefaultBindingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserDefaultBinding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserDefaultBindingCreate) OnConflictColumns(columns ...string) *UserDefaultBindingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserDefaultBindingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserDefaultBindingUpsertOne is the builder for "upsert"-ing
+	//  one UserDefaultBinding node.
+	UserDefaultBindingUpsertOne struct {
+		create *UserDefaultBindingCreate
+	}
+
+	// UserDefaultBindingUpsert is the "OnConflict" setter.
+	UserDefaultBindingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserDefaultBindingUpsert) SetHarukiUserID(v int) *UserDefaultBindingUpsert {
+	u.Set(userdefaultbinding.FieldHarukiUserID, v)
+	return u
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserDefaultBindingUpsert) UpdateHarukiUserID() *UserDefaultBindingUpsert {
+	u.SetExcluded(userdefaultbinding.FieldHarukiUserID)
+	return u
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserDefaultBindingUpsert) AddHarukiUserID(v int) *UserDefaultBindingUpsert {
+	u.Add(userdefaultbinding.FieldHarukiUserID, v)
+	return u
+}
+
+// SetServer sets the "server" field.
+func (u *UserDefaultBindingUpsert) SetServer(v string) *UserDefaultBindingUpsert {
+	u.Set(userdefaultbinding.FieldServer, v)
+	return u
+}
+
+// UpdateServer sets the "server" field to the value that was provided on create.
+func (u *UserDefaultBindingUpsert) UpdateServer() *UserDefaultBindingUpsert {
+	u.SetExcluded(userdefaultbinding.FieldServer)
+	return u
+}
+
+// SetBindingID sets the "binding_id" field.
+func (u *UserDefaultBindingUpsert) SetBindingID(v int) *UserDefaultBindingUpsert {
+	u.Set(userdefaultbinding.FieldBindingID, v)
+	return u
+}
+
+// UpdateBindingID sets the "binding_id" field to the value that was provided on create.
+func (u *UserDefaultBindingUpsert) UpdateBindingID() *UserDefaultBindingUpsert {
+	u.SetExcluded(userdefaultbinding.FieldBindingID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserDefaultBinding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userdefaultbinding.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserDefaultBindingUpsertOne) UpdateNewValues() *UserDefaultBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(userdefaultbinding.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserDefaultBinding.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserDefaultBindingUpsertOne) Ignore() *UserDefaultBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserDefaultBindingUpsertOne) DoNothing() *UserDefaultBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserDefaultBindingCreate.OnConflict
+// documentation for more info.
+func (u *UserDefaultBindingUpsertOne) Update(set func(*UserDefaultBindingUpsert)) *UserDefaultBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserDefaultBindingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserDefaultBindingUpsertOne) SetHarukiUserID(v int) *UserDefaultBindingUpsertOne {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserDefaultBindingUpsertOne) AddHarukiUserID(v int) *UserDefaultBindingUpsertOne {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserDefaultBindingUpsertOne) UpdateHarukiUserID() *UserDefaultBindingUpsertOne {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetServer sets the "server" field.
+func (u *UserDefaultBindingUpsertOne) SetServer(v string) *UserDefaultBindingUpsertOne {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.SetServer(v)
+	})
+}
+
+// UpdateServer sets the "server" field to the value that was provided on create.
+func (u *UserDefaultBindingUpsertOne) UpdateServer() *UserDefaultBindingUpsertOne {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.UpdateServer()
+	})
+}
+
+// SetBindingID sets the "binding_id" field.
+func (u *UserDefaultBindingUpsertOne) SetBindingID(v int) *UserDefaultBindingUpsertOne {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.SetBindingID(v)
+	})
+}
+
+// UpdateBindingID sets the "binding_id" field to the value that was provided on create.
+func (u *UserDefaultBindingUpsertOne) UpdateBindingID() *UserDefaultBindingUpsertOne {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.UpdateBindingID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserDefaultBindingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for UserDefaultBindingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserDefaultBindingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserDefaultBindingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserDefaultBindingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserDefaultBindingCreateBulk is the builder for creating many UserDefaultBinding entities in bulk.
 type UserDefaultBindingCreateBulk struct {
 	config
 	err      error
 	builders []*UserDefaultBindingCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the UserDefaultBinding entities in the database.
@@ -193,6 +418,7 @@ func (_c *UserDefaultBindingCreateBulk) Save(ctx context.Context) ([]*UserDefaul
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -246,3 +472,166 @@ func (_c *UserDefaultBindingCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserDefaultBinding.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserDefaultBindingUpsert) {
+//			SetHarukiUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserDefaultBindingCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserDefaultBindingUpsertBulk {
+	_c.conflict = opts
+	return &UserDefaultBindingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserDefaultBinding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserDefaultBindingCreateBulk) OnConflictColumns(columns ...string) *UserDefaultBindingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserDefaultBindingUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserDefaultBindingUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserDefaultBinding nodes.
+type UserDefaultBindingUpsertBulk struct {
+	create *UserDefaultBindingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserDefaultBinding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userdefaultbinding.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserDefaultBindingUpsertBulk) UpdateNewValues() *UserDefaultBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(userdefaultbinding.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserDefaultBinding.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserDefaultBindingUpsertBulk) Ignore() *UserDefaultBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserDefaultBindingUpsertBulk) DoNothing() *UserDefaultBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserDefaultBindingCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserDefaultBindingUpsertBulk) Update(set func(*UserDefaultBindingUpsert)) *UserDefaultBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserDefaultBindingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserDefaultBindingUpsertBulk) SetHarukiUserID(v int) *UserDefaultBindingUpsertBulk {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserDefaultBindingUpsertBulk) AddHarukiUserID(v int) *UserDefaultBindingUpsertBulk {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserDefaultBindingUpsertBulk) UpdateHarukiUserID() *UserDefaultBindingUpsertBulk {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetServer sets the "server" field.
+func (u *UserDefaultBindingUpsertBulk) SetServer(v string) *UserDefaultBindingUpsertBulk {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.SetServer(v)
+	})
+}
+
+// UpdateServer sets the "server" field to the value that was provided on create.
+func (u *UserDefaultBindingUpsertBulk) UpdateServer() *UserDefaultBindingUpsertBulk {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.UpdateServer()
+	})
+}
+
+// SetBindingID sets the "binding_id" field.
+func (u *UserDefaultBindingUpsertBulk) SetBindingID(v int) *UserDefaultBindingUpsertBulk {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.SetBindingID(v)
+	})
+}
+
+// UpdateBindingID sets the "binding_id" field to the value that was provided on create.
+func (u *UserDefaultBindingUpsertBulk) UpdateBindingID() *UserDefaultBindingUpsertBulk {
+	return u.Update(func(s *UserDefaultBindingUpsert) {
+		s.UpdateBindingID()
+	})
+}
+
+// Exec executes the query.
+func (u *UserDefaultBindingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("pjsk: OnConflict was set for builder %d. Set it on the UserDefaultBindingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for UserDefaultBindingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserDefaultBindingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
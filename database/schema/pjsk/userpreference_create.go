@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"haruki-database/database/schema/pjsk/userpreference"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +18,7 @@ type UserPreferenceCreate struct {
 	config
 	mutation *UserPreferenceMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetHarukiUserID sets the "haruki_user_id" field.
@@ -116,6 +118,7 @@ func (_c *UserPreferenceCreate) createSpec() (*UserPreference, *sqlgraph.CreateS
 		_node = &UserPreference{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(userpreference.Table, sqlgraph.NewFieldSpec(userpreference.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.HarukiUserID(); ok {
 		_spec.SetField(userpreference.FieldHarukiUserID, field.TypeInt, value)
 		_node.HarukiUserID = value
@@ -131,11 +134,225 @@ func (_c *UserPreferenceCreate) createSpec() (*UserPreference, *sqlgraph.CreateS
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserPreference.Create().
+//		SetHarukiUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserPreferenceUpsert) {
+//			SetHarukiUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserPreferenceCreate) OnConflict(opts ...sql.ConflictOption) *UserPreferenceUpsertOne {
+	_c.conflict = opts
+	return &UserPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserPreferenceCreate) OnConflictColumns(columns ...string) *UserPreferenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserPreferenceUpsertOne is the builder for "upsert"-ing
+	//  one UserPreference node.
+	UserPreferenceUpsertOne struct {
+		create *UserPreferenceCreate
+	}
+
+	// UserPreferenceUpsert is the "OnConflict" setter.
+	UserPreferenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserPreferenceUpsert) SetHarukiUserID(v int) *UserPreferenceUpsert {
+	u.Set(userpreference.FieldHarukiUserID, v)
+	return u
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserPreferenceUpsert) UpdateHarukiUserID() *UserPreferenceUpsert {
+	u.SetExcluded(userpreference.FieldHarukiUserID)
+	return u
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserPreferenceUpsert) AddHarukiUserID(v int) *UserPreferenceUpsert {
+	u.Add(userpreference.FieldHarukiUserID, v)
+	return u
+}
+
+// SetOption sets the "option" field.
+func (u *UserPreferenceUpsert) SetOption(v string) *UserPreferenceUpsert {
+	u.Set(userpreference.FieldOption, v)
+	return u
+}
+
+// UpdateOption sets the "option" field to the value that was provided on create.
+func (u *UserPreferenceUpsert) UpdateOption() *UserPreferenceUpsert {
+	u.SetExcluded(userpreference.FieldOption)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *UserPreferenceUpsert) SetValue(v string) *UserPreferenceUpsert {
+	u.Set(userpreference.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *UserPreferenceUpsert) UpdateValue() *UserPreferenceUpsert {
+	u.SetExcluded(userpreference.FieldValue)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.UserPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserPreferenceUpsertOne) UpdateNewValues() *UserPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserPreference.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserPreferenceUpsertOne) Ignore() *UserPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserPreferenceUpsertOne) DoNothing() *UserPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserPreferenceCreate.OnConflict
+// documentation for more info.
+func (u *UserPreferenceUpsertOne) Update(set func(*UserPreferenceUpsert)) *UserPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserPreferenceUpsertOne) SetHarukiUserID(v int) *UserPreferenceUpsertOne {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserPreferenceUpsertOne) AddHarukiUserID(v int) *UserPreferenceUpsertOne {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserPreferenceUpsertOne) UpdateHarukiUserID() *UserPreferenceUpsertOne {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetOption sets the "option" field.
+func (u *UserPreferenceUpsertOne) SetOption(v string) *UserPreferenceUpsertOne {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.SetOption(v)
+	})
+}
+
+// UpdateOption sets the "option" field to the value that was provided on create.
+func (u *UserPreferenceUpsertOne) UpdateOption() *UserPreferenceUpsertOne {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.UpdateOption()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *UserPreferenceUpsertOne) SetValue(v string) *UserPreferenceUpsertOne {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *UserPreferenceUpsertOne) UpdateValue() *UserPreferenceUpsertOne {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// Exec executes the query.
+func (u *UserPreferenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for UserPreferenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserPreferenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserPreferenceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserPreferenceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserPreferenceCreateBulk is the builder for creating many UserPreference entities in bulk.
 type UserPreferenceCreateBulk struct {
 	config
 	err      error
 	builders []*UserPreferenceCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the UserPreference entities in the database.
@@ -164,6 +381,7 @@ func (_c *UserPreferenceCreateBulk) Save(ctx context.Context) ([]*UserPreference
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -217,3 +435,156 @@ func (_c *UserPreferenceCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserPreference.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserPreferenceUpsert) {
+//			SetHarukiUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserPreferenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserPreferenceUpsertBulk {
+	_c.conflict = opts
+	return &UserPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserPreferenceCreateBulk) OnConflictColumns(columns ...string) *UserPreferenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserPreferenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserPreference nodes.
+type UserPreferenceUpsertBulk struct {
+	create *UserPreferenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserPreferenceUpsertBulk) UpdateNewValues() *UserPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserPreference.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserPreferenceUpsertBulk) Ignore() *UserPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserPreferenceUpsertBulk) DoNothing() *UserPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserPreferenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserPreferenceUpsertBulk) Update(set func(*UserPreferenceUpsert)) *UserPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *UserPreferenceUpsertBulk) SetHarukiUserID(v int) *UserPreferenceUpsertBulk {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *UserPreferenceUpsertBulk) AddHarukiUserID(v int) *UserPreferenceUpsertBulk {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *UserPreferenceUpsertBulk) UpdateHarukiUserID() *UserPreferenceUpsertBulk {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetOption sets the "option" field.
+func (u *UserPreferenceUpsertBulk) SetOption(v string) *UserPreferenceUpsertBulk {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.SetOption(v)
+	})
+}
+
+// UpdateOption sets the "option" field to the value that was provided on create.
+func (u *UserPreferenceUpsertBulk) UpdateOption() *UserPreferenceUpsertBulk {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.UpdateOption()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *UserPreferenceUpsertBulk) SetValue(v string) *UserPreferenceUpsertBulk {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *UserPreferenceUpsertBulk) UpdateValue() *UserPreferenceUpsertBulk {
+	return u.Update(func(s *UserPreferenceUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// Exec executes the query.
+func (u *UserPreferenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("pjsk: OnConflict was set for builder %d. Set it on the UserPreferenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("pjsk: missing options for UserPreferenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserPreferenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
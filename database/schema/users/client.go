@@ -11,6 +11,9 @@ import (
 
 	"haruki-database/database/schema/users/migrate"
 
+	"haruki-database/database/schema/users/gamebinding"
+	"haruki-database/database/schema/users/gamebindinglock"
+	"haruki-database/database/schema/users/preference"
 	"haruki-database/database/schema/users/user"
 
 	"entgo.io/ent"
@@ -23,6 +26,12 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// GameBinding is the client for interacting with the GameBinding builders.
+	GameBinding *GameBindingClient
+	// GameBindingLock is the client for interacting with the GameBindingLock builders.
+	GameBindingLock *GameBindingLockClient
+	// Preference is the client for interacting with the Preference builders.
+	Preference *PreferenceClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -36,6 +45,9 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.GameBinding = NewGameBindingClient(c.config)
+	c.GameBindingLock = NewGameBindingLockClient(c.config)
+	c.Preference = NewPreferenceClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -127,9 +139,12 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		User:   NewUserClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		GameBinding:     NewGameBindingClient(cfg),
+		GameBindingLock: NewGameBindingLockClient(cfg),
+		Preference:      NewPreferenceClient(cfg),
+		User:            NewUserClient(cfg),
 	}, nil
 }
 
@@ -147,16 +162,19 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		User:   NewUserClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		GameBinding:     NewGameBindingClient(cfg),
+		GameBindingLock: NewGameBindingLockClient(cfg),
+		Preference:      NewPreferenceClient(cfg),
+		User:            NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		User.
+//		GameBinding.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -178,18 +196,30 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.GameBinding.Use(hooks...)
+	c.GameBindingLock.Use(hooks...)
+	c.Preference.Use(hooks...)
 	c.User.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.GameBinding.Intercept(interceptors...)
+	c.GameBindingLock.Intercept(interceptors...)
+	c.Preference.Intercept(interceptors...)
 	c.User.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *GameBindingMutation:
+		return c.GameBinding.mutate(ctx, m)
+	case *GameBindingLockMutation:
+		return c.GameBindingLock.mutate(ctx, m)
+	case *PreferenceMutation:
+		return c.Preference.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -197,6 +227,405 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// GameBindingClient is a client for the GameBinding schema.
+type GameBindingClient struct {
+	config
+}
+
+// NewGameBindingClient returns a client for the GameBinding from the given config.
+func NewGameBindingClient(c config) *GameBindingClient {
+	return &GameBindingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gamebinding.Hooks(f(g(h())))`.
+func (c *GameBindingClient) Use(hooks ...Hook) {
+	c.hooks.GameBinding = append(c.hooks.GameBinding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gamebinding.Intercept(f(g(h())))`.
+func (c *GameBindingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GameBinding = append(c.inters.GameBinding, interceptors...)
+}
+
+// Create returns a builder for creating a GameBinding entity.
+func (c *GameBindingClient) Create() *GameBindingCreate {
+	mutation := newGameBindingMutation(c.config, OpCreate)
+	return &GameBindingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GameBinding entities.
+func (c *GameBindingClient) CreateBulk(builders ...*GameBindingCreate) *GameBindingCreateBulk {
+	return &GameBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GameBindingClient) MapCreateBulk(slice any, setFunc func(*GameBindingCreate, int)) *GameBindingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GameBindingCreateBulk{err: fmt.Errorf("calling to GameBindingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GameBindingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GameBindingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GameBinding.
+func (c *GameBindingClient) Update() *GameBindingUpdate {
+	mutation := newGameBindingMutation(c.config, OpUpdate)
+	return &GameBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GameBindingClient) UpdateOne(_m *GameBinding) *GameBindingUpdateOne {
+	mutation := newGameBindingMutation(c.config, OpUpdateOne, withGameBinding(_m))
+	return &GameBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GameBindingClient) UpdateOneID(id int) *GameBindingUpdateOne {
+	mutation := newGameBindingMutation(c.config, OpUpdateOne, withGameBindingID(id))
+	return &GameBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GameBinding.
+func (c *GameBindingClient) Delete() *GameBindingDelete {
+	mutation := newGameBindingMutation(c.config, OpDelete)
+	return &GameBindingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GameBindingClient) DeleteOne(_m *GameBinding) *GameBindingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GameBindingClient) DeleteOneID(id int) *GameBindingDeleteOne {
+	builder := c.Delete().Where(gamebinding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GameBindingDeleteOne{builder}
+}
+
+// Query returns a query builder for GameBinding.
+func (c *GameBindingClient) Query() *GameBindingQuery {
+	return &GameBindingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGameBinding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GameBinding entity by its id.
+func (c *GameBindingClient) Get(ctx context.Context, id int) (*GameBinding, error) {
+	return c.Query().Where(gamebinding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GameBindingClient) GetX(ctx context.Context, id int) *GameBinding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GameBindingClient) Hooks() []Hook {
+	return c.hooks.GameBinding
+}
+
+// Interceptors returns the client interceptors.
+func (c *GameBindingClient) Interceptors() []Interceptor {
+	return c.inters.GameBinding
+}
+
+func (c *GameBindingClient) mutate(ctx context.Context, m *GameBindingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GameBindingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GameBindingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GameBindingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GameBindingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("users: unknown GameBinding mutation op: %q", m.Op())
+	}
+}
+
+// GameBindingLockClient is a client for the GameBindingLock schema.
+type GameBindingLockClient struct {
+	config
+}
+
+// NewGameBindingLockClient returns a client for the GameBindingLock from the given config.
+func NewGameBindingLockClient(c config) *GameBindingLockClient {
+	return &GameBindingLockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gamebindinglock.Hooks(f(g(h())))`.
+func (c *GameBindingLockClient) Use(hooks ...Hook) {
+	c.hooks.GameBindingLock = append(c.hooks.GameBindingLock, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gamebindinglock.Intercept(f(g(h())))`.
+func (c *GameBindingLockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GameBindingLock = append(c.inters.GameBindingLock, interceptors...)
+}
+
+// Create returns a builder for creating a GameBindingLock entity.
+func (c *GameBindingLockClient) Create() *GameBindingLockCreate {
+	mutation := newGameBindingLockMutation(c.config, OpCreate)
+	return &GameBindingLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GameBindingLock entities.
+func (c *GameBindingLockClient) CreateBulk(builders ...*GameBindingLockCreate) *GameBindingLockCreateBulk {
+	return &GameBindingLockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GameBindingLockClient) MapCreateBulk(slice any, setFunc func(*GameBindingLockCreate, int)) *GameBindingLockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GameBindingLockCreateBulk{err: fmt.Errorf("calling to GameBindingLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GameBindingLockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GameBindingLockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GameBindingLock.
+func (c *GameBindingLockClient) Update() *GameBindingLockUpdate {
+	mutation := newGameBindingLockMutation(c.config, OpUpdate)
+	return &GameBindingLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GameBindingLockClient) UpdateOne(_m *GameBindingLock) *GameBindingLockUpdateOne {
+	mutation := newGameBindingLockMutation(c.config, OpUpdateOne, withGameBindingLock(_m))
+	return &GameBindingLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GameBindingLockClient) UpdateOneID(id int) *GameBindingLockUpdateOne {
+	mutation := newGameBindingLockMutation(c.config, OpUpdateOne, withGameBindingLockID(id))
+	return &GameBindingLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GameBindingLock.
+func (c *GameBindingLockClient) Delete() *GameBindingLockDelete {
+	mutation := newGameBindingLockMutation(c.config, OpDelete)
+	return &GameBindingLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GameBindingLockClient) DeleteOne(_m *GameBindingLock) *GameBindingLockDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GameBindingLockClient) DeleteOneID(id int) *GameBindingLockDeleteOne {
+	builder := c.Delete().Where(gamebindinglock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GameBindingLockDeleteOne{builder}
+}
+
+// Query returns a query builder for GameBindingLock.
+func (c *GameBindingLockClient) Query() *GameBindingLockQuery {
+	return &GameBindingLockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGameBindingLock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GameBindingLock entity by its id.
+func (c *GameBindingLockClient) Get(ctx context.Context, id int) (*GameBindingLock, error) {
+	return c.Query().Where(gamebindinglock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GameBindingLockClient) GetX(ctx context.Context, id int) *GameBindingLock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GameBindingLockClient) Hooks() []Hook {
+	return c.hooks.GameBindingLock
+}
+
+// Interceptors returns the client interceptors.
+func (c *GameBindingLockClient) Interceptors() []Interceptor {
+	return c.inters.GameBindingLock
+}
+
+func (c *GameBindingLockClient) mutate(ctx context.Context, m *GameBindingLockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GameBindingLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GameBindingLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GameBindingLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GameBindingLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("users: unknown GameBindingLock mutation op: %q", m.Op())
+	}
+}
+
+// PreferenceClient is a client for the Preference schema.
+type PreferenceClient struct {
+	config
+}
+
+// NewPreferenceClient returns a client for the Preference from the given config.
+func NewPreferenceClient(c config) *PreferenceClient {
+	return &PreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `preference.Hooks(f(g(h())))`.
+func (c *PreferenceClient) Use(hooks ...Hook) {
+	c.hooks.Preference = append(c.hooks.Preference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `preference.Intercept(f(g(h())))`.
+func (c *PreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Preference = append(c.inters.Preference, interceptors...)
+}
+
+// Create returns a builder for creating a Preference entity.
+func (c *PreferenceClient) Create() *PreferenceCreate {
+	mutation := newPreferenceMutation(c.config, OpCreate)
+	return &PreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Preference entities.
+func (c *PreferenceClient) CreateBulk(builders ...*PreferenceCreate) *PreferenceCreateBulk {
+	return &PreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PreferenceClient) MapCreateBulk(slice any, setFunc func(*PreferenceCreate, int)) *PreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PreferenceCreateBulk{err: fmt.Errorf("calling to PreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Preference.
+func (c *PreferenceClient) Update() *PreferenceUpdate {
+	mutation := newPreferenceMutation(c.config, OpUpdate)
+	return &PreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PreferenceClient) UpdateOne(_m *Preference) *PreferenceUpdateOne {
+	mutation := newPreferenceMutation(c.config, OpUpdateOne, withPreference(_m))
+	return &PreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PreferenceClient) UpdateOneID(id int) *PreferenceUpdateOne {
+	mutation := newPreferenceMutation(c.config, OpUpdateOne, withPreferenceID(id))
+	return &PreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Preference.
+func (c *PreferenceClient) Delete() *PreferenceDelete {
+	mutation := newPreferenceMutation(c.config, OpDelete)
+	return &PreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PreferenceClient) DeleteOne(_m *Preference) *PreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PreferenceClient) DeleteOneID(id int) *PreferenceDeleteOne {
+	builder := c.Delete().Where(preference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for Preference.
+func (c *PreferenceClient) Query() *PreferenceQuery {
+	return &PreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Preference entity by its id.
+func (c *PreferenceClient) Get(ctx context.Context, id int) (*Preference, error) {
+	return c.Query().Where(preference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PreferenceClient) GetX(ctx context.Context, id int) *Preference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PreferenceClient) Hooks() []Hook {
+	return c.hooks.Preference
+}
+
+// Interceptors returns the client interceptors.
+func (c *PreferenceClient) Interceptors() []Interceptor {
+	return c.inters.Preference
+}
+
+func (c *PreferenceClient) mutate(ctx context.Context, m *PreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("users: unknown Preference mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -333,9 +762,9 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		User []ent.Hook
+		GameBinding, GameBindingLock, Preference, User []ent.Hook
 	}
 	inters struct {
-		User []ent.Interceptor
+		GameBinding, GameBindingLock, Preference, User []ent.Interceptor
 	}
 )
@@ -6,6 +6,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"haruki-database/database/schema/users/gamebinding"
+	"haruki-database/database/schema/users/gamebindinglock"
+	"haruki-database/database/schema/users/preference"
 	"haruki-database/database/schema/users/user"
 	"reflect"
 	"sync"
@@ -73,7 +76,10 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			user.Table: user.ValidColumn,
+			gamebinding.Table:     gamebinding.ValidColumn,
+			gamebindinglock.Table: gamebindinglock.ValidColumn,
+			preference.Table:      preference.ValidColumn,
+			user.Table:            user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
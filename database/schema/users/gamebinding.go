@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package users
+
+import (
+	"fmt"
+	"haruki-database/database/schema/users/gamebinding"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// GameBinding is the model entity for the GameBinding schema.
+type GameBinding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Game name declared in the game_bindings config
+	Game string `json:"game,omitempty"`
+	// Reference to users table
+	HarukiUserID int `json:"haruki_user_id,omitempty"`
+	// Server holds the value of the "server" field.
+	Server string `json:"server,omitempty"`
+	// Account ID in the game
+	AccountID string `json:"account_id,omitempty"`
+	// Label holds the value of the "label" field.
+	Label *string `json:"label,omitempty"`
+	// Visible holds the value of the "visible" field.
+	Visible bool `json:"visible,omitempty"`
+	// Whether this is the default binding of its server
+	IsDefault bool `json:"is_default,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder    int `json:"sort_order,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GameBinding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gamebinding.FieldVisible, gamebinding.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case gamebinding.FieldID, gamebinding.FieldHarukiUserID, gamebinding.FieldSortOrder:
+			values[i] = new(sql.NullInt64)
+		case gamebinding.FieldGame, gamebinding.FieldServer, gamebinding.FieldAccountID, gamebinding.FieldLabel:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GameBinding fields.
+func (_m *GameBinding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gamebinding.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case gamebinding.FieldGame:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field game", values[i])
+			} else if value.Valid {
+				_m.Game = value.String
+			}
+		case gamebinding.FieldHarukiUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field haruki_user_id", values[i])
+			} else if value.Valid {
+				_m.HarukiUserID = int(value.Int64)
+			}
+		case gamebinding.FieldServer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field server", values[i])
+			} else if value.Valid {
+				_m.Server = value.String
+			}
+		case gamebinding.FieldAccountID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.String
+			}
+		case gamebinding.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = new(string)
+				*_m.Label = value.String
+			}
+		case gamebinding.FieldVisible:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field visible", values[i])
+			} else if value.Valid {
+				_m.Visible = value.Bool
+			}
+		case gamebinding.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				_m.IsDefault = value.Bool
+			}
+		case gamebinding.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				_m.SortOrder = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GameBinding.
+// This includes values selected through modifiers, order, etc.
+func (_m *GameBinding) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GameBinding.
+// Note that you need to call GameBinding.Unwrap() before calling this method if this GameBinding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GameBinding) Update() *GameBindingUpdateOne {
+	return NewGameBindingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GameBinding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GameBinding) Unwrap() *GameBinding {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("users: GameBinding is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GameBinding) String() string {
+	var builder strings.Builder
+	builder.WriteString("GameBinding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("game=")
+	builder.WriteString(_m.Game)
+	builder.WriteString(", ")
+	builder.WriteString("haruki_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HarukiUserID))
+	builder.WriteString(", ")
+	builder.WriteString("server=")
+	builder.WriteString(_m.Server)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(_m.AccountID)
+	builder.WriteString(", ")
+	if v := _m.Label; v != nil {
+		builder.WriteString("label=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("visible=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visible))
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SortOrder))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GameBindings is a parsable slice of GameBinding.
+type GameBindings []*GameBinding
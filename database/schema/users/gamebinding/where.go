@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gamebinding
+
+import (
+	"haruki-database/database/schema/users/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLTE(FieldID, id))
+}
+
+// Game applies equality check predicate on the "game" field. It's identical to GameEQ.
+func Game(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldGame, v))
+}
+
+// HarukiUserID applies equality check predicate on the "haruki_user_id" field. It's identical to HarukiUserIDEQ.
+func HarukiUserID(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldHarukiUserID, v))
+}
+
+// Server applies equality check predicate on the "server" field. It's identical to ServerEQ.
+func Server(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldServer, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Visible applies equality check predicate on the "visible" field. It's identical to VisibleEQ.
+func Visible(v bool) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldVisible, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// GameEQ applies the EQ predicate on the "game" field.
+func GameEQ(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldGame, v))
+}
+
+// GameNEQ applies the NEQ predicate on the "game" field.
+func GameNEQ(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNEQ(FieldGame, v))
+}
+
+// GameIn applies the In predicate on the "game" field.
+func GameIn(vs ...string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldIn(FieldGame, vs...))
+}
+
+// GameNotIn applies the NotIn predicate on the "game" field.
+func GameNotIn(vs ...string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNotIn(FieldGame, vs...))
+}
+
+// GameGT applies the GT predicate on the "game" field.
+func GameGT(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGT(FieldGame, v))
+}
+
+// GameGTE applies the GTE predicate on the "game" field.
+func GameGTE(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGTE(FieldGame, v))
+}
+
+// GameLT applies the LT predicate on the "game" field.
+func GameLT(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLT(FieldGame, v))
+}
+
+// GameLTE applies the LTE predicate on the "game" field.
+func GameLTE(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLTE(FieldGame, v))
+}
+
+// GameContains applies the Contains predicate on the "game" field.
+func GameContains(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldContains(FieldGame, v))
+}
+
+// GameHasPrefix applies the HasPrefix predicate on the "game" field.
+func GameHasPrefix(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldHasPrefix(FieldGame, v))
+}
+
+// GameHasSuffix applies the HasSuffix predicate on the "game" field.
+func GameHasSuffix(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldHasSuffix(FieldGame, v))
+}
+
+// GameEqualFold applies the EqualFold predicate on the "game" field.
+func GameEqualFold(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEqualFold(FieldGame, v))
+}
+
+// GameContainsFold applies the ContainsFold predicate on the "game" field.
+func GameContainsFold(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldContainsFold(FieldGame, v))
+}
+
+// HarukiUserIDEQ applies the EQ predicate on the "haruki_user_id" field.
+func HarukiUserIDEQ(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDNEQ applies the NEQ predicate on the "haruki_user_id" field.
+func HarukiUserIDNEQ(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNEQ(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDIn applies the In predicate on the "haruki_user_id" field.
+func HarukiUserIDIn(vs ...int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldIn(FieldHarukiUserID, vs...))
+}
+
+// HarukiUserIDNotIn applies the NotIn predicate on the "haruki_user_id" field.
+func HarukiUserIDNotIn(vs ...int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNotIn(FieldHarukiUserID, vs...))
+}
+
+// HarukiUserIDGT applies the GT predicate on the "haruki_user_id" field.
+func HarukiUserIDGT(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGT(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDGTE applies the GTE predicate on the "haruki_user_id" field.
+func HarukiUserIDGTE(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGTE(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDLT applies the LT predicate on the "haruki_user_id" field.
+func HarukiUserIDLT(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLT(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDLTE applies the LTE predicate on the "haruki_user_id" field.
+func HarukiUserIDLTE(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLTE(FieldHarukiUserID, v))
+}
+
+// ServerEQ applies the EQ predicate on the "server" field.
+func ServerEQ(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldServer, v))
+}
+
+// ServerNEQ applies the NEQ predicate on the "server" field.
+func ServerNEQ(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNEQ(FieldServer, v))
+}
+
+// ServerIn applies the In predicate on the "server" field.
+func ServerIn(vs ...string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldIn(FieldServer, vs...))
+}
+
+// ServerNotIn applies the NotIn predicate on the "server" field.
+func ServerNotIn(vs ...string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNotIn(FieldServer, vs...))
+}
+
+// ServerGT applies the GT predicate on the "server" field.
+func ServerGT(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGT(FieldServer, v))
+}
+
+// ServerGTE applies the GTE predicate on the "server" field.
+func ServerGTE(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGTE(FieldServer, v))
+}
+
+// ServerLT applies the LT predicate on the "server" field.
+func ServerLT(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLT(FieldServer, v))
+}
+
+// ServerLTE applies the LTE predicate on the "server" field.
+func ServerLTE(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLTE(FieldServer, v))
+}
+
+// ServerContains applies the Contains predicate on the "server" field.
+func ServerContains(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldContains(FieldServer, v))
+}
+
+// ServerHasPrefix applies the HasPrefix predicate on the "server" field.
+func ServerHasPrefix(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldHasPrefix(FieldServer, v))
+}
+
+// ServerHasSuffix applies the HasSuffix predicate on the "server" field.
+func ServerHasSuffix(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldHasSuffix(FieldServer, v))
+}
+
+// ServerEqualFold applies the EqualFold predicate on the "server" field.
+func ServerEqualFold(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEqualFold(FieldServer, v))
+}
+
+// ServerContainsFold applies the ContainsFold predicate on the "server" field.
+func ServerContainsFold(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldContainsFold(FieldServer, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDContains applies the Contains predicate on the "account_id" field.
+func AccountIDContains(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldContains(FieldAccountID, v))
+}
+
+// AccountIDHasPrefix applies the HasPrefix predicate on the "account_id" field.
+func AccountIDHasPrefix(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldHasPrefix(FieldAccountID, v))
+}
+
+// AccountIDHasSuffix applies the HasSuffix predicate on the "account_id" field.
+func AccountIDHasSuffix(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldHasSuffix(FieldAccountID, v))
+}
+
+// AccountIDEqualFold applies the EqualFold predicate on the "account_id" field.
+func AccountIDEqualFold(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEqualFold(FieldAccountID, v))
+}
+
+// AccountIDContainsFold applies the ContainsFold predicate on the "account_id" field.
+func AccountIDContainsFold(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldContainsFold(FieldAccountID, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelIsNil applies the IsNil predicate on the "label" field.
+func LabelIsNil() predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldIsNull(FieldLabel))
+}
+
+// LabelNotNil applies the NotNil predicate on the "label" field.
+func LabelNotNil() predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNotNull(FieldLabel))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// VisibleEQ applies the EQ predicate on the "visible" field.
+func VisibleEQ(v bool) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldVisible, v))
+}
+
+// VisibleNEQ applies the NEQ predicate on the "visible" field.
+func VisibleNEQ(v bool) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNEQ(FieldVisible, v))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int) predicate.GameBinding {
+	return predicate.GameBinding(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GameBinding) predicate.GameBinding {
+	return predicate.GameBinding(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GameBinding) predicate.GameBinding {
+	return predicate.GameBinding(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GameBinding) predicate.GameBinding {
+	return predicate.GameBinding(sql.NotPredicates(p))
+}
@@ -0,0 +1,952 @@
+// Code generated by ent, DO NOT EDIT.
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/users/gamebinding"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GameBindingCreate is the builder for creating a GameBinding entity.
+type GameBindingCreate struct {
+	config
+	mutation *GameBindingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGame sets the "game" field.
+func (_c *GameBindingCreate) SetGame(v string) *GameBindingCreate {
+	_c.mutation.SetGame(v)
+	return _c
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_c *GameBindingCreate) SetHarukiUserID(v int) *GameBindingCreate {
+	_c.mutation.SetHarukiUserID(v)
+	return _c
+}
+
+// SetServer sets the "server" field.
+func (_c *GameBindingCreate) SetServer(v string) *GameBindingCreate {
+	_c.mutation.SetServer(v)
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *GameBindingCreate) SetAccountID(v string) *GameBindingCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *GameBindingCreate) SetLabel(v string) *GameBindingCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_c *GameBindingCreate) SetNillableLabel(v *string) *GameBindingCreate {
+	if v != nil {
+		_c.SetLabel(*v)
+	}
+	return _c
+}
+
+// SetVisible sets the "visible" field.
+func (_c *GameBindingCreate) SetVisible(v bool) *GameBindingCreate {
+	_c.mutation.SetVisible(v)
+	return _c
+}
+
+// SetNillableVisible sets the "visible" field if the given value is not nil.
+func (_c *GameBindingCreate) SetNillableVisible(v *bool) *GameBindingCreate {
+	if v != nil {
+		_c.SetVisible(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *GameBindingCreate) SetIsDefault(v bool) *GameBindingCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *GameBindingCreate) SetNillableIsDefault(v *bool) *GameBindingCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *GameBindingCreate) SetSortOrder(v int) *GameBindingCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_c *GameBindingCreate) SetNillableSortOrder(v *int) *GameBindingCreate {
+	if v != nil {
+		_c.SetSortOrder(*v)
+	}
+	return _c
+}
+
+// Mutation returns the GameBindingMutation object of the builder.
+func (_c *GameBindingCreate) Mutation() *GameBindingMutation {
+	return _c.mutation
+}
+
+// Save creates the GameBinding in the database.
+func (_c *GameBindingCreate) Save(ctx context.Context) (*GameBinding, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GameBindingCreate) SaveX(ctx context.Context) *GameBinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GameBindingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GameBindingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GameBindingCreate) defaults() {
+	if _, ok := _c.mutation.Visible(); !ok {
+		v := gamebinding.DefaultVisible
+		_c.mutation.SetVisible(v)
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		v := gamebinding.DefaultIsDefault
+		_c.mutation.SetIsDefault(v)
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		v := gamebinding.DefaultSortOrder
+		_c.mutation.SetSortOrder(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GameBindingCreate) check() error {
+	if _, ok := _c.mutation.Game(); !ok {
+		return &ValidationError{Name: "game", err: errors.New(`users: missing required field "GameBinding.game"`)}
+	}
+	if v, ok := _c.mutation.Game(); ok {
+		if err := gamebinding.GameValidator(v); err != nil {
+			return &ValidationError{Name: "game", err: fmt.Errorf(`users: validator failed for field "GameBinding.game": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HarukiUserID(); !ok {
+		return &ValidationError{Name: "haruki_user_id", err: errors.New(`users: missing required field "GameBinding.haruki_user_id"`)}
+	}
+	if _, ok := _c.mutation.Server(); !ok {
+		return &ValidationError{Name: "server", err: errors.New(`users: missing required field "GameBinding.server"`)}
+	}
+	if v, ok := _c.mutation.Server(); ok {
+		if err := gamebinding.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`users: validator failed for field "GameBinding.server": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`users: missing required field "GameBinding.account_id"`)}
+	}
+	if v, ok := _c.mutation.AccountID(); ok {
+		if err := gamebinding.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`users: validator failed for field "GameBinding.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Label(); ok {
+		if err := gamebinding.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`users: validator failed for field "GameBinding.label": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Visible(); !ok {
+		return &ValidationError{Name: "visible", err: errors.New(`users: missing required field "GameBinding.visible"`)}
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`users: missing required field "GameBinding.is_default"`)}
+	}
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`users: missing required field "GameBinding.sort_order"`)}
+	}
+	return nil
+}
+
+func (_c *GameBindingCreate) sqlSave(ctx context.Context) (*GameBinding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GameBindingCreate) createSpec() (*GameBinding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GameBinding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gamebinding.Table, sqlgraph.NewFieldSpec(gamebinding.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Game(); ok {
+		_spec.SetField(gamebinding.FieldGame, field.TypeString, value)
+		_node.Game = value
+	}
+	if value, ok := _c.mutation.HarukiUserID(); ok {
+		_spec.SetField(gamebinding.FieldHarukiUserID, field.TypeInt, value)
+		_node.HarukiUserID = value
+	}
+	if value, ok := _c.mutation.Server(); ok {
+		_spec.SetField(gamebinding.FieldServer, field.TypeString, value)
+		_node.Server = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(gamebinding.FieldAccountID, field.TypeString, value)
+		_node.AccountID = value
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(gamebinding.FieldLabel, field.TypeString, value)
+		_node.Label = &value
+	}
+	if value, ok := _c.mutation.Visible(); ok {
+		_spec.SetField(gamebinding.FieldVisible, field.TypeBool, value)
+		_node.Visible = value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(gamebinding.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(gamebinding.FieldSortOrder, field.TypeInt, value)
+		_node.SortOrder = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GameBinding.Create().
+//		SetGame(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GameBindingUpsert) {
+//			SetGame(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GameBindingCreate) OnConflict(opts ...sql.ConflictOption) *GameBindingUpsertOne {
+	_c.conflict = opts
+	return &GameBindingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GameBinding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GameBindingCreate) OnConflictColumns(columns ...string) *GameBindingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GameBindingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GameBindingUpsertOne is the builder for "upsert"-ing
+	//  one GameBinding node.
+	GameBindingUpsertOne struct {
+		create *GameBindingCreate
+	}
+
+	// GameBindingUpsert is the "OnConflict" setter.
+	GameBindingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGame sets the "game" field.
+func (u *GameBindingUpsert) SetGame(v string) *GameBindingUpsert {
+	u.Set(gamebinding.FieldGame, v)
+	return u
+}
+
+// UpdateGame sets the "game" field to the value that was provided on create.
+func (u *GameBindingUpsert) UpdateGame() *GameBindingUpsert {
+	u.SetExcluded(gamebinding.FieldGame)
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *GameBindingUpsert) SetHarukiUserID(v int) *GameBindingUpsert {
+	u.Set(gamebinding.FieldHarukiUserID, v)
+	return u
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *GameBindingUpsert) UpdateHarukiUserID() *GameBindingUpsert {
+	u.SetExcluded(gamebinding.FieldHarukiUserID)
+	return u
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *GameBindingUpsert) AddHarukiUserID(v int) *GameBindingUpsert {
+	u.Add(gamebinding.FieldHarukiUserID, v)
+	return u
+}
+
+// SetServer sets the "server" field.
+func (u *GameBindingUpsert) SetServer(v string) *GameBindingUpsert {
+	u.Set(gamebinding.FieldServer, v)
+	return u
+}
+
+// UpdateServer sets the "server" field to the value that was provided on create.
+func (u *GameBindingUpsert) UpdateServer() *GameBindingUpsert {
+	u.SetExcluded(gamebinding.FieldServer)
+	return u
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *GameBindingUpsert) SetAccountID(v string) *GameBindingUpsert {
+	u.Set(gamebinding.FieldAccountID, v)
+	return u
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *GameBindingUpsert) UpdateAccountID() *GameBindingUpsert {
+	u.SetExcluded(gamebinding.FieldAccountID)
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *GameBindingUpsert) SetLabel(v string) *GameBindingUpsert {
+	u.Set(gamebinding.FieldLabel, v)
+	return u
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *GameBindingUpsert) UpdateLabel() *GameBindingUpsert {
+	u.SetExcluded(gamebinding.FieldLabel)
+	return u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *GameBindingUpsert) ClearLabel() *GameBindingUpsert {
+	u.SetNull(gamebinding.FieldLabel)
+	return u
+}
+
+// SetVisible sets the "visible" field.
+func (u *GameBindingUpsert) SetVisible(v bool) *GameBindingUpsert {
+	u.Set(gamebinding.FieldVisible, v)
+	return u
+}
+
+// UpdateVisible sets the "visible" field to the value that was provided on create.
+func (u *GameBindingUpsert) UpdateVisible() *GameBindingUpsert {
+	u.SetExcluded(gamebinding.FieldVisible)
+	return u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *GameBindingUpsert) SetIsDefault(v bool) *GameBindingUpsert {
+	u.Set(gamebinding.FieldIsDefault, v)
+	return u
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *GameBindingUpsert) UpdateIsDefault() *GameBindingUpsert {
+	u.SetExcluded(gamebinding.FieldIsDefault)
+	return u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *GameBindingUpsert) SetSortOrder(v int) *GameBindingUpsert {
+	u.Set(gamebinding.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *GameBindingUpsert) UpdateSortOrder() *GameBindingUpsert {
+	u.SetExcluded(gamebinding.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *GameBindingUpsert) AddSortOrder(v int) *GameBindingUpsert {
+	u.Add(gamebinding.FieldSortOrder, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.GameBinding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GameBindingUpsertOne) UpdateNewValues() *GameBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GameBinding.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GameBindingUpsertOne) Ignore() *GameBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GameBindingUpsertOne) DoNothing() *GameBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GameBindingCreate.OnConflict
+// documentation for more info.
+func (u *GameBindingUpsertOne) Update(set func(*GameBindingUpsert)) *GameBindingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GameBindingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGame sets the "game" field.
+func (u *GameBindingUpsertOne) SetGame(v string) *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetGame(v)
+	})
+}
+
+// UpdateGame sets the "game" field to the value that was provided on create.
+func (u *GameBindingUpsertOne) UpdateGame() *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateGame()
+	})
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *GameBindingUpsertOne) SetHarukiUserID(v int) *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *GameBindingUpsertOne) AddHarukiUserID(v int) *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *GameBindingUpsertOne) UpdateHarukiUserID() *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetServer sets the "server" field.
+func (u *GameBindingUpsertOne) SetServer(v string) *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetServer(v)
+	})
+}
+
+// UpdateServer sets the "server" field to the value that was provided on create.
+func (u *GameBindingUpsertOne) UpdateServer() *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateServer()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *GameBindingUpsertOne) SetAccountID(v string) *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *GameBindingUpsertOne) UpdateAccountID() *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *GameBindingUpsertOne) SetLabel(v string) *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *GameBindingUpsertOne) UpdateLabel() *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *GameBindingUpsertOne) ClearLabel() *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.ClearLabel()
+	})
+}
+
+// SetVisible sets the "visible" field.
+func (u *GameBindingUpsertOne) SetVisible(v bool) *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetVisible(v)
+	})
+}
+
+// UpdateVisible sets the "visible" field to the value that was provided on create.
+func (u *GameBindingUpsertOne) UpdateVisible() *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateVisible()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *GameBindingUpsertOne) SetIsDefault(v bool) *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *GameBindingUpsertOne) UpdateIsDefault() *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *GameBindingUpsertOne) SetSortOrder(v int) *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *GameBindingUpsertOne) AddSortOrder(v int) *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *GameBindingUpsertOne) UpdateSortOrder() *GameBindingUpsertOne {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// Exec executes the query.
+func (u *GameBindingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("users: missing options for GameBindingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GameBindingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GameBindingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GameBindingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GameBindingCreateBulk is the builder for creating many GameBinding entities in bulk.
+type GameBindingCreateBulk struct {
+	config
+	err      error
+	builders []*GameBindingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GameBinding entities in the database.
+func (_c *GameBindingCreateBulk) Save(ctx context.Context) ([]*GameBinding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GameBinding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GameBindingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GameBindingCreateBulk) SaveX(ctx context.Context) []*GameBinding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GameBindingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GameBindingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GameBinding.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GameBindingUpsert) {
+//			SetGame(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GameBindingCreateBulk) OnConflict(opts ...sql.ConflictOption) *GameBindingUpsertBulk {
+	_c.conflict = opts
+	return &GameBindingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GameBinding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GameBindingCreateBulk) OnConflictColumns(columns ...string) *GameBindingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GameBindingUpsertBulk{
+		create: _c,
+	}
+}
+
+// GameBindingUpsertBulk is the builder for "upsert"-ing
+// a bulk of GameBinding nodes.
+type GameBindingUpsertBulk struct {
+	create *GameBindingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GameBinding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GameBindingUpsertBulk) UpdateNewValues() *GameBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GameBinding.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GameBindingUpsertBulk) Ignore() *GameBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GameBindingUpsertBulk) DoNothing() *GameBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GameBindingCreateBulk.OnConflict
+// documentation for more info.
+func (u *GameBindingUpsertBulk) Update(set func(*GameBindingUpsert)) *GameBindingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GameBindingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGame sets the "game" field.
+func (u *GameBindingUpsertBulk) SetGame(v string) *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetGame(v)
+	})
+}
+
+// UpdateGame sets the "game" field to the value that was provided on create.
+func (u *GameBindingUpsertBulk) UpdateGame() *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateGame()
+	})
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *GameBindingUpsertBulk) SetHarukiUserID(v int) *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *GameBindingUpsertBulk) AddHarukiUserID(v int) *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *GameBindingUpsertBulk) UpdateHarukiUserID() *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetServer sets the "server" field.
+func (u *GameBindingUpsertBulk) SetServer(v string) *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetServer(v)
+	})
+}
+
+// UpdateServer sets the "server" field to the value that was provided on create.
+func (u *GameBindingUpsertBulk) UpdateServer() *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateServer()
+	})
+}
+
+// SetAccountID sets the "account_id" field.
+func (u *GameBindingUpsertBulk) SetAccountID(v string) *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetAccountID(v)
+	})
+}
+
+// UpdateAccountID sets the "account_id" field to the value that was provided on create.
+func (u *GameBindingUpsertBulk) UpdateAccountID() *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateAccountID()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *GameBindingUpsertBulk) SetLabel(v string) *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *GameBindingUpsertBulk) UpdateLabel() *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *GameBindingUpsertBulk) ClearLabel() *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.ClearLabel()
+	})
+}
+
+// SetVisible sets the "visible" field.
+func (u *GameBindingUpsertBulk) SetVisible(v bool) *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetVisible(v)
+	})
+}
+
+// UpdateVisible sets the "visible" field to the value that was provided on create.
+func (u *GameBindingUpsertBulk) UpdateVisible() *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateVisible()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *GameBindingUpsertBulk) SetIsDefault(v bool) *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *GameBindingUpsertBulk) UpdateIsDefault() *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *GameBindingUpsertBulk) SetSortOrder(v int) *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *GameBindingUpsertBulk) AddSortOrder(v int) *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *GameBindingUpsertBulk) UpdateSortOrder() *GameBindingUpsertBulk {
+	return u.Update(func(s *GameBindingUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// Exec executes the query.
+func (u *GameBindingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("users: OnConflict was set for builder %d. Set it on the GameBindingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("users: missing options for GameBindingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GameBindingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package users
+
+import (
+	"context"
+	"haruki-database/database/schema/users/gamebinding"
+	"haruki-database/database/schema/users/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GameBindingDelete is the builder for deleting a GameBinding entity.
+type GameBindingDelete struct {
+	config
+	hooks    []Hook
+	mutation *GameBindingMutation
+}
+
+// Where appends a list predicates to the GameBindingDelete builder.
+func (_d *GameBindingDelete) Where(ps ...predicate.GameBinding) *GameBindingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GameBindingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GameBindingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GameBindingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(gamebinding.Table, sqlgraph.NewFieldSpec(gamebinding.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GameBindingDeleteOne is the builder for deleting a single GameBinding entity.
+type GameBindingDeleteOne struct {
+	_d *GameBindingDelete
+}
+
+// Where appends a list predicates to the GameBindingDelete builder.
+func (_d *GameBindingDeleteOne) Where(ps ...predicate.GameBinding) *GameBindingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GameBindingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{gamebinding.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GameBindingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
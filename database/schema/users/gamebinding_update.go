@@ -0,0 +1,561 @@
+// Code generated by ent, DO NOT EDIT.
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/users/gamebinding"
+	"haruki-database/database/schema/users/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GameBindingUpdate is the builder for updating GameBinding entities.
+type GameBindingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GameBindingMutation
+}
+
+// Where appends a list predicates to the GameBindingUpdate builder.
+func (_u *GameBindingUpdate) Where(ps ...predicate.GameBinding) *GameBindingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGame sets the "game" field.
+func (_u *GameBindingUpdate) SetGame(v string) *GameBindingUpdate {
+	_u.mutation.SetGame(v)
+	return _u
+}
+
+// SetNillableGame sets the "game" field if the given value is not nil.
+func (_u *GameBindingUpdate) SetNillableGame(v *string) *GameBindingUpdate {
+	if v != nil {
+		_u.SetGame(*v)
+	}
+	return _u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_u *GameBindingUpdate) SetHarukiUserID(v int) *GameBindingUpdate {
+	_u.mutation.ResetHarukiUserID()
+	_u.mutation.SetHarukiUserID(v)
+	return _u
+}
+
+// SetNillableHarukiUserID sets the "haruki_user_id" field if the given value is not nil.
+func (_u *GameBindingUpdate) SetNillableHarukiUserID(v *int) *GameBindingUpdate {
+	if v != nil {
+		_u.SetHarukiUserID(*v)
+	}
+	return _u
+}
+
+// AddHarukiUserID adds value to the "haruki_user_id" field.
+func (_u *GameBindingUpdate) AddHarukiUserID(v int) *GameBindingUpdate {
+	_u.mutation.AddHarukiUserID(v)
+	return _u
+}
+
+// SetServer sets the "server" field.
+func (_u *GameBindingUpdate) SetServer(v string) *GameBindingUpdate {
+	_u.mutation.SetServer(v)
+	return _u
+}
+
+// SetNillableServer sets the "server" field if the given value is not nil.
+func (_u *GameBindingUpdate) SetNillableServer(v *string) *GameBindingUpdate {
+	if v != nil {
+		_u.SetServer(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *GameBindingUpdate) SetAccountID(v string) *GameBindingUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *GameBindingUpdate) SetNillableAccountID(v *string) *GameBindingUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *GameBindingUpdate) SetLabel(v string) *GameBindingUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *GameBindingUpdate) SetNillableLabel(v *string) *GameBindingUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *GameBindingUpdate) ClearLabel() *GameBindingUpdate {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetVisible sets the "visible" field.
+func (_u *GameBindingUpdate) SetVisible(v bool) *GameBindingUpdate {
+	_u.mutation.SetVisible(v)
+	return _u
+}
+
+// SetNillableVisible sets the "visible" field if the given value is not nil.
+func (_u *GameBindingUpdate) SetNillableVisible(v *bool) *GameBindingUpdate {
+	if v != nil {
+		_u.SetVisible(*v)
+	}
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *GameBindingUpdate) SetIsDefault(v bool) *GameBindingUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *GameBindingUpdate) SetNillableIsDefault(v *bool) *GameBindingUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *GameBindingUpdate) SetSortOrder(v int) *GameBindingUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *GameBindingUpdate) SetNillableSortOrder(v *int) *GameBindingUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *GameBindingUpdate) AddSortOrder(v int) *GameBindingUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// Mutation returns the GameBindingMutation object of the builder.
+func (_u *GameBindingUpdate) Mutation() *GameBindingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GameBindingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GameBindingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GameBindingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GameBindingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GameBindingUpdate) check() error {
+	if v, ok := _u.mutation.Game(); ok {
+		if err := gamebinding.GameValidator(v); err != nil {
+			return &ValidationError{Name: "game", err: fmt.Errorf(`users: validator failed for field "GameBinding.game": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Server(); ok {
+		if err := gamebinding.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`users: validator failed for field "GameBinding.server": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := gamebinding.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`users: validator failed for field "GameBinding.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := gamebinding.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`users: validator failed for field "GameBinding.label": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GameBindingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gamebinding.Table, gamebinding.Columns, sqlgraph.NewFieldSpec(gamebinding.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Game(); ok {
+		_spec.SetField(gamebinding.FieldGame, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HarukiUserID(); ok {
+		_spec.SetField(gamebinding.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHarukiUserID(); ok {
+		_spec.AddField(gamebinding.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Server(); ok {
+		_spec.SetField(gamebinding.FieldServer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(gamebinding.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(gamebinding.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(gamebinding.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Visible(); ok {
+		_spec.SetField(gamebinding.FieldVisible, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(gamebinding.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(gamebinding.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(gamebinding.FieldSortOrder, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gamebinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GameBindingUpdateOne is the builder for updating a single GameBinding entity.
+type GameBindingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GameBindingMutation
+}
+
+// SetGame sets the "game" field.
+func (_u *GameBindingUpdateOne) SetGame(v string) *GameBindingUpdateOne {
+	_u.mutation.SetGame(v)
+	return _u
+}
+
+// SetNillableGame sets the "game" field if the given value is not nil.
+func (_u *GameBindingUpdateOne) SetNillableGame(v *string) *GameBindingUpdateOne {
+	if v != nil {
+		_u.SetGame(*v)
+	}
+	return _u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_u *GameBindingUpdateOne) SetHarukiUserID(v int) *GameBindingUpdateOne {
+	_u.mutation.ResetHarukiUserID()
+	_u.mutation.SetHarukiUserID(v)
+	return _u
+}
+
+// SetNillableHarukiUserID sets the "haruki_user_id" field if the given value is not nil.
+func (_u *GameBindingUpdateOne) SetNillableHarukiUserID(v *int) *GameBindingUpdateOne {
+	if v != nil {
+		_u.SetHarukiUserID(*v)
+	}
+	return _u
+}
+
+// AddHarukiUserID adds value to the "haruki_user_id" field.
+func (_u *GameBindingUpdateOne) AddHarukiUserID(v int) *GameBindingUpdateOne {
+	_u.mutation.AddHarukiUserID(v)
+	return _u
+}
+
+// SetServer sets the "server" field.
+func (_u *GameBindingUpdateOne) SetServer(v string) *GameBindingUpdateOne {
+	_u.mutation.SetServer(v)
+	return _u
+}
+
+// SetNillableServer sets the "server" field if the given value is not nil.
+func (_u *GameBindingUpdateOne) SetNillableServer(v *string) *GameBindingUpdateOne {
+	if v != nil {
+		_u.SetServer(*v)
+	}
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *GameBindingUpdateOne) SetAccountID(v string) *GameBindingUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *GameBindingUpdateOne) SetNillableAccountID(v *string) *GameBindingUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *GameBindingUpdateOne) SetLabel(v string) *GameBindingUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *GameBindingUpdateOne) SetNillableLabel(v *string) *GameBindingUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *GameBindingUpdateOne) ClearLabel() *GameBindingUpdateOne {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetVisible sets the "visible" field.
+func (_u *GameBindingUpdateOne) SetVisible(v bool) *GameBindingUpdateOne {
+	_u.mutation.SetVisible(v)
+	return _u
+}
+
+// SetNillableVisible sets the "visible" field if the given value is not nil.
+func (_u *GameBindingUpdateOne) SetNillableVisible(v *bool) *GameBindingUpdateOne {
+	if v != nil {
+		_u.SetVisible(*v)
+	}
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *GameBindingUpdateOne) SetIsDefault(v bool) *GameBindingUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *GameBindingUpdateOne) SetNillableIsDefault(v *bool) *GameBindingUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *GameBindingUpdateOne) SetSortOrder(v int) *GameBindingUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *GameBindingUpdateOne) SetNillableSortOrder(v *int) *GameBindingUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *GameBindingUpdateOne) AddSortOrder(v int) *GameBindingUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// Mutation returns the GameBindingMutation object of the builder.
+func (_u *GameBindingUpdateOne) Mutation() *GameBindingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GameBindingUpdate builder.
+func (_u *GameBindingUpdateOne) Where(ps ...predicate.GameBinding) *GameBindingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GameBindingUpdateOne) Select(field string, fields ...string) *GameBindingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GameBinding entity.
+func (_u *GameBindingUpdateOne) Save(ctx context.Context) (*GameBinding, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GameBindingUpdateOne) SaveX(ctx context.Context) *GameBinding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GameBindingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GameBindingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GameBindingUpdateOne) check() error {
+	if v, ok := _u.mutation.Game(); ok {
+		if err := gamebinding.GameValidator(v); err != nil {
+			return &ValidationError{Name: "game", err: fmt.Errorf(`users: validator failed for field "GameBinding.game": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Server(); ok {
+		if err := gamebinding.ServerValidator(v); err != nil {
+			return &ValidationError{Name: "server", err: fmt.Errorf(`users: validator failed for field "GameBinding.server": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountID(); ok {
+		if err := gamebinding.AccountIDValidator(v); err != nil {
+			return &ValidationError{Name: "account_id", err: fmt.Errorf(`users: validator failed for field "GameBinding.account_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := gamebinding.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`users: validator failed for field "GameBinding.label": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GameBindingUpdateOne) sqlSave(ctx context.Context) (_node *GameBinding, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gamebinding.Table, gamebinding.Columns, sqlgraph.NewFieldSpec(gamebinding.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`users: missing "GameBinding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gamebinding.FieldID)
+		for _, f := range fields {
+			if !gamebinding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("users: invalid field %q for query", f)}
+			}
+			if f != gamebinding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Game(); ok {
+		_spec.SetField(gamebinding.FieldGame, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HarukiUserID(); ok {
+		_spec.SetField(gamebinding.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHarukiUserID(); ok {
+		_spec.AddField(gamebinding.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Server(); ok {
+		_spec.SetField(gamebinding.FieldServer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(gamebinding.FieldAccountID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(gamebinding.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(gamebinding.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Visible(); ok {
+		_spec.SetField(gamebinding.FieldVisible, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(gamebinding.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(gamebinding.FieldSortOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(gamebinding.FieldSortOrder, field.TypeInt, value)
+	}
+	_node = &GameBinding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gamebinding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package users
+
+import (
+	"fmt"
+	"haruki-database/database/schema/users/gamebindinglock"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// GameBindingLock is the model entity for the GameBindingLock schema.
+type GameBindingLock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Game name declared in the game_bindings config
+	Game string `json:"game,omitempty"`
+	// Reference to users table
+	HarukiUserID int `json:"haruki_user_id,omitempty"`
+	// Time of the last add that took the lock
+	LockedAt     time.Time `json:"locked_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GameBindingLock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gamebindinglock.FieldID, gamebindinglock.FieldHarukiUserID:
+			values[i] = new(sql.NullInt64)
+		case gamebindinglock.FieldGame:
+			values[i] = new(sql.NullString)
+		case gamebindinglock.FieldLockedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GameBindingLock fields.
+func (_m *GameBindingLock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gamebindinglock.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case gamebindinglock.FieldGame:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field game", values[i])
+			} else if value.Valid {
+				_m.Game = value.String
+			}
+		case gamebindinglock.FieldHarukiUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field haruki_user_id", values[i])
+			} else if value.Valid {
+				_m.HarukiUserID = int(value.Int64)
+			}
+		case gamebindinglock.FieldLockedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_at", values[i])
+			} else if value.Valid {
+				_m.LockedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GameBindingLock.
+// This includes values selected through modifiers, order, etc.
+func (_m *GameBindingLock) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GameBindingLock.
+// Note that you need to call GameBindingLock.Unwrap() before calling this method if this GameBindingLock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GameBindingLock) Update() *GameBindingLockUpdateOne {
+	return NewGameBindingLockClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GameBindingLock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GameBindingLock) Unwrap() *GameBindingLock {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("users: GameBindingLock is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GameBindingLock) String() string {
+	var builder strings.Builder
+	builder.WriteString("GameBindingLock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("game=")
+	builder.WriteString(_m.Game)
+	builder.WriteString(", ")
+	builder.WriteString("haruki_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HarukiUserID))
+	builder.WriteString(", ")
+	builder.WriteString("locked_at=")
+	builder.WriteString(_m.LockedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GameBindingLocks is a parsable slice of GameBindingLock.
+type GameBindingLocks []*GameBindingLock
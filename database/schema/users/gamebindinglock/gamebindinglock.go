@@ -0,0 +1,68 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gamebindinglock
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the gamebindinglock type in the database.
+	Label = "game_binding_lock"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGame holds the string denoting the game field in the database.
+	FieldGame = "game"
+	// FieldHarukiUserID holds the string denoting the haruki_user_id field in the database.
+	FieldHarukiUserID = "haruki_user_id"
+	// FieldLockedAt holds the string denoting the locked_at field in the database.
+	FieldLockedAt = "locked_at"
+	// Table holds the table name of the gamebindinglock in the database.
+	Table = "game_binding_locks"
+)
+
+// Columns holds all SQL columns for gamebindinglock fields.
+var Columns = []string{
+	FieldID,
+	FieldGame,
+	FieldHarukiUserID,
+	FieldLockedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// GameValidator is a validator for the "game" field. It is called by the builders before save.
+	GameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the GameBindingLock queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGame orders the results by the game field.
+func ByGame(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGame, opts...).ToFunc()
+}
+
+// ByHarukiUserID orders the results by the haruki_user_id field.
+func ByHarukiUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHarukiUserID, opts...).ToFunc()
+}
+
+// ByLockedAt orders the results by the locked_at field.
+func ByLockedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedAt, opts...).ToFunc()
+}
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gamebindinglock
+
+import (
+	"haruki-database/database/schema/users/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldLTE(FieldID, id))
+}
+
+// Game applies equality check predicate on the "game" field. It's identical to GameEQ.
+func Game(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldEQ(FieldGame, v))
+}
+
+// HarukiUserID applies equality check predicate on the "haruki_user_id" field. It's identical to HarukiUserIDEQ.
+func HarukiUserID(v int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldEQ(FieldHarukiUserID, v))
+}
+
+// LockedAt applies equality check predicate on the "locked_at" field. It's identical to LockedAtEQ.
+func LockedAt(v time.Time) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// GameEQ applies the EQ predicate on the "game" field.
+func GameEQ(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldEQ(FieldGame, v))
+}
+
+// GameNEQ applies the NEQ predicate on the "game" field.
+func GameNEQ(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldNEQ(FieldGame, v))
+}
+
+// GameIn applies the In predicate on the "game" field.
+func GameIn(vs ...string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldIn(FieldGame, vs...))
+}
+
+// GameNotIn applies the NotIn predicate on the "game" field.
+func GameNotIn(vs ...string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldNotIn(FieldGame, vs...))
+}
+
+// GameGT applies the GT predicate on the "game" field.
+func GameGT(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldGT(FieldGame, v))
+}
+
+// GameGTE applies the GTE predicate on the "game" field.
+func GameGTE(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldGTE(FieldGame, v))
+}
+
+// GameLT applies the LT predicate on the "game" field.
+func GameLT(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldLT(FieldGame, v))
+}
+
+// GameLTE applies the LTE predicate on the "game" field.
+func GameLTE(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldLTE(FieldGame, v))
+}
+
+// GameContains applies the Contains predicate on the "game" field.
+func GameContains(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldContains(FieldGame, v))
+}
+
+// GameHasPrefix applies the HasPrefix predicate on the "game" field.
+func GameHasPrefix(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldHasPrefix(FieldGame, v))
+}
+
+// GameHasSuffix applies the HasSuffix predicate on the "game" field.
+func GameHasSuffix(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldHasSuffix(FieldGame, v))
+}
+
+// GameEqualFold applies the EqualFold predicate on the "game" field.
+func GameEqualFold(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldEqualFold(FieldGame, v))
+}
+
+// GameContainsFold applies the ContainsFold predicate on the "game" field.
+func GameContainsFold(v string) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldContainsFold(FieldGame, v))
+}
+
+// HarukiUserIDEQ applies the EQ predicate on the "haruki_user_id" field.
+func HarukiUserIDEQ(v int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldEQ(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDNEQ applies the NEQ predicate on the "haruki_user_id" field.
+func HarukiUserIDNEQ(v int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldNEQ(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDIn applies the In predicate on the "haruki_user_id" field.
+func HarukiUserIDIn(vs ...int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldIn(FieldHarukiUserID, vs...))
+}
+
+// HarukiUserIDNotIn applies the NotIn predicate on the "haruki_user_id" field.
+func HarukiUserIDNotIn(vs ...int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldNotIn(FieldHarukiUserID, vs...))
+}
+
+// HarukiUserIDGT applies the GT predicate on the "haruki_user_id" field.
+func HarukiUserIDGT(v int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldGT(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDGTE applies the GTE predicate on the "haruki_user_id" field.
+func HarukiUserIDGTE(v int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldGTE(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDLT applies the LT predicate on the "haruki_user_id" field.
+func HarukiUserIDLT(v int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldLT(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDLTE applies the LTE predicate on the "haruki_user_id" field.
+func HarukiUserIDLTE(v int) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldLTE(FieldHarukiUserID, v))
+}
+
+// LockedAtEQ applies the EQ predicate on the "locked_at" field.
+func LockedAtEQ(v time.Time) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// LockedAtNEQ applies the NEQ predicate on the "locked_at" field.
+func LockedAtNEQ(v time.Time) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldNEQ(FieldLockedAt, v))
+}
+
+// LockedAtIn applies the In predicate on the "locked_at" field.
+func LockedAtIn(vs ...time.Time) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldIn(FieldLockedAt, vs...))
+}
+
+// LockedAtNotIn applies the NotIn predicate on the "locked_at" field.
+func LockedAtNotIn(vs ...time.Time) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldNotIn(FieldLockedAt, vs...))
+}
+
+// LockedAtGT applies the GT predicate on the "locked_at" field.
+func LockedAtGT(v time.Time) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldGT(FieldLockedAt, v))
+}
+
+// LockedAtGTE applies the GTE predicate on the "locked_at" field.
+func LockedAtGTE(v time.Time) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldGTE(FieldLockedAt, v))
+}
+
+// LockedAtLT applies the LT predicate on the "locked_at" field.
+func LockedAtLT(v time.Time) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldLT(FieldLockedAt, v))
+}
+
+// LockedAtLTE applies the LTE predicate on the "locked_at" field.
+func LockedAtLTE(v time.Time) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.FieldLTE(FieldLockedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GameBindingLock) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GameBindingLock) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GameBindingLock) predicate.GameBindingLock {
+	return predicate.GameBindingLock(sql.NotPredicates(p))
+}
@@ -0,0 +1,586 @@
+// Code generated by ent, DO NOT EDIT.
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/users/gamebindinglock"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GameBindingLockCreate is the builder for creating a GameBindingLock entity.
+type GameBindingLockCreate struct {
+	config
+	mutation *GameBindingLockMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGame sets the "game" field.
+func (_c *GameBindingLockCreate) SetGame(v string) *GameBindingLockCreate {
+	_c.mutation.SetGame(v)
+	return _c
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_c *GameBindingLockCreate) SetHarukiUserID(v int) *GameBindingLockCreate {
+	_c.mutation.SetHarukiUserID(v)
+	return _c
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_c *GameBindingLockCreate) SetLockedAt(v time.Time) *GameBindingLockCreate {
+	_c.mutation.SetLockedAt(v)
+	return _c
+}
+
+// Mutation returns the GameBindingLockMutation object of the builder.
+func (_c *GameBindingLockCreate) Mutation() *GameBindingLockMutation {
+	return _c.mutation
+}
+
+// Save creates the GameBindingLock in the database.
+func (_c *GameBindingLockCreate) Save(ctx context.Context) (*GameBindingLock, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GameBindingLockCreate) SaveX(ctx context.Context) *GameBindingLock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GameBindingLockCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GameBindingLockCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GameBindingLockCreate) check() error {
+	if _, ok := _c.mutation.Game(); !ok {
+		return &ValidationError{Name: "game", err: errors.New(`users: missing required field "GameBindingLock.game"`)}
+	}
+	if v, ok := _c.mutation.Game(); ok {
+		if err := gamebindinglock.GameValidator(v); err != nil {
+			return &ValidationError{Name: "game", err: fmt.Errorf(`users: validator failed for field "GameBindingLock.game": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HarukiUserID(); !ok {
+		return &ValidationError{Name: "haruki_user_id", err: errors.New(`users: missing required field "GameBindingLock.haruki_user_id"`)}
+	}
+	if _, ok := _c.mutation.LockedAt(); !ok {
+		return &ValidationError{Name: "locked_at", err: errors.New(`users: missing required field "GameBindingLock.locked_at"`)}
+	}
+	return nil
+}
+
+func (_c *GameBindingLockCreate) sqlSave(ctx context.Context) (*GameBindingLock, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GameBindingLockCreate) createSpec() (*GameBindingLock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GameBindingLock{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gamebindinglock.Table, sqlgraph.NewFieldSpec(gamebindinglock.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Game(); ok {
+		_spec.SetField(gamebindinglock.FieldGame, field.TypeString, value)
+		_node.Game = value
+	}
+	if value, ok := _c.mutation.HarukiUserID(); ok {
+		_spec.SetField(gamebindinglock.FieldHarukiUserID, field.TypeInt, value)
+		_node.HarukiUserID = value
+	}
+	if value, ok := _c.mutation.LockedAt(); ok {
+		_spec.SetField(gamebindinglock.FieldLockedAt, field.TypeTime, value)
+		_node.LockedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GameBindingLock.Create().
+//		SetGame(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GameBindingLockUpsert) {
+//			SetGame(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GameBindingLockCreate) OnConflict(opts ...sql.ConflictOption) *GameBindingLockUpsertOne {
+	_c.conflict = opts
+	return &GameBindingLockUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GameBindingLock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GameBindingLockCreate) OnConflictColumns(columns ...string) *GameBindingLockUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GameBindingLockUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GameBindingLockUpsertOne is the builder for "upsert"-ing
+	//  one GameBindingLock node.
+	GameBindingLockUpsertOne struct {
+		create *GameBindingLockCreate
+	}
+
+	// GameBindingLockUpsert is the "OnConflict" setter.
+	GameBindingLockUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGame sets the "game" field.
+func (u *GameBindingLockUpsert) SetGame(v string) *GameBindingLockUpsert {
+	u.Set(gamebindinglock.FieldGame, v)
+	return u
+}
+
+// UpdateGame sets the "game" field to the value that was provided on create.
+func (u *GameBindingLockUpsert) UpdateGame() *GameBindingLockUpsert {
+	u.SetExcluded(gamebindinglock.FieldGame)
+	return u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *GameBindingLockUpsert) SetHarukiUserID(v int) *GameBindingLockUpsert {
+	u.Set(gamebindinglock.FieldHarukiUserID, v)
+	return u
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *GameBindingLockUpsert) UpdateHarukiUserID() *GameBindingLockUpsert {
+	u.SetExcluded(gamebindinglock.FieldHarukiUserID)
+	return u
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *GameBindingLockUpsert) AddHarukiUserID(v int) *GameBindingLockUpsert {
+	u.Add(gamebindinglock.FieldHarukiUserID, v)
+	return u
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (u *GameBindingLockUpsert) SetLockedAt(v time.Time) *GameBindingLockUpsert {
+	u.Set(gamebindinglock.FieldLockedAt, v)
+	return u
+}
+
+// UpdateLockedAt sets the "locked_at" field to the value that was provided on create.
+func (u *GameBindingLockUpsert) UpdateLockedAt() *GameBindingLockUpsert {
+	u.SetExcluded(gamebindinglock.FieldLockedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.GameBindingLock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GameBindingLockUpsertOne) UpdateNewValues() *GameBindingLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GameBindingLock.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GameBindingLockUpsertOne) Ignore() *GameBindingLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GameBindingLockUpsertOne) DoNothing() *GameBindingLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GameBindingLockCreate.OnConflict
+// documentation for more info.
+func (u *GameBindingLockUpsertOne) Update(set func(*GameBindingLockUpsert)) *GameBindingLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GameBindingLockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGame sets the "game" field.
+func (u *GameBindingLockUpsertOne) SetGame(v string) *GameBindingLockUpsertOne {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.SetGame(v)
+	})
+}
+
+// UpdateGame sets the "game" field to the value that was provided on create.
+func (u *GameBindingLockUpsertOne) UpdateGame() *GameBindingLockUpsertOne {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.UpdateGame()
+	})
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *GameBindingLockUpsertOne) SetHarukiUserID(v int) *GameBindingLockUpsertOne {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *GameBindingLockUpsertOne) AddHarukiUserID(v int) *GameBindingLockUpsertOne {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *GameBindingLockUpsertOne) UpdateHarukiUserID() *GameBindingLockUpsertOne {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (u *GameBindingLockUpsertOne) SetLockedAt(v time.Time) *GameBindingLockUpsertOne {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.SetLockedAt(v)
+	})
+}
+
+// UpdateLockedAt sets the "locked_at" field to the value that was provided on create.
+func (u *GameBindingLockUpsertOne) UpdateLockedAt() *GameBindingLockUpsertOne {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.UpdateLockedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GameBindingLockUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("users: missing options for GameBindingLockCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GameBindingLockUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GameBindingLockUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GameBindingLockUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GameBindingLockCreateBulk is the builder for creating many GameBindingLock entities in bulk.
+type GameBindingLockCreateBulk struct {
+	config
+	err      error
+	builders []*GameBindingLockCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GameBindingLock entities in the database.
+func (_c *GameBindingLockCreateBulk) Save(ctx context.Context) ([]*GameBindingLock, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GameBindingLock, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GameBindingLockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GameBindingLockCreateBulk) SaveX(ctx context.Context) []*GameBindingLock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GameBindingLockCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GameBindingLockCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GameBindingLock.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GameBindingLockUpsert) {
+//			SetGame(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GameBindingLockCreateBulk) OnConflict(opts ...sql.ConflictOption) *GameBindingLockUpsertBulk {
+	_c.conflict = opts
+	return &GameBindingLockUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GameBindingLock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GameBindingLockCreateBulk) OnConflictColumns(columns ...string) *GameBindingLockUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GameBindingLockUpsertBulk{
+		create: _c,
+	}
+}
+
+// GameBindingLockUpsertBulk is the builder for "upsert"-ing
+// a bulk of GameBindingLock nodes.
+type GameBindingLockUpsertBulk struct {
+	create *GameBindingLockCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GameBindingLock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GameBindingLockUpsertBulk) UpdateNewValues() *GameBindingLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GameBindingLock.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GameBindingLockUpsertBulk) Ignore() *GameBindingLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GameBindingLockUpsertBulk) DoNothing() *GameBindingLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GameBindingLockCreateBulk.OnConflict
+// documentation for more info.
+func (u *GameBindingLockUpsertBulk) Update(set func(*GameBindingLockUpsert)) *GameBindingLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GameBindingLockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGame sets the "game" field.
+func (u *GameBindingLockUpsertBulk) SetGame(v string) *GameBindingLockUpsertBulk {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.SetGame(v)
+	})
+}
+
+// UpdateGame sets the "game" field to the value that was provided on create.
+func (u *GameBindingLockUpsertBulk) UpdateGame() *GameBindingLockUpsertBulk {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.UpdateGame()
+	})
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (u *GameBindingLockUpsertBulk) SetHarukiUserID(v int) *GameBindingLockUpsertBulk {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.SetHarukiUserID(v)
+	})
+}
+
+// AddHarukiUserID adds v to the "haruki_user_id" field.
+func (u *GameBindingLockUpsertBulk) AddHarukiUserID(v int) *GameBindingLockUpsertBulk {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.AddHarukiUserID(v)
+	})
+}
+
+// UpdateHarukiUserID sets the "haruki_user_id" field to the value that was provided on create.
+func (u *GameBindingLockUpsertBulk) UpdateHarukiUserID() *GameBindingLockUpsertBulk {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.UpdateHarukiUserID()
+	})
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (u *GameBindingLockUpsertBulk) SetLockedAt(v time.Time) *GameBindingLockUpsertBulk {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.SetLockedAt(v)
+	})
+}
+
+// UpdateLockedAt sets the "locked_at" field to the value that was provided on create.
+func (u *GameBindingLockUpsertBulk) UpdateLockedAt() *GameBindingLockUpsertBulk {
+	return u.Update(func(s *GameBindingLockUpsert) {
+		s.UpdateLockedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GameBindingLockUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("users: OnConflict was set for builder %d. Set it on the GameBindingLockCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("users: missing options for GameBindingLockCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GameBindingLockUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package users
+
+import (
+	"context"
+	"haruki-database/database/schema/users/gamebindinglock"
+	"haruki-database/database/schema/users/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GameBindingLockDelete is the builder for deleting a GameBindingLock entity.
+type GameBindingLockDelete struct {
+	config
+	hooks    []Hook
+	mutation *GameBindingLockMutation
+}
+
+// Where appends a list predicates to the GameBindingLockDelete builder.
+func (_d *GameBindingLockDelete) Where(ps ...predicate.GameBindingLock) *GameBindingLockDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GameBindingLockDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GameBindingLockDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GameBindingLockDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(gamebindinglock.Table, sqlgraph.NewFieldSpec(gamebindinglock.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GameBindingLockDeleteOne is the builder for deleting a single GameBindingLock entity.
+type GameBindingLockDeleteOne struct {
+	_d *GameBindingLockDelete
+}
+
+// Where appends a list predicates to the GameBindingLockDelete builder.
+func (_d *GameBindingLockDeleteOne) Where(ps ...predicate.GameBindingLock) *GameBindingLockDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GameBindingLockDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{gamebindinglock.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GameBindingLockDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/users/gamebindinglock"
+	"haruki-database/database/schema/users/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GameBindingLockUpdate is the builder for updating GameBindingLock entities.
+type GameBindingLockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GameBindingLockMutation
+}
+
+// Where appends a list predicates to the GameBindingLockUpdate builder.
+func (_u *GameBindingLockUpdate) Where(ps ...predicate.GameBindingLock) *GameBindingLockUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGame sets the "game" field.
+func (_u *GameBindingLockUpdate) SetGame(v string) *GameBindingLockUpdate {
+	_u.mutation.SetGame(v)
+	return _u
+}
+
+// SetNillableGame sets the "game" field if the given value is not nil.
+func (_u *GameBindingLockUpdate) SetNillableGame(v *string) *GameBindingLockUpdate {
+	if v != nil {
+		_u.SetGame(*v)
+	}
+	return _u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_u *GameBindingLockUpdate) SetHarukiUserID(v int) *GameBindingLockUpdate {
+	_u.mutation.ResetHarukiUserID()
+	_u.mutation.SetHarukiUserID(v)
+	return _u
+}
+
+// SetNillableHarukiUserID sets the "haruki_user_id" field if the given value is not nil.
+func (_u *GameBindingLockUpdate) SetNillableHarukiUserID(v *int) *GameBindingLockUpdate {
+	if v != nil {
+		_u.SetHarukiUserID(*v)
+	}
+	return _u
+}
+
+// AddHarukiUserID adds value to the "haruki_user_id" field.
+func (_u *GameBindingLockUpdate) AddHarukiUserID(v int) *GameBindingLockUpdate {
+	_u.mutation.AddHarukiUserID(v)
+	return _u
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_u *GameBindingLockUpdate) SetLockedAt(v time.Time) *GameBindingLockUpdate {
+	_u.mutation.SetLockedAt(v)
+	return _u
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_u *GameBindingLockUpdate) SetNillableLockedAt(v *time.Time) *GameBindingLockUpdate {
+	if v != nil {
+		_u.SetLockedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the GameBindingLockMutation object of the builder.
+func (_u *GameBindingLockUpdate) Mutation() *GameBindingLockMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GameBindingLockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GameBindingLockUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GameBindingLockUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GameBindingLockUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GameBindingLockUpdate) check() error {
+	if v, ok := _u.mutation.Game(); ok {
+		if err := gamebindinglock.GameValidator(v); err != nil {
+			return &ValidationError{Name: "game", err: fmt.Errorf(`users: validator failed for field "GameBindingLock.game": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GameBindingLockUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gamebindinglock.Table, gamebindinglock.Columns, sqlgraph.NewFieldSpec(gamebindinglock.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Game(); ok {
+		_spec.SetField(gamebindinglock.FieldGame, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HarukiUserID(); ok {
+		_spec.SetField(gamebindinglock.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHarukiUserID(); ok {
+		_spec.AddField(gamebindinglock.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedAt(); ok {
+		_spec.SetField(gamebindinglock.FieldLockedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gamebindinglock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GameBindingLockUpdateOne is the builder for updating a single GameBindingLock entity.
+type GameBindingLockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GameBindingLockMutation
+}
+
+// SetGame sets the "game" field.
+func (_u *GameBindingLockUpdateOne) SetGame(v string) *GameBindingLockUpdateOne {
+	_u.mutation.SetGame(v)
+	return _u
+}
+
+// SetNillableGame sets the "game" field if the given value is not nil.
+func (_u *GameBindingLockUpdateOne) SetNillableGame(v *string) *GameBindingLockUpdateOne {
+	if v != nil {
+		_u.SetGame(*v)
+	}
+	return _u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_u *GameBindingLockUpdateOne) SetHarukiUserID(v int) *GameBindingLockUpdateOne {
+	_u.mutation.ResetHarukiUserID()
+	_u.mutation.SetHarukiUserID(v)
+	return _u
+}
+
+// SetNillableHarukiUserID sets the "haruki_user_id" field if the given value is not nil.
+func (_u *GameBindingLockUpdateOne) SetNillableHarukiUserID(v *int) *GameBindingLockUpdateOne {
+	if v != nil {
+		_u.SetHarukiUserID(*v)
+	}
+	return _u
+}
+
+// AddHarukiUserID adds value to the "haruki_user_id" field.
+func (_u *GameBindingLockUpdateOne) AddHarukiUserID(v int) *GameBindingLockUpdateOne {
+	_u.mutation.AddHarukiUserID(v)
+	return _u
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_u *GameBindingLockUpdateOne) SetLockedAt(v time.Time) *GameBindingLockUpdateOne {
+	_u.mutation.SetLockedAt(v)
+	return _u
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_u *GameBindingLockUpdateOne) SetNillableLockedAt(v *time.Time) *GameBindingLockUpdateOne {
+	if v != nil {
+		_u.SetLockedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the GameBindingLockMutation object of the builder.
+func (_u *GameBindingLockUpdateOne) Mutation() *GameBindingLockMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GameBindingLockUpdate builder.
+func (_u *GameBindingLockUpdateOne) Where(ps ...predicate.GameBindingLock) *GameBindingLockUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GameBindingLockUpdateOne) Select(field string, fields ...string) *GameBindingLockUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GameBindingLock entity.
+func (_u *GameBindingLockUpdateOne) Save(ctx context.Context) (*GameBindingLock, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GameBindingLockUpdateOne) SaveX(ctx context.Context) *GameBindingLock {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GameBindingLockUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GameBindingLockUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GameBindingLockUpdateOne) check() error {
+	if v, ok := _u.mutation.Game(); ok {
+		if err := gamebindinglock.GameValidator(v); err != nil {
+			return &ValidationError{Name: "game", err: fmt.Errorf(`users: validator failed for field "GameBindingLock.game": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GameBindingLockUpdateOne) sqlSave(ctx context.Context) (_node *GameBindingLock, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gamebindinglock.Table, gamebindinglock.Columns, sqlgraph.NewFieldSpec(gamebindinglock.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`users: missing "GameBindingLock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gamebindinglock.FieldID)
+		for _, f := range fields {
+			if !gamebindinglock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("users: invalid field %q for query", f)}
+			}
+			if f != gamebindinglock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Game(); ok {
+		_spec.SetField(gamebindinglock.FieldGame, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HarukiUserID(); ok {
+		_spec.SetField(gamebindinglock.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHarukiUserID(); ok {
+		_spec.AddField(gamebindinglock.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedAt(); ok {
+		_spec.SetField(gamebindinglock.FieldLockedAt, field.TypeTime, value)
+	}
+	_node = &GameBindingLock{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gamebindinglock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,42 @@ import (
 	"haruki-database/database/schema/users"
 )
 
+// The GameBindingFunc type is an adapter to allow the use of ordinary
+// function as GameBinding mutator.
+type GameBindingFunc func(context.Context, *users.GameBindingMutation) (users.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GameBindingFunc) Mutate(ctx context.Context, m users.Mutation) (users.Value, error) {
+	if mv, ok := m.(*users.GameBindingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *users.GameBindingMutation", m)
+}
+
+// The GameBindingLockFunc type is an adapter to allow the use of ordinary
+// function as GameBindingLock mutator.
+type GameBindingLockFunc func(context.Context, *users.GameBindingLockMutation) (users.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GameBindingLockFunc) Mutate(ctx context.Context, m users.Mutation) (users.Value, error) {
+	if mv, ok := m.(*users.GameBindingLockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *users.GameBindingLockMutation", m)
+}
+
+// The PreferenceFunc type is an adapter to allow the use of ordinary
+// function as Preference mutator.
+type PreferenceFunc func(context.Context, *users.PreferenceMutation) (users.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PreferenceFunc) Mutate(ctx context.Context, m users.Mutation) (users.Value, error) {
+	if mv, ok := m.(*users.PreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *users.PreferenceMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *users.UserMutation) (users.Value, error)
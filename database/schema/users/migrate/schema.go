@@ -8,6 +8,78 @@ import (
 )
 
 var (
+	// GameBindingsColumns holds the columns for the "game_bindings" table.
+	GameBindingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "game", Type: field.TypeString, Size: 20},
+		{Name: "haruki_user_id", Type: field.TypeInt},
+		{Name: "server", Type: field.TypeString, Size: 20},
+		{Name: "account_id", Type: field.TypeString, Size: 100},
+		{Name: "label", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "visible", Type: field.TypeBool, Default: true},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+	}
+	// GameBindingsTable holds the schema information for the "game_bindings" table.
+	GameBindingsTable = &schema.Table{
+		Name:       "game_bindings",
+		Columns:    GameBindingsColumns,
+		PrimaryKey: []*schema.Column{GameBindingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gamebinding_game_haruki_user_id_server_account_id",
+				Unique:  true,
+				Columns: []*schema.Column{GameBindingsColumns[1], GameBindingsColumns[2], GameBindingsColumns[3], GameBindingsColumns[4]},
+			},
+			{
+				Name:    "gamebinding_game_server_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{GameBindingsColumns[1], GameBindingsColumns[3], GameBindingsColumns[4]},
+			},
+		},
+	}
+	// GameBindingLocksColumns holds the columns for the "game_binding_locks" table.
+	GameBindingLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "game", Type: field.TypeString, Size: 20},
+		{Name: "haruki_user_id", Type: field.TypeInt},
+		{Name: "locked_at", Type: field.TypeTime},
+	}
+	// GameBindingLocksTable holds the schema information for the "game_binding_locks" table.
+	GameBindingLocksTable = &schema.Table{
+		Name:       "game_binding_locks",
+		Columns:    GameBindingLocksColumns,
+		PrimaryKey: []*schema.Column{GameBindingLocksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gamebindinglock_game_haruki_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{GameBindingLocksColumns[1], GameBindingLocksColumns[2]},
+			},
+		},
+	}
+	// PreferencesColumns holds the columns for the "preferences" table.
+	PreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "haruki_user_id", Type: field.TypeInt},
+		{Name: "scope", Type: field.TypeString, Size: 20},
+		{Name: "scope_key", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "option", Type: field.TypeString, Size: 50},
+		{Name: "value", Type: field.TypeString, Size: 50},
+	}
+	// PreferencesTable holds the schema information for the "preferences" table.
+	PreferencesTable = &schema.Table{
+		Name:       "preferences",
+		Columns:    PreferencesColumns,
+		PrimaryKey: []*schema.Column{PreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "preference_haruki_user_id_scope_scope_key_option",
+				Unique:  true,
+				Columns: []*schema.Column{PreferencesColumns[1], PreferencesColumns[2], PreferencesColumns[3], PreferencesColumns[4]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -47,6 +119,9 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		GameBindingsTable,
+		GameBindingLocksTable,
+		PreferencesTable,
 		UsersTable,
 	}
 )
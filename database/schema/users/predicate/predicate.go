@@ -6,5 +6,14 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// GameBinding is the predicate function for gamebinding builders.
+type GameBinding func(*sql.Selector)
+
+// GameBindingLock is the predicate function for gamebindinglock builders.
+type GameBindingLock func(*sql.Selector)
+
+// Preference is the predicate function for preference builders.
+type Preference func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
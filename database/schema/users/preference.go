@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package users
+
+import (
+	"fmt"
+	"haruki-database/database/schema/users/preference"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Preference is the model entity for the Preference schema.
+type Preference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Reference to users table
+	HarukiUserID int `json:"haruki_user_id,omitempty"`
+	// Preference scope: global, game or group
+	Scope string `json:"scope,omitempty"`
+	// Game name or platform:group_id, empty for the global scope
+	ScopeKey string `json:"scope_key,omitempty"`
+	// Option holds the value of the "option" field.
+	Option string `json:"option,omitempty"`
+	// Value holds the value of the "value" field.
+	Value        string `json:"value,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Preference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case preference.FieldID, preference.FieldHarukiUserID:
+			values[i] = new(sql.NullInt64)
+		case preference.FieldScope, preference.FieldScopeKey, preference.FieldOption, preference.FieldValue:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Preference fields.
+func (_m *Preference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case preference.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case preference.FieldHarukiUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field haruki_user_id", values[i])
+			} else if value.Valid {
+				_m.HarukiUserID = int(value.Int64)
+			}
+		case preference.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				_m.Scope = value.String
+			}
+		case preference.FieldScopeKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_key", values[i])
+			} else if value.Valid {
+				_m.ScopeKey = value.String
+			}
+		case preference.FieldOption:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field option", values[i])
+			} else if value.Valid {
+				_m.Option = value.String
+			}
+		case preference.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the Preference.
+// This includes values selected through modifiers, order, etc.
+func (_m *Preference) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Preference.
+// Note that you need to call Preference.Unwrap() before calling this method if this Preference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Preference) Update() *PreferenceUpdateOne {
+	return NewPreferenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Preference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Preference) Unwrap() *Preference {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("users: Preference is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Preference) String() string {
+	var builder strings.Builder
+	builder.WriteString("Preference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("haruki_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HarukiUserID))
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(_m.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("scope_key=")
+	builder.WriteString(_m.ScopeKey)
+	builder.WriteString(", ")
+	builder.WriteString("option=")
+	builder.WriteString(_m.Option)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Preferences is a parsable slice of Preference.
+type Preferences []*Preference
@@ -0,0 +1,92 @@
+// Code generated by ent, DO NOT EDIT.
+
+package preference
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the preference type in the database.
+	Label = "preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHarukiUserID holds the string denoting the haruki_user_id field in the database.
+	FieldHarukiUserID = "haruki_user_id"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldScopeKey holds the string denoting the scope_key field in the database.
+	FieldScopeKey = "scope_key"
+	// FieldOption holds the string denoting the option field in the database.
+	FieldOption = "option"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// Table holds the table name of the preference in the database.
+	Table = "preferences"
+)
+
+// Columns holds all SQL columns for preference fields.
+var Columns = []string{
+	FieldID,
+	FieldHarukiUserID,
+	FieldScope,
+	FieldScopeKey,
+	FieldOption,
+	FieldValue,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	ScopeValidator func(string) error
+	// DefaultScopeKey holds the default value on creation for the "scope_key" field.
+	DefaultScopeKey string
+	// ScopeKeyValidator is a validator for the "scope_key" field. It is called by the builders before save.
+	ScopeKeyValidator func(string) error
+	// OptionValidator is a validator for the "option" field. It is called by the builders before save.
+	OptionValidator func(string) error
+	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ValueValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Preference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByHarukiUserID orders the results by the haruki_user_id field.
+func ByHarukiUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHarukiUserID, opts...).ToFunc()
+}
+
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
+
+// ByScopeKey orders the results by the scope_key field.
+func ByScopeKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopeKey, opts...).ToFunc()
+}
+
+// ByOption orders the results by the option field.
+func ByOption(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOption, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
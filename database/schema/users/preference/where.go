@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package preference
+
+import (
+	"haruki-database/database/schema/users/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldID, id))
+}
+
+// HarukiUserID applies equality check predicate on the "haruki_user_id" field. It's identical to HarukiUserIDEQ.
+func HarukiUserID(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldHarukiUserID, v))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeKey applies equality check predicate on the "scope_key" field. It's identical to ScopeKeyEQ.
+func ScopeKey(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldScopeKey, v))
+}
+
+// Option applies equality check predicate on the "option" field. It's identical to OptionEQ.
+func Option(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldOption, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldValue, v))
+}
+
+// HarukiUserIDEQ applies the EQ predicate on the "haruki_user_id" field.
+func HarukiUserIDEQ(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDNEQ applies the NEQ predicate on the "haruki_user_id" field.
+func HarukiUserIDNEQ(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDIn applies the In predicate on the "haruki_user_id" field.
+func HarukiUserIDIn(vs ...int) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldHarukiUserID, vs...))
+}
+
+// HarukiUserIDNotIn applies the NotIn predicate on the "haruki_user_id" field.
+func HarukiUserIDNotIn(vs ...int) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldHarukiUserID, vs...))
+}
+
+// HarukiUserIDGT applies the GT predicate on the "haruki_user_id" field.
+func HarukiUserIDGT(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDGTE applies the GTE predicate on the "haruki_user_id" field.
+func HarukiUserIDGTE(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDLT applies the LT predicate on the "haruki_user_id" field.
+func HarukiUserIDLT(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldHarukiUserID, v))
+}
+
+// HarukiUserIDLTE applies the LTE predicate on the "haruki_user_id" field.
+func HarukiUserIDLTE(v int) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldHarukiUserID, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContainsFold(FieldScope, v))
+}
+
+// ScopeKeyEQ applies the EQ predicate on the "scope_key" field.
+func ScopeKeyEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldScopeKey, v))
+}
+
+// ScopeKeyNEQ applies the NEQ predicate on the "scope_key" field.
+func ScopeKeyNEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldScopeKey, v))
+}
+
+// ScopeKeyIn applies the In predicate on the "scope_key" field.
+func ScopeKeyIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldScopeKey, vs...))
+}
+
+// ScopeKeyNotIn applies the NotIn predicate on the "scope_key" field.
+func ScopeKeyNotIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldScopeKey, vs...))
+}
+
+// ScopeKeyGT applies the GT predicate on the "scope_key" field.
+func ScopeKeyGT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldScopeKey, v))
+}
+
+// ScopeKeyGTE applies the GTE predicate on the "scope_key" field.
+func ScopeKeyGTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldScopeKey, v))
+}
+
+// ScopeKeyLT applies the LT predicate on the "scope_key" field.
+func ScopeKeyLT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldScopeKey, v))
+}
+
+// ScopeKeyLTE applies the LTE predicate on the "scope_key" field.
+func ScopeKeyLTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldScopeKey, v))
+}
+
+// ScopeKeyContains applies the Contains predicate on the "scope_key" field.
+func ScopeKeyContains(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContains(FieldScopeKey, v))
+}
+
+// ScopeKeyHasPrefix applies the HasPrefix predicate on the "scope_key" field.
+func ScopeKeyHasPrefix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasPrefix(FieldScopeKey, v))
+}
+
+// ScopeKeyHasSuffix applies the HasSuffix predicate on the "scope_key" field.
+func ScopeKeyHasSuffix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasSuffix(FieldScopeKey, v))
+}
+
+// ScopeKeyEqualFold applies the EqualFold predicate on the "scope_key" field.
+func ScopeKeyEqualFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEqualFold(FieldScopeKey, v))
+}
+
+// ScopeKeyContainsFold applies the ContainsFold predicate on the "scope_key" field.
+func ScopeKeyContainsFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContainsFold(FieldScopeKey, v))
+}
+
+// OptionEQ applies the EQ predicate on the "option" field.
+func OptionEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldOption, v))
+}
+
+// OptionNEQ applies the NEQ predicate on the "option" field.
+func OptionNEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldOption, v))
+}
+
+// OptionIn applies the In predicate on the "option" field.
+func OptionIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldOption, vs...))
+}
+
+// OptionNotIn applies the NotIn predicate on the "option" field.
+func OptionNotIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldOption, vs...))
+}
+
+// OptionGT applies the GT predicate on the "option" field.
+func OptionGT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldOption, v))
+}
+
+// OptionGTE applies the GTE predicate on the "option" field.
+func OptionGTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldOption, v))
+}
+
+// OptionLT applies the LT predicate on the "option" field.
+func OptionLT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldOption, v))
+}
+
+// OptionLTE applies the LTE predicate on the "option" field.
+func OptionLTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldOption, v))
+}
+
+// OptionContains applies the Contains predicate on the "option" field.
+func OptionContains(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContains(FieldOption, v))
+}
+
+// OptionHasPrefix applies the HasPrefix predicate on the "option" field.
+func OptionHasPrefix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasPrefix(FieldOption, v))
+}
+
+// OptionHasSuffix applies the HasSuffix predicate on the "option" field.
+func OptionHasSuffix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasSuffix(FieldOption, v))
+}
+
+// OptionEqualFold applies the EqualFold predicate on the "option" field.
+func OptionEqualFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEqualFold(FieldOption, v))
+}
+
+// OptionContainsFold applies the ContainsFold predicate on the "option" field.
+func OptionContainsFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContainsFold(FieldOption, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.Preference {
+	return predicate.Preference(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.Preference {
+	return predicate.Preference(sql.FieldContainsFold(FieldValue, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Preference) predicate.Preference {
+	return predicate.Preference(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Preference) predicate.Preference {
+	return predicate.Preference(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Preference) predicate.Preference {
+	return predicate.Preference(sql.NotPredicates(p))
+}
@@ -0,0 +1,421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haruki-database/database/schema/users/predicate"
+	"haruki-database/database/schema/users/preference"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PreferenceUpdate is the builder for updating Preference entities.
+type PreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PreferenceMutation
+}
+
+// Where appends a list predicates to the PreferenceUpdate builder.
+func (_u *PreferenceUpdate) Where(ps ...predicate.Preference) *PreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_u *PreferenceUpdate) SetHarukiUserID(v int) *PreferenceUpdate {
+	_u.mutation.ResetHarukiUserID()
+	_u.mutation.SetHarukiUserID(v)
+	return _u
+}
+
+// SetNillableHarukiUserID sets the "haruki_user_id" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableHarukiUserID(v *int) *PreferenceUpdate {
+	if v != nil {
+		_u.SetHarukiUserID(*v)
+	}
+	return _u
+}
+
+// AddHarukiUserID adds value to the "haruki_user_id" field.
+func (_u *PreferenceUpdate) AddHarukiUserID(v int) *PreferenceUpdate {
+	_u.mutation.AddHarukiUserID(v)
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *PreferenceUpdate) SetScope(v string) *PreferenceUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableScope(v *string) *PreferenceUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetScopeKey sets the "scope_key" field.
+func (_u *PreferenceUpdate) SetScopeKey(v string) *PreferenceUpdate {
+	_u.mutation.SetScopeKey(v)
+	return _u
+}
+
+// SetNillableScopeKey sets the "scope_key" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableScopeKey(v *string) *PreferenceUpdate {
+	if v != nil {
+		_u.SetScopeKey(*v)
+	}
+	return _u
+}
+
+// SetOption sets the "option" field.
+func (_u *PreferenceUpdate) SetOption(v string) *PreferenceUpdate {
+	_u.mutation.SetOption(v)
+	return _u
+}
+
+// SetNillableOption sets the "option" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableOption(v *string) *PreferenceUpdate {
+	if v != nil {
+		_u.SetOption(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *PreferenceUpdate) SetValue(v string) *PreferenceUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *PreferenceUpdate) SetNillableValue(v *string) *PreferenceUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PreferenceMutation object of the builder.
+func (_u *PreferenceUpdate) Mutation() *PreferenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PreferenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PreferenceUpdate) check() error {
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := preference.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`users: validator failed for field "Preference.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScopeKey(); ok {
+		if err := preference.ScopeKeyValidator(v); err != nil {
+			return &ValidationError{Name: "scope_key", err: fmt.Errorf(`users: validator failed for field "Preference.scope_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Option(); ok {
+		if err := preference.OptionValidator(v); err != nil {
+			return &ValidationError{Name: "option", err: fmt.Errorf(`users: validator failed for field "Preference.option": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := preference.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`users: validator failed for field "Preference.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(preference.Table, preference.Columns, sqlgraph.NewFieldSpec(preference.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HarukiUserID(); ok {
+		_spec.SetField(preference.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHarukiUserID(); ok {
+		_spec.AddField(preference.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(preference.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScopeKey(); ok {
+		_spec.SetField(preference.FieldScopeKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Option(); ok {
+		_spec.SetField(preference.FieldOption, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(preference.FieldValue, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{preference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PreferenceUpdateOne is the builder for updating a single Preference entity.
+type PreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PreferenceMutation
+}
+
+// SetHarukiUserID sets the "haruki_user_id" field.
+func (_u *PreferenceUpdateOne) SetHarukiUserID(v int) *PreferenceUpdateOne {
+	_u.mutation.ResetHarukiUserID()
+	_u.mutation.SetHarukiUserID(v)
+	return _u
+}
+
+// SetNillableHarukiUserID sets the "haruki_user_id" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableHarukiUserID(v *int) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetHarukiUserID(*v)
+	}
+	return _u
+}
+
+// AddHarukiUserID adds value to the "haruki_user_id" field.
+func (_u *PreferenceUpdateOne) AddHarukiUserID(v int) *PreferenceUpdateOne {
+	_u.mutation.AddHarukiUserID(v)
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *PreferenceUpdateOne) SetScope(v string) *PreferenceUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableScope(v *string) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetScopeKey sets the "scope_key" field.
+func (_u *PreferenceUpdateOne) SetScopeKey(v string) *PreferenceUpdateOne {
+	_u.mutation.SetScopeKey(v)
+	return _u
+}
+
+// SetNillableScopeKey sets the "scope_key" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableScopeKey(v *string) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetScopeKey(*v)
+	}
+	return _u
+}
+
+// SetOption sets the "option" field.
+func (_u *PreferenceUpdateOne) SetOption(v string) *PreferenceUpdateOne {
+	_u.mutation.SetOption(v)
+	return _u
+}
+
+// SetNillableOption sets the "option" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableOption(v *string) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetOption(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *PreferenceUpdateOne) SetValue(v string) *PreferenceUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *PreferenceUpdateOne) SetNillableValue(v *string) *PreferenceUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PreferenceMutation object of the builder.
+func (_u *PreferenceUpdateOne) Mutation() *PreferenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PreferenceUpdate builder.
+func (_u *PreferenceUpdateOne) Where(ps ...predicate.Preference) *PreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PreferenceUpdateOne) Select(field string, fields ...string) *PreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Preference entity.
+func (_u *PreferenceUpdateOne) Save(ctx context.Context) (*Preference, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PreferenceUpdateOne) SaveX(ctx context.Context) *Preference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PreferenceUpdateOne) check() error {
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := preference.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`users: validator failed for field "Preference.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScopeKey(); ok {
+		if err := preference.ScopeKeyValidator(v); err != nil {
+			return &ValidationError{Name: "scope_key", err: fmt.Errorf(`users: validator failed for field "Preference.scope_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Option(); ok {
+		if err := preference.OptionValidator(v); err != nil {
+			return &ValidationError{Name: "option", err: fmt.Errorf(`users: validator failed for field "Preference.option": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := preference.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`users: validator failed for field "Preference.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PreferenceUpdateOne) sqlSave(ctx context.Context) (_node *Preference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(preference.Table, preference.Columns, sqlgraph.NewFieldSpec(preference.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`users: missing "Preference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, preference.FieldID)
+		for _, f := range fields {
+			if !preference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("users: invalid field %q for query", f)}
+			}
+			if f != preference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HarukiUserID(); ok {
+		_spec.SetField(preference.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHarukiUserID(); ok {
+		_spec.AddField(preference.FieldHarukiUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(preference.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ScopeKey(); ok {
+		_spec.SetField(preference.FieldScopeKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Option(); ok {
+		_spec.SetField(preference.FieldOption, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(preference.FieldValue, field.TypeString, value)
+	}
+	_node = &Preference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{preference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
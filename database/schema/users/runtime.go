@@ -3,6 +3,9 @@
 package users
 
 import (
+	"haruki-database/database/schema/users/gamebinding"
+	"haruki-database/database/schema/users/gamebindinglock"
+	"haruki-database/database/schema/users/preference"
 	"haruki-database/database/schema/users/user"
 	"haruki-database/entsrc/schema/users/schema"
 )
@@ -11,6 +14,62 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	gamebindingFields := schema.GameBinding{}.Fields()
+	_ = gamebindingFields
+	// gamebindingDescGame is the schema descriptor for game field.
+	gamebindingDescGame := gamebindingFields[0].Descriptor()
+	// gamebinding.GameValidator is a validator for the "game" field. It is called by the builders before save.
+	gamebinding.GameValidator = gamebindingDescGame.Validators[0].(func(string) error)
+	// gamebindingDescServer is the schema descriptor for server field.
+	gamebindingDescServer := gamebindingFields[2].Descriptor()
+	// gamebinding.ServerValidator is a validator for the "server" field. It is called by the builders before save.
+	gamebinding.ServerValidator = gamebindingDescServer.Validators[0].(func(string) error)
+	// gamebindingDescAccountID is the schema descriptor for account_id field.
+	gamebindingDescAccountID := gamebindingFields[3].Descriptor()
+	// gamebinding.AccountIDValidator is a validator for the "account_id" field. It is called by the builders before save.
+	gamebinding.AccountIDValidator = gamebindingDescAccountID.Validators[0].(func(string) error)
+	// gamebindingDescLabel is the schema descriptor for label field.
+	gamebindingDescLabel := gamebindingFields[4].Descriptor()
+	// gamebinding.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	gamebinding.LabelValidator = gamebindingDescLabel.Validators[0].(func(string) error)
+	// gamebindingDescVisible is the schema descriptor for visible field.
+	gamebindingDescVisible := gamebindingFields[5].Descriptor()
+	// gamebinding.DefaultVisible holds the default value on creation for the visible field.
+	gamebinding.DefaultVisible = gamebindingDescVisible.Default.(bool)
+	// gamebindingDescIsDefault is the schema descriptor for is_default field.
+	gamebindingDescIsDefault := gamebindingFields[6].Descriptor()
+	// gamebinding.DefaultIsDefault holds the default value on creation for the is_default field.
+	gamebinding.DefaultIsDefault = gamebindingDescIsDefault.Default.(bool)
+	// gamebindingDescSortOrder is the schema descriptor for sort_order field.
+	gamebindingDescSortOrder := gamebindingFields[7].Descriptor()
+	// gamebinding.DefaultSortOrder holds the default value on creation for the sort_order field.
+	gamebinding.DefaultSortOrder = gamebindingDescSortOrder.Default.(int)
+	gamebindinglockFields := schema.GameBindingLock{}.Fields()
+	_ = gamebindinglockFields
+	// gamebindinglockDescGame is the schema descriptor for game field.
+	gamebindinglockDescGame := gamebindinglockFields[0].Descriptor()
+	// gamebindinglock.GameValidator is a validator for the "game" field. It is called by the builders before save.
+	gamebindinglock.GameValidator = gamebindinglockDescGame.Validators[0].(func(string) error)
+	preferenceFields := schema.Preference{}.Fields()
+	_ = preferenceFields
+	// preferenceDescScope is the schema descriptor for scope field.
+	preferenceDescScope := preferenceFields[1].Descriptor()
+	// preference.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	preference.ScopeValidator = preferenceDescScope.Validators[0].(func(string) error)
+	// preferenceDescScopeKey is the schema descriptor for scope_key field.
+	preferenceDescScopeKey := preferenceFields[2].Descriptor()
+	// preference.DefaultScopeKey holds the default value on creation for the scope_key field.
+	preference.DefaultScopeKey = preferenceDescScopeKey.Default.(string)
+	// preference.ScopeKeyValidator is a validator for the "scope_key" field. It is called by the builders before save.
+	preference.ScopeKeyValidator = preferenceDescScopeKey.Validators[0].(func(string) error)
+	// preferenceDescOption is the schema descriptor for option field.
+	preferenceDescOption := preferenceFields[3].Descriptor()
+	// preference.OptionValidator is a validator for the "option" field. It is called by the builders before save.
+	preference.OptionValidator = preferenceDescOption.Validators[0].(func(string) error)
+	// preferenceDescValue is the schema descriptor for value field.
+	preferenceDescValue := preferenceFields[4].Descriptor()
+	// preference.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	preference.ValueValidator = preferenceDescValue.Validators[0].(func(string) error)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescPlatform is the schema descriptor for platform field.
@@ -12,6 +12,12 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// GameBinding is the client for interacting with the GameBinding builders.
+	GameBinding *GameBindingClient
+	// GameBindingLock is the client for interacting with the GameBindingLock builders.
+	GameBindingLock *GameBindingLockClient
+	// Preference is the client for interacting with the Preference builders.
+	Preference *PreferenceClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -145,6 +151,9 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.GameBinding = NewGameBindingClient(tx.config)
+	tx.GameBindingLock = NewGameBindingLockClient(tx.config)
+	tx.Preference = NewPreferenceClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -155,7 +164,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: User.QueryXXX(), the query will be executed
+// applies a query, for example: GameBinding.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
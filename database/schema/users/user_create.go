@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"haruki-database/database/schema/users/user"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -17,6 +18,7 @@ type UserCreate struct {
 	config
 	mutation *UserMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetPlatform sets the "platform" field.
@@ -480,6 +482,7 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node = &User{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(user.Table, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -567,11 +570,779 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.Create().
+//		SetPlatform(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetPlatform(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserCreate) OnConflict(opts ...sql.ConflictOption) *UserUpsertOne {
+	_c.conflict = opts
+	return &UserUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserCreate) OnConflictColumns(columns ...string) *UserUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserUpsertOne is the builder for "upsert"-ing
+	//  one User node.
+	UserUpsertOne struct {
+		create *UserCreate
+	}
+
+	// UserUpsert is the "OnConflict" setter.
+	UserUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlatform sets the "platform" field.
+func (u *UserUpsert) SetPlatform(v string) *UserUpsert {
+	u.Set(user.FieldPlatform, v)
+	return u
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePlatform() *UserUpsert {
+	u.SetExcluded(user.FieldPlatform)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserUpsert) SetUserID(v string) *UserUpsert {
+	u.Set(user.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateUserID() *UserUpsert {
+	u.SetExcluded(user.FieldUserID)
+	return u
+}
+
+// SetBanState sets the "ban_state" field.
+func (u *UserUpsert) SetBanState(v bool) *UserUpsert {
+	u.Set(user.FieldBanState, v)
+	return u
+}
+
+// UpdateBanState sets the "ban_state" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBanState() *UserUpsert {
+	u.SetExcluded(user.FieldBanState)
+	return u
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (u *UserUpsert) SetBanReason(v string) *UserUpsert {
+	u.Set(user.FieldBanReason, v)
+	return u
+}
+
+// UpdateBanReason sets the "ban_reason" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBanReason() *UserUpsert {
+	u.SetExcluded(user.FieldBanReason)
+	return u
+}
+
+// ClearBanReason clears the value of the "ban_reason" field.
+func (u *UserUpsert) ClearBanReason() *UserUpsert {
+	u.SetNull(user.FieldBanReason)
+	return u
+}
+
+// SetPjskBanState sets the "pjsk_ban_state" field.
+func (u *UserUpsert) SetPjskBanState(v bool) *UserUpsert {
+	u.Set(user.FieldPjskBanState, v)
+	return u
+}
+
+// UpdatePjskBanState sets the "pjsk_ban_state" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePjskBanState() *UserUpsert {
+	u.SetExcluded(user.FieldPjskBanState)
+	return u
+}
+
+// SetPjskBanReason sets the "pjsk_ban_reason" field.
+func (u *UserUpsert) SetPjskBanReason(v string) *UserUpsert {
+	u.Set(user.FieldPjskBanReason, v)
+	return u
+}
+
+// UpdatePjskBanReason sets the "pjsk_ban_reason" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePjskBanReason() *UserUpsert {
+	u.SetExcluded(user.FieldPjskBanReason)
+	return u
+}
+
+// ClearPjskBanReason clears the value of the "pjsk_ban_reason" field.
+func (u *UserUpsert) ClearPjskBanReason() *UserUpsert {
+	u.SetNull(user.FieldPjskBanReason)
+	return u
+}
+
+// SetChunithmBanState sets the "chunithm_ban_state" field.
+func (u *UserUpsert) SetChunithmBanState(v bool) *UserUpsert {
+	u.Set(user.FieldChunithmBanState, v)
+	return u
+}
+
+// UpdateChunithmBanState sets the "chunithm_ban_state" field to the value that was provided on create.
+func (u *UserUpsert) UpdateChunithmBanState() *UserUpsert {
+	u.SetExcluded(user.FieldChunithmBanState)
+	return u
+}
+
+// SetChunithmBanReason sets the "chunithm_ban_reason" field.
+func (u *UserUpsert) SetChunithmBanReason(v string) *UserUpsert {
+	u.Set(user.FieldChunithmBanReason, v)
+	return u
+}
+
+// UpdateChunithmBanReason sets the "chunithm_ban_reason" field to the value that was provided on create.
+func (u *UserUpsert) UpdateChunithmBanReason() *UserUpsert {
+	u.SetExcluded(user.FieldChunithmBanReason)
+	return u
+}
+
+// ClearChunithmBanReason clears the value of the "chunithm_ban_reason" field.
+func (u *UserUpsert) ClearChunithmBanReason() *UserUpsert {
+	u.SetNull(user.FieldChunithmBanReason)
+	return u
+}
+
+// SetPjskMainBanState sets the "pjsk_main_ban_state" field.
+func (u *UserUpsert) SetPjskMainBanState(v bool) *UserUpsert {
+	u.Set(user.FieldPjskMainBanState, v)
+	return u
+}
+
+// UpdatePjskMainBanState sets the "pjsk_main_ban_state" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePjskMainBanState() *UserUpsert {
+	u.SetExcluded(user.FieldPjskMainBanState)
+	return u
+}
+
+// SetPjskMainBanReason sets the "pjsk_main_ban_reason" field.
+func (u *UserUpsert) SetPjskMainBanReason(v string) *UserUpsert {
+	u.Set(user.FieldPjskMainBanReason, v)
+	return u
+}
+
+// UpdatePjskMainBanReason sets the "pjsk_main_ban_reason" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePjskMainBanReason() *UserUpsert {
+	u.SetExcluded(user.FieldPjskMainBanReason)
+	return u
+}
+
+// ClearPjskMainBanReason clears the value of the "pjsk_main_ban_reason" field.
+func (u *UserUpsert) ClearPjskMainBanReason() *UserUpsert {
+	u.SetNull(user.FieldPjskMainBanReason)
+	return u
+}
+
+// SetPjskRankingBanState sets the "pjsk_ranking_ban_state" field.
+func (u *UserUpsert) SetPjskRankingBanState(v bool) *UserUpsert {
+	u.Set(user.FieldPjskRankingBanState, v)
+	return u
+}
+
+// UpdatePjskRankingBanState sets the "pjsk_ranking_ban_state" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePjskRankingBanState() *UserUpsert {
+	u.SetExcluded(user.FieldPjskRankingBanState)
+	return u
+}
+
+// SetPjskRankingBanReason sets the "pjsk_ranking_ban_reason" field.
+func (u *UserUpsert) SetPjskRankingBanReason(v string) *UserUpsert {
+	u.Set(user.FieldPjskRankingBanReason, v)
+	return u
+}
+
+// UpdatePjskRankingBanReason sets the "pjsk_ranking_ban_reason" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePjskRankingBanReason() *UserUpsert {
+	u.SetExcluded(user.FieldPjskRankingBanReason)
+	return u
+}
+
+// ClearPjskRankingBanReason clears the value of the "pjsk_ranking_ban_reason" field.
+func (u *UserUpsert) ClearPjskRankingBanReason() *UserUpsert {
+	u.SetNull(user.FieldPjskRankingBanReason)
+	return u
+}
+
+// SetPjskAliasBanState sets the "pjsk_alias_ban_state" field.
+func (u *UserUpsert) SetPjskAliasBanState(v bool) *UserUpsert {
+	u.Set(user.FieldPjskAliasBanState, v)
+	return u
+}
+
+// UpdatePjskAliasBanState sets the "pjsk_alias_ban_state" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePjskAliasBanState() *UserUpsert {
+	u.SetExcluded(user.FieldPjskAliasBanState)
+	return u
+}
+
+// SetPjskAliasBanReason sets the "pjsk_alias_ban_reason" field.
+func (u *UserUpsert) SetPjskAliasBanReason(v string) *UserUpsert {
+	u.Set(user.FieldPjskAliasBanReason, v)
+	return u
+}
+
+// UpdatePjskAliasBanReason sets the "pjsk_alias_ban_reason" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePjskAliasBanReason() *UserUpsert {
+	u.SetExcluded(user.FieldPjskAliasBanReason)
+	return u
+}
+
+// ClearPjskAliasBanReason clears the value of the "pjsk_alias_ban_reason" field.
+func (u *UserUpsert) ClearPjskAliasBanReason() *UserUpsert {
+	u.SetNull(user.FieldPjskAliasBanReason)
+	return u
+}
+
+// SetPjskMysekaiBanState sets the "pjsk_mysekai_ban_state" field.
+func (u *UserUpsert) SetPjskMysekaiBanState(v bool) *UserUpsert {
+	u.Set(user.FieldPjskMysekaiBanState, v)
+	return u
+}
+
+// UpdatePjskMysekaiBanState sets the "pjsk_mysekai_ban_state" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePjskMysekaiBanState() *UserUpsert {
+	u.SetExcluded(user.FieldPjskMysekaiBanState)
+	return u
+}
+
+// SetPjskMysekaiBanReason sets the "pjsk_mysekai_ban_reason" field.
+func (u *UserUpsert) SetPjskMysekaiBanReason(v string) *UserUpsert {
+	u.Set(user.FieldPjskMysekaiBanReason, v)
+	return u
+}
+
+// UpdatePjskMysekaiBanReason sets the "pjsk_mysekai_ban_reason" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePjskMysekaiBanReason() *UserUpsert {
+	u.SetExcluded(user.FieldPjskMysekaiBanReason)
+	return u
+}
+
+// ClearPjskMysekaiBanReason clears the value of the "pjsk_mysekai_ban_reason" field.
+func (u *UserUpsert) ClearPjskMysekaiBanReason() *UserUpsert {
+	u.SetNull(user.FieldPjskMysekaiBanReason)
+	return u
+}
+
+// SetChunithmMainBanState sets the "chunithm_main_ban_state" field.
+func (u *UserUpsert) SetChunithmMainBanState(v bool) *UserUpsert {
+	u.Set(user.FieldChunithmMainBanState, v)
+	return u
+}
+
+// UpdateChunithmMainBanState sets the "chunithm_main_ban_state" field to the value that was provided on create.
+func (u *UserUpsert) UpdateChunithmMainBanState() *UserUpsert {
+	u.SetExcluded(user.FieldChunithmMainBanState)
+	return u
+}
+
+// SetChunithmMainBanReason sets the "chunithm_main_ban_reason" field.
+func (u *UserUpsert) SetChunithmMainBanReason(v string) *UserUpsert {
+	u.Set(user.FieldChunithmMainBanReason, v)
+	return u
+}
+
+// UpdateChunithmMainBanReason sets the "chunithm_main_ban_reason" field to the value that was provided on create.
+func (u *UserUpsert) UpdateChunithmMainBanReason() *UserUpsert {
+	u.SetExcluded(user.FieldChunithmMainBanReason)
+	return u
+}
+
+// ClearChunithmMainBanReason clears the value of the "chunithm_main_ban_reason" field.
+func (u *UserUpsert) ClearChunithmMainBanReason() *UserUpsert {
+	u.SetNull(user.FieldChunithmMainBanReason)
+	return u
+}
+
+// SetChunithmAliasBanState sets the "chunithm_alias_ban_state" field.
+func (u *UserUpsert) SetChunithmAliasBanState(v bool) *UserUpsert {
+	u.Set(user.FieldChunithmAliasBanState, v)
+	return u
+}
+
+// UpdateChunithmAliasBanState sets the "chunithm_alias_ban_state" field to the value that was provided on create.
+func (u *UserUpsert) UpdateChunithmAliasBanState() *UserUpsert {
+	u.SetExcluded(user.FieldChunithmAliasBanState)
+	return u
+}
+
+// SetChunithmAliasBanReason sets the "chunithm_alias_ban_reason" field.
+func (u *UserUpsert) SetChunithmAliasBanReason(v string) *UserUpsert {
+	u.Set(user.FieldChunithmAliasBanReason, v)
+	return u
+}
+
+// UpdateChunithmAliasBanReason sets the "chunithm_alias_ban_reason" field to the value that was provided on create.
+func (u *UserUpsert) UpdateChunithmAliasBanReason() *UserUpsert {
+	u.SetExcluded(user.FieldChunithmAliasBanReason)
+	return u
+}
+
+// ClearChunithmAliasBanReason clears the value of the "chunithm_alias_ban_reason" field.
+func (u *UserUpsert) ClearChunithmAliasBanReason() *UserUpsert {
+	u.SetNull(user.FieldChunithmAliasBanReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertOne) UpdateNewValues() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(user.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserUpsertOne) Ignore() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertOne) DoNothing() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreate.OnConflict
+// documentation for more info.
+func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlatform sets the "platform" field.
+func (u *UserUpsertOne) SetPlatform(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePlatform() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserUpsertOne) SetUserID(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateUserID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetBanState sets the "ban_state" field.
+func (u *UserUpsertOne) SetBanState(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBanState(v)
+	})
+}
+
+// UpdateBanState sets the "ban_state" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBanState() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBanState()
+	})
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (u *UserUpsertOne) SetBanReason(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBanReason(v)
+	})
+}
+
+// UpdateBanReason sets the "ban_reason" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBanReason()
+	})
+}
+
+// ClearBanReason clears the value of the "ban_reason" field.
+func (u *UserUpsertOne) ClearBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBanReason()
+	})
+}
+
+// SetPjskBanState sets the "pjsk_ban_state" field.
+func (u *UserUpsertOne) SetPjskBanState(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskBanState(v)
+	})
+}
+
+// UpdatePjskBanState sets the "pjsk_ban_state" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePjskBanState() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskBanState()
+	})
+}
+
+// SetPjskBanReason sets the "pjsk_ban_reason" field.
+func (u *UserUpsertOne) SetPjskBanReason(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskBanReason(v)
+	})
+}
+
+// UpdatePjskBanReason sets the "pjsk_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePjskBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskBanReason()
+	})
+}
+
+// ClearPjskBanReason clears the value of the "pjsk_ban_reason" field.
+func (u *UserUpsertOne) ClearPjskBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPjskBanReason()
+	})
+}
+
+// SetChunithmBanState sets the "chunithm_ban_state" field.
+func (u *UserUpsertOne) SetChunithmBanState(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmBanState(v)
+	})
+}
+
+// UpdateChunithmBanState sets the "chunithm_ban_state" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateChunithmBanState() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmBanState()
+	})
+}
+
+// SetChunithmBanReason sets the "chunithm_ban_reason" field.
+func (u *UserUpsertOne) SetChunithmBanReason(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmBanReason(v)
+	})
+}
+
+// UpdateChunithmBanReason sets the "chunithm_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateChunithmBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmBanReason()
+	})
+}
+
+// ClearChunithmBanReason clears the value of the "chunithm_ban_reason" field.
+func (u *UserUpsertOne) ClearChunithmBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearChunithmBanReason()
+	})
+}
+
+// SetPjskMainBanState sets the "pjsk_main_ban_state" field.
+func (u *UserUpsertOne) SetPjskMainBanState(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskMainBanState(v)
+	})
+}
+
+// UpdatePjskMainBanState sets the "pjsk_main_ban_state" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePjskMainBanState() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskMainBanState()
+	})
+}
+
+// SetPjskMainBanReason sets the "pjsk_main_ban_reason" field.
+func (u *UserUpsertOne) SetPjskMainBanReason(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskMainBanReason(v)
+	})
+}
+
+// UpdatePjskMainBanReason sets the "pjsk_main_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePjskMainBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskMainBanReason()
+	})
+}
+
+// ClearPjskMainBanReason clears the value of the "pjsk_main_ban_reason" field.
+func (u *UserUpsertOne) ClearPjskMainBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPjskMainBanReason()
+	})
+}
+
+// SetPjskRankingBanState sets the "pjsk_ranking_ban_state" field.
+func (u *UserUpsertOne) SetPjskRankingBanState(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskRankingBanState(v)
+	})
+}
+
+// UpdatePjskRankingBanState sets the "pjsk_ranking_ban_state" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePjskRankingBanState() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskRankingBanState()
+	})
+}
+
+// SetPjskRankingBanReason sets the "pjsk_ranking_ban_reason" field.
+func (u *UserUpsertOne) SetPjskRankingBanReason(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskRankingBanReason(v)
+	})
+}
+
+// UpdatePjskRankingBanReason sets the "pjsk_ranking_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePjskRankingBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskRankingBanReason()
+	})
+}
+
+// ClearPjskRankingBanReason clears the value of the "pjsk_ranking_ban_reason" field.
+func (u *UserUpsertOne) ClearPjskRankingBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPjskRankingBanReason()
+	})
+}
+
+// SetPjskAliasBanState sets the "pjsk_alias_ban_state" field.
+func (u *UserUpsertOne) SetPjskAliasBanState(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskAliasBanState(v)
+	})
+}
+
+// UpdatePjskAliasBanState sets the "pjsk_alias_ban_state" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePjskAliasBanState() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskAliasBanState()
+	})
+}
+
+// SetPjskAliasBanReason sets the "pjsk_alias_ban_reason" field.
+func (u *UserUpsertOne) SetPjskAliasBanReason(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskAliasBanReason(v)
+	})
+}
+
+// UpdatePjskAliasBanReason sets the "pjsk_alias_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePjskAliasBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskAliasBanReason()
+	})
+}
+
+// ClearPjskAliasBanReason clears the value of the "pjsk_alias_ban_reason" field.
+func (u *UserUpsertOne) ClearPjskAliasBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPjskAliasBanReason()
+	})
+}
+
+// SetPjskMysekaiBanState sets the "pjsk_mysekai_ban_state" field.
+func (u *UserUpsertOne) SetPjskMysekaiBanState(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskMysekaiBanState(v)
+	})
+}
+
+// UpdatePjskMysekaiBanState sets the "pjsk_mysekai_ban_state" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePjskMysekaiBanState() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskMysekaiBanState()
+	})
+}
+
+// SetPjskMysekaiBanReason sets the "pjsk_mysekai_ban_reason" field.
+func (u *UserUpsertOne) SetPjskMysekaiBanReason(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskMysekaiBanReason(v)
+	})
+}
+
+// UpdatePjskMysekaiBanReason sets the "pjsk_mysekai_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePjskMysekaiBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskMysekaiBanReason()
+	})
+}
+
+// ClearPjskMysekaiBanReason clears the value of the "pjsk_mysekai_ban_reason" field.
+func (u *UserUpsertOne) ClearPjskMysekaiBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPjskMysekaiBanReason()
+	})
+}
+
+// SetChunithmMainBanState sets the "chunithm_main_ban_state" field.
+func (u *UserUpsertOne) SetChunithmMainBanState(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmMainBanState(v)
+	})
+}
+
+// UpdateChunithmMainBanState sets the "chunithm_main_ban_state" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateChunithmMainBanState() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmMainBanState()
+	})
+}
+
+// SetChunithmMainBanReason sets the "chunithm_main_ban_reason" field.
+func (u *UserUpsertOne) SetChunithmMainBanReason(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmMainBanReason(v)
+	})
+}
+
+// UpdateChunithmMainBanReason sets the "chunithm_main_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateChunithmMainBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmMainBanReason()
+	})
+}
+
+// ClearChunithmMainBanReason clears the value of the "chunithm_main_ban_reason" field.
+func (u *UserUpsertOne) ClearChunithmMainBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearChunithmMainBanReason()
+	})
+}
+
+// SetChunithmAliasBanState sets the "chunithm_alias_ban_state" field.
+func (u *UserUpsertOne) SetChunithmAliasBanState(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmAliasBanState(v)
+	})
+}
+
+// UpdateChunithmAliasBanState sets the "chunithm_alias_ban_state" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateChunithmAliasBanState() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmAliasBanState()
+	})
+}
+
+// SetChunithmAliasBanReason sets the "chunithm_alias_ban_reason" field.
+func (u *UserUpsertOne) SetChunithmAliasBanReason(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmAliasBanReason(v)
+	})
+}
+
+// UpdateChunithmAliasBanReason sets the "chunithm_alias_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateChunithmAliasBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmAliasBanReason()
+	})
+}
+
+// ClearChunithmAliasBanReason clears the value of the "chunithm_alias_ban_reason" field.
+func (u *UserUpsertOne) ClearChunithmAliasBanReason() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearChunithmAliasBanReason()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("users: missing options for UserCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
 	err      error
 	builders []*UserCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the User entities in the database.
@@ -601,6 +1372,7 @@ func (_c *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -654,3 +1426,460 @@ func (_c *UserCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetPlatform(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserUpsertBulk {
+	_c.conflict = opts
+	return &UserUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserCreateBulk) OnConflictColumns(columns ...string) *UserUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserUpsertBulk is the builder for "upsert"-ing
+// a bulk of User nodes.
+type UserUpsertBulk struct {
+	create *UserCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(user.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertBulk) UpdateNewValues() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(user.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserUpsertBulk) Ignore() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertBulk) DoNothing() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserUpsertBulk) Update(set func(*UserUpsert)) *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlatform sets the "platform" field.
+func (u *UserUpsertBulk) SetPlatform(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePlatform() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserUpsertBulk) SetUserID(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateUserID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetBanState sets the "ban_state" field.
+func (u *UserUpsertBulk) SetBanState(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBanState(v)
+	})
+}
+
+// UpdateBanState sets the "ban_state" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBanState() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBanState()
+	})
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (u *UserUpsertBulk) SetBanReason(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBanReason(v)
+	})
+}
+
+// UpdateBanReason sets the "ban_reason" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBanReason()
+	})
+}
+
+// ClearBanReason clears the value of the "ban_reason" field.
+func (u *UserUpsertBulk) ClearBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBanReason()
+	})
+}
+
+// SetPjskBanState sets the "pjsk_ban_state" field.
+func (u *UserUpsertBulk) SetPjskBanState(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskBanState(v)
+	})
+}
+
+// UpdatePjskBanState sets the "pjsk_ban_state" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePjskBanState() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskBanState()
+	})
+}
+
+// SetPjskBanReason sets the "pjsk_ban_reason" field.
+func (u *UserUpsertBulk) SetPjskBanReason(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskBanReason(v)
+	})
+}
+
+// UpdatePjskBanReason sets the "pjsk_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePjskBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskBanReason()
+	})
+}
+
+// ClearPjskBanReason clears the value of the "pjsk_ban_reason" field.
+func (u *UserUpsertBulk) ClearPjskBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPjskBanReason()
+	})
+}
+
+// SetChunithmBanState sets the "chunithm_ban_state" field.
+func (u *UserUpsertBulk) SetChunithmBanState(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmBanState(v)
+	})
+}
+
+// UpdateChunithmBanState sets the "chunithm_ban_state" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateChunithmBanState() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmBanState()
+	})
+}
+
+// SetChunithmBanReason sets the "chunithm_ban_reason" field.
+func (u *UserUpsertBulk) SetChunithmBanReason(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmBanReason(v)
+	})
+}
+
+// UpdateChunithmBanReason sets the "chunithm_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateChunithmBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmBanReason()
+	})
+}
+
+// ClearChunithmBanReason clears the value of the "chunithm_ban_reason" field.
+func (u *UserUpsertBulk) ClearChunithmBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearChunithmBanReason()
+	})
+}
+
+// SetPjskMainBanState sets the "pjsk_main_ban_state" field.
+func (u *UserUpsertBulk) SetPjskMainBanState(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskMainBanState(v)
+	})
+}
+
+// UpdatePjskMainBanState sets the "pjsk_main_ban_state" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePjskMainBanState() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskMainBanState()
+	})
+}
+
+// SetPjskMainBanReason sets the "pjsk_main_ban_reason" field.
+func (u *UserUpsertBulk) SetPjskMainBanReason(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskMainBanReason(v)
+	})
+}
+
+// UpdatePjskMainBanReason sets the "pjsk_main_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePjskMainBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskMainBanReason()
+	})
+}
+
+// ClearPjskMainBanReason clears the value of the "pjsk_main_ban_reason" field.
+func (u *UserUpsertBulk) ClearPjskMainBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPjskMainBanReason()
+	})
+}
+
+// SetPjskRankingBanState sets the "pjsk_ranking_ban_state" field.
+func (u *UserUpsertBulk) SetPjskRankingBanState(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskRankingBanState(v)
+	})
+}
+
+// UpdatePjskRankingBanState sets the "pjsk_ranking_ban_state" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePjskRankingBanState() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskRankingBanState()
+	})
+}
+
+// SetPjskRankingBanReason sets the "pjsk_ranking_ban_reason" field.
+func (u *UserUpsertBulk) SetPjskRankingBanReason(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskRankingBanReason(v)
+	})
+}
+
+// UpdatePjskRankingBanReason sets the "pjsk_ranking_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePjskRankingBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskRankingBanReason()
+	})
+}
+
+// ClearPjskRankingBanReason clears the value of the "pjsk_ranking_ban_reason" field.
+func (u *UserUpsertBulk) ClearPjskRankingBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPjskRankingBanReason()
+	})
+}
+
+// SetPjskAliasBanState sets the "pjsk_alias_ban_state" field.
+func (u *UserUpsertBulk) SetPjskAliasBanState(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskAliasBanState(v)
+	})
+}
+
+// UpdatePjskAliasBanState sets the "pjsk_alias_ban_state" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePjskAliasBanState() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskAliasBanState()
+	})
+}
+
+// SetPjskAliasBanReason sets the "pjsk_alias_ban_reason" field.
+func (u *UserUpsertBulk) SetPjskAliasBanReason(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskAliasBanReason(v)
+	})
+}
+
+// UpdatePjskAliasBanReason sets the "pjsk_alias_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePjskAliasBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskAliasBanReason()
+	})
+}
+
+// ClearPjskAliasBanReason clears the value of the "pjsk_alias_ban_reason" field.
+func (u *UserUpsertBulk) ClearPjskAliasBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPjskAliasBanReason()
+	})
+}
+
+// SetPjskMysekaiBanState sets the "pjsk_mysekai_ban_state" field.
+func (u *UserUpsertBulk) SetPjskMysekaiBanState(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskMysekaiBanState(v)
+	})
+}
+
+// UpdatePjskMysekaiBanState sets the "pjsk_mysekai_ban_state" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePjskMysekaiBanState() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskMysekaiBanState()
+	})
+}
+
+// SetPjskMysekaiBanReason sets the "pjsk_mysekai_ban_reason" field.
+func (u *UserUpsertBulk) SetPjskMysekaiBanReason(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPjskMysekaiBanReason(v)
+	})
+}
+
+// UpdatePjskMysekaiBanReason sets the "pjsk_mysekai_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePjskMysekaiBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePjskMysekaiBanReason()
+	})
+}
+
+// ClearPjskMysekaiBanReason clears the value of the "pjsk_mysekai_ban_reason" field.
+func (u *UserUpsertBulk) ClearPjskMysekaiBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPjskMysekaiBanReason()
+	})
+}
+
+// SetChunithmMainBanState sets the "chunithm_main_ban_state" field.
+func (u *UserUpsertBulk) SetChunithmMainBanState(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmMainBanState(v)
+	})
+}
+
+// UpdateChunithmMainBanState sets the "chunithm_main_ban_state" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateChunithmMainBanState() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmMainBanState()
+	})
+}
+
+// SetChunithmMainBanReason sets the "chunithm_main_ban_reason" field.
+func (u *UserUpsertBulk) SetChunithmMainBanReason(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmMainBanReason(v)
+	})
+}
+
+// UpdateChunithmMainBanReason sets the "chunithm_main_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateChunithmMainBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmMainBanReason()
+	})
+}
+
+// ClearChunithmMainBanReason clears the value of the "chunithm_main_ban_reason" field.
+func (u *UserUpsertBulk) ClearChunithmMainBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearChunithmMainBanReason()
+	})
+}
+
+// SetChunithmAliasBanState sets the "chunithm_alias_ban_state" field.
+func (u *UserUpsertBulk) SetChunithmAliasBanState(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmAliasBanState(v)
+	})
+}
+
+// UpdateChunithmAliasBanState sets the "chunithm_alias_ban_state" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateChunithmAliasBanState() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmAliasBanState()
+	})
+}
+
+// SetChunithmAliasBanReason sets the "chunithm_alias_ban_reason" field.
+func (u *UserUpsertBulk) SetChunithmAliasBanReason(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetChunithmAliasBanReason(v)
+	})
+}
+
+// UpdateChunithmAliasBanReason sets the "chunithm_alias_ban_reason" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateChunithmAliasBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateChunithmAliasBanReason()
+	})
+}
+
+// ClearChunithmAliasBanReason clears the value of the "chunithm_alias_ban_reason" field.
+func (u *UserUpsertBulk) ClearChunithmAliasBanReason() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearChunithmAliasBanReason()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("users: OnConflict was set for builder %d. Set it on the UserCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("users: missing options for UserCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,21 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+type ChunithmAliasAdmin struct {
+	ent.Schema
+}
+
+func (ChunithmAliasAdmin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("haruki_user_id").Unique().Comment("Reference to users table"),
+		field.String("name").MaxLen(100),
+	}
+}
+
+func (ChunithmAliasAdmin) Edges() []ent.Edge {
+	return nil
+}
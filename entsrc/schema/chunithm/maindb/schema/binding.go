@@ -15,12 +15,16 @@ func (ChunithmBinding) Fields() []ent.Field {
 		field.Int("haruki_user_id").Comment("Reference to users table"),
 		field.String("server").MaxLen(10),
 		field.String("aime_id").MaxLen(50),
+		field.String("label").MaxLen(50).Optional().Nillable(),
+		field.Bool("visible").Default(true),
+		field.Bool("is_default").Default(false).Comment("Default card of the user on this server"),
+		field.Int("sort_order").Default(0),
 	}
 }
 
 func (ChunithmBinding) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("haruki_user_id", "server").Unique(),
+		index.Fields("haruki_user_id", "server", "aime_id").Unique(),
 	}
 }
 
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type ChunithmGroupAlias struct {
+	ent.Schema
+}
+
+func (ChunithmGroupAlias) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("platform").MaxLen(20),
+		field.String("group_id").MaxLen(50),
+		field.Int("music_id"),
+		field.String("alias").MaxLen(100),
+	}
+}
+
+func (ChunithmGroupAlias) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("platform", "group_id", "music_id", "alias").Unique(),
+	}
+}
+
+func (ChunithmGroupAlias) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type ChunithmPendingAlias struct {
+	ent.Schema
+}
+
+func (ChunithmPendingAlias) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int("music_id"),
+		field.String("alias").MaxLen(100),
+		field.String("submitted_by").MaxLen(100),
+		field.Time("submitted_at"),
+	}
+}
+
+func (ChunithmPendingAlias) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("music_id", "alias").Unique(),
+	}
+}
+
+func (ChunithmPendingAlias) Edges() []ent.Edge {
+	return nil
+}
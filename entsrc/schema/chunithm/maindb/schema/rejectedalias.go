@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+type ChunithmRejectedAlias struct {
+	ent.Schema
+}
+
+func (ChunithmRejectedAlias) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int("music_id"),
+		field.String("alias").MaxLen(100),
+		field.String("reviewed_by").MaxLen(100),
+		field.String("reason").MaxLen(255),
+		field.Time("reviewed_at"),
+	}
+}
+
+func (ChunithmRejectedAlias) Edges() []ent.Edge {
+	return nil
+}
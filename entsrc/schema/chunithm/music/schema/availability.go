@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type ChunithmMusicAvailability struct {
+	ent.Schema
+}
+
+func (ChunithmMusicAvailability) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("music_id"),
+		field.String("server").MaxLen(10),
+		field.String("release_version").MaxLen(10).Optional().Nillable(),
+		field.Time("release_date").Optional().Nillable(),
+		field.Bool("is_deleted").Default(false),
+		field.String("deleted_version").MaxLen(10).Optional().Nillable(),
+	}
+}
+
+func (ChunithmMusicAvailability) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("music_id", "server").Unique(),
+		index.Fields("server"),
+	}
+}
+
+func (ChunithmMusicAvailability) Edges() []ent.Edge {
+	return nil
+}
+
+func (ChunithmMusicAvailability) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "music_availability"},
+	}
+}
@@ -24,6 +24,7 @@ func (ChunithmChartData) Fields() []ent.Field {
 		field.Int("air_count").Optional().Nillable(),
 		field.Int("flick_count").Optional().Nillable(),
 		field.Int("total_count").Optional().Nillable(),
+		field.Float("duration").Optional().Nillable().Comment("Chart length in seconds"),
 	}
 }
 
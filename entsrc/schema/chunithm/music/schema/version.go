@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+type ChunithmVersion struct {
+	ent.Schema
+}
+
+func (ChunithmVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("version").MaxLen(10).Unique(),
+		field.String("name").MaxLen(50).Optional().Nillable(),
+		field.Int("release_order").Unique().Comment("Position of the version in release history, ascending"),
+		field.Time("release_date").Optional().Nillable(),
+	}
+}
+
+func (ChunithmVersion) Edges() []ent.Edge {
+	return nil
+}
+
+func (ChunithmVersion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "versions"},
+	}
+}
@@ -13,6 +13,9 @@ func main() {
 	if err := entc.Generate("./schema", &gen.Config{
 		Package: "haruki-database/database/schema/pjsk",
 		Target:  "../../../database/schema/pjsk",
+		Features: []gen.Feature{
+			gen.FeatureUpsert,
+		},
 	}); err != nil {
 		log.Fatal("running ent codegen:", err)
 	}
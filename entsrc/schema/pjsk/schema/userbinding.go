@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -18,12 +19,17 @@ func (UserBinding) Fields() []ent.Field {
 		field.String("user_id").MaxLen(30),
 		field.String("server").MaxLen(2),
 		field.Bool("visible").Default(true),
+		field.Bool("verified").Default(false).Comment("Ownership proven through a profile challenge"),
+		field.Time("verified_at").Optional().Nillable(),
+		field.String("label").MaxLen(50).Optional().Nillable(),
+		field.Int("sort_order").Default(0).Comment("Display position among the user's bindings of the server"),
 	}
 }
 
 func (UserBinding) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("default_refs", UserDefaultBinding.Type),
+		edge.To("default_refs", UserDefaultBinding.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
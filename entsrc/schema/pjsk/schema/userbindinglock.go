@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserBindingLock holds one row per user that adding a binding upserts first,
+// so concurrent adds of the same user queue up behind its row lock.
+type UserBindingLock struct {
+	ent.Schema
+}
+
+func (UserBindingLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("haruki_user_id").Comment("Reference to users table"),
+		field.Time("locked_at").Comment("Time of the last add that took the lock"),
+	}
+}
+
+func (UserBindingLock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("haruki_user_id").Unique(),
+	}
+}
@@ -13,6 +13,9 @@ func main() {
 	if err := entc.Generate("./schema", &gen.Config{
 		Package: "haruki-database/database/schema/users",
 		Target:  "../../../database/schema/users",
+		Features: []gen.Feature{
+			gen.FeatureUpsert,
+		},
 	}); err != nil {
 		log.Fatal("running ent codegen:", err)
 	}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GameBinding stores bindings of games declared in the game_bindings config,
+// which have no dedicated binding tables.
+type GameBinding struct {
+	ent.Schema
+}
+
+func (GameBinding) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("game").
+			MaxLen(20).
+			Comment("Game name declared in the game_bindings config"),
+		field.Int("haruki_user_id").
+			Comment("Reference to users table"),
+		field.String("server").
+			MaxLen(20),
+		field.String("account_id").
+			MaxLen(100).
+			Comment("Account ID in the game"),
+		field.String("label").
+			MaxLen(50).
+			Optional().
+			Nillable(),
+		field.Bool("visible").
+			Default(true),
+		field.Bool("is_default").
+			Default(false).
+			Comment("Whether this is the default binding of its server"),
+		field.Int("sort_order").
+			Default(0),
+	}
+}
+
+func (GameBinding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("game", "haruki_user_id", "server", "account_id").Unique(),
+		index.Fields("game", "server", "account_id"),
+	}
+}
+
+func (GameBinding) Edges() []ent.Edge {
+	return nil
+}
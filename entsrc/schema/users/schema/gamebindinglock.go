@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GameBindingLock holds one row per game and user that adding a game binding
+// upserts first, so concurrent adds of the same user queue up behind its row
+// lock.
+type GameBindingLock struct {
+	ent.Schema
+}
+
+func (GameBindingLock) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("game").
+			MaxLen(20).
+			Comment("Game name declared in the game_bindings config"),
+		field.Int("haruki_user_id").
+			Comment("Reference to users table"),
+		field.Time("locked_at").
+			Comment("Time of the last add that took the lock"),
+	}
+}
+
+func (GameBindingLock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("game", "haruki_user_id").Unique(),
+	}
+}
+
+func (GameBindingLock) Edges() []ent.Edge {
+	return nil
+}
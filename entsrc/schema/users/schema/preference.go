@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Preference struct {
+	ent.Schema
+}
+
+func (Preference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("haruki_user_id").
+			Comment("Reference to users table"),
+		field.String("scope").
+			MaxLen(20).
+			Comment("Preference scope: global, game or group"),
+		field.String("scope_key").
+			MaxLen(100).
+			Default("").
+			Comment("Game name or platform:group_id, empty for the global scope"),
+		field.String("option").
+			MaxLen(50),
+		field.String("value").
+			MaxLen(50),
+	}
+}
+
+func (Preference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("haruki_user_id", "scope", "scope_key", "option").Unique(),
+	}
+}
+
+func (Preference) Edges() []ent.Edge {
+	return nil
+}
@@ -5,21 +5,28 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
+	"syscall"
 
 	harukiConfig "haruki-database/config"
+	"haruki-database/utils"
+	harukiEvents "haruki-database/utils/events"
 	harukiLogger "haruki-database/utils/logger"
 	harukiRedis "haruki-database/utils/redis"
 
 	botAPI "haruki-database/api/bot"
 	censorAPI "haruki-database/api/censor"
 	chunithmAPI "haruki-database/api/chunithm"
+	gameBindingAPI "haruki-database/api/gamebinding"
 	PJSKAPI "haruki-database/api/pjsk"
+	preferenceAPI "haruki-database/api/preference"
 	usersAPI "haruki-database/api/users"
 	censorTool "haruki-database/utils/censor"
 
 	botDB "haruki-database/database/schema/bot"
 	censorDB "haruki-database/database/schema/censor"
 	chunithmMainDB "haruki-database/database/schema/chunithm/maindb"
+	chunithmMainMigrate "haruki-database/database/schema/chunithm/maindb/migrate"
 	chunithmMusicDB "haruki-database/database/schema/chunithm/music"
 	pjskDB "haruki-database/database/schema/pjsk"
 	usersDB "haruki-database/database/schema/users"
@@ -37,22 +44,44 @@ import (
 var Version = "2.0.0-dev"
 
 func main() {
+	if len(os.Args) > 1 {
+		os.Exit(runCommand(os.Args[1:]))
+	}
 	loggerWriter := setupLogging()
 	mainLogger := harukiLogger.NewLogger("Main", harukiConfig.Cfg.Backend.LogLevel, loggerWriter)
 	logStartupInfo(mainLogger)
 	redisClient := initRedis(mainLogger)
+	publisher := initEvents(mainLogger, redisClient)
 	app := createFiberApp(mainLogger)
-	usersDBClient := initUsers(mainLogger, app)
-	chunithmMainClient, chunithmMusicClient := initChunithmIfEnabled(mainLogger, app, redisClient, usersDBClient)
-	pjskClient := initPJSKIfEnabled(mainLogger, app, redisClient, usersDBClient)
+	usersDBClient := initUsers(mainLogger, app, redisClient, publisher)
+	chunithmPolicy := parseSharedBindingPolicy(mainLogger, "Chunithm", harukiConfig.Cfg.Chunithm.SharedBindingPolicy)
+	pjskPolicy := parseSharedBindingPolicy(mainLogger, "PJSK", harukiConfig.Cfg.PJSK.SharedBindingPolicy)
+	chunithmMainClient, chunithmMusicClient := initChunithmIfEnabled(mainLogger, app, redisClient, usersDBClient, chunithmPolicy, publisher)
+	pjskClient := initPJSKIfEnabled(mainLogger, app, redisClient, usersDBClient, pjskPolicy, publisher)
+	initGameBindings(mainLogger, app, redisClient, usersDBClient, chunithmMainClient, chunithmPolicy, pjskClient, pjskPolicy, publisher)
 	censorDBClient, _ := initCensor(mainLogger, app, usersDBClient, redisClient)
 	botDBClient := initBot(mainLogger, app, redisClient)
 
 	defer closeClients(chunithmMainClient, chunithmMusicClient, pjskClient, censorDBClient, botDBClient, usersDBClient)
+	defer publisher.Close()
 
+	go shutdownOnSignal(mainLogger, app)
 	startServer(mainLogger, app)
 }
 
+// shutdownOnSignal stops the server on SIGINT or SIGTERM. Listen then returns
+// and the deferred cleanup in main flushes the event publisher and closes the
+// database clients.
+func shutdownOnSignal(mainLogger *harukiLogger.Logger, app *fiber.App) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-signals
+	mainLogger.Infof("Received %s, shutting down", sig)
+	if err := app.Shutdown(); err != nil {
+		mainLogger.Errorf("Failed to shut down HTTP server: %v", err)
+	}
+}
+
 func setupLogging() io.Writer {
 	var logFile *os.File
 	loggerWriter := io.Writer(os.Stdout)
@@ -88,6 +117,17 @@ func initRedis(mainLogger *harukiLogger.Logger) *redis.Client {
 	return redisClient
 }
 
+// initEvents starts the change event publisher, or returns nil when events
+// are disabled.
+func initEvents(mainLogger *harukiLogger.Logger, redisClient *redis.Client) *harukiEvents.Publisher {
+	publisher, err := harukiEvents.NewPublisher(harukiConfig.Cfg.Events, redisClient)
+	if err != nil {
+		mainLogger.Errorf("Invalid events config: %v", err)
+		os.Exit(1)
+	}
+	return publisher
+}
+
 func createFiberApp(mainLogger *harukiLogger.Logger) *fiber.App {
 	app := fiber.New(fiber.Config{
 		BodyLimit:   30 * 1024 * 1024,
@@ -115,7 +155,19 @@ func createFiberApp(mainLogger *harukiLogger.Logger) *fiber.App {
 	return app
 }
 
-func initChunithmIfEnabled(mainLogger *harukiLogger.Logger, app *fiber.App, redisClient *redis.Client, usersClient *usersDB.Client) (*chunithmMainDB.Client, *chunithmMusicDB.Client) {
+// parseSharedBindingPolicy validates a configured shared binding policy once
+// at startup, exiting on an invalid value.
+func parseSharedBindingPolicy(mainLogger *harukiLogger.Logger, game, value string) utils.SharedBindingPolicy {
+	policy, err := utils.ParseSharedBindingPolicy(value)
+	if err != nil {
+		mainLogger.Errorf("Invalid %s config: %v", game, err)
+		os.Exit(1)
+	}
+	return policy
+}
+
+func initChunithmIfEnabled(mainLogger *harukiLogger.Logger, app *fiber.App, redisClient *redis.Client, usersClient *usersDB.Client,
+	policy utils.SharedBindingPolicy, publisher *harukiEvents.Publisher) (*chunithmMainDB.Client, *chunithmMusicDB.Client) {
 	if !harukiConfig.Cfg.Chunithm.Enabled {
 		return nil, nil
 	}
@@ -125,10 +177,15 @@ func initChunithmIfEnabled(mainLogger *harukiLogger.Logger, app *fiber.App, redi
 		mainLogger.Errorf("Failed to connect to Chunithm main DB: %v", err)
 		os.Exit(1)
 	}
-	if err := chunithmMainClient.Schema.Create(context.Background()); err != nil {
+	// Dropping stale indexes removes the old one-card-per-server unique index.
+	if err := chunithmMainClient.Schema.Create(context.Background(), chunithmMainMigrate.WithDropIndex(true)); err != nil {
 		mainLogger.Errorf("Failed to create schema for Chunithm main DB: %v", err)
 		os.Exit(1)
 	}
+	if err := chunithmAPI.MigrateBindingDefaults(context.Background(), chunithmMainClient); err != nil {
+		mainLogger.Errorf("Failed to migrate Chunithm binding defaults: %v", err)
+		os.Exit(1)
+	}
 
 	chunithmMusicClient, err := chunithmMusicDB.Open(harukiConfig.Cfg.Chunithm.MusicDBType, harukiConfig.Cfg.Chunithm.MusicDBURL)
 	if err != nil {
@@ -140,11 +197,12 @@ func initChunithmIfEnabled(mainLogger *harukiLogger.Logger, app *fiber.App, redi
 		os.Exit(1)
 	}
 
-	chunithmAPI.RegisterChunithmRoutes(app, chunithmMainClient, chunithmMusicClient, redisClient, usersClient)
+	chunithmAPI.RegisterChunithmRoutes(app, chunithmMainClient, chunithmMusicClient, redisClient, usersClient, policy, publisher)
 	return chunithmMainClient, chunithmMusicClient
 }
 
-func initPJSKIfEnabled(mainLogger *harukiLogger.Logger, app *fiber.App, redisClient *redis.Client, usersClient *usersDB.Client) *pjskDB.Client {
+func initPJSKIfEnabled(mainLogger *harukiLogger.Logger, app *fiber.App, redisClient *redis.Client, usersClient *usersDB.Client,
+	policy utils.SharedBindingPolicy, publisher *harukiEvents.Publisher) *pjskDB.Client {
 	if !harukiConfig.Cfg.PJSK.Enabled {
 		return nil
 	}
@@ -154,15 +212,44 @@ func initPJSKIfEnabled(mainLogger *harukiLogger.Logger, app *fiber.App, redisCli
 		mainLogger.Errorf("Failed to connect to PJSK DB: %v", err)
 		os.Exit(1)
 	}
-	if err := pjskClient.Schema.Create(context.Background()); err != nil {
+	if err := PJSKAPI.CreateSchema(context.Background(), pjskClient); err != nil {
 		mainLogger.Errorf("Failed to create schema for PJSK DB: %v", err)
 		os.Exit(1)
 	}
+	if err := PJSKAPI.MigratePreferences(context.Background(), pjskClient, usersClient); err != nil {
+		mainLogger.Errorf("Failed to migrate PJSK preferences: %v", err)
+		os.Exit(1)
+	}
 
-	PJSKAPI.RegisterPJSKRoutes(app, pjskClient, redisClient, usersClient)
+	PJSKAPI.RegisterPJSKRoutes(app, pjskClient, redisClient, usersClient, policy, publisher)
 	return pjskClient
 }
 
+// initGameBindings registers the enabled games and the games of the
+// game_bindings config with the generic binding API.
+func initGameBindings(mainLogger *harukiLogger.Logger, app *fiber.App, redisClient *redis.Client, usersClient *usersDB.Client,
+	chunithmMainClient *chunithmMainDB.Client, chunithmPolicy utils.SharedBindingPolicy, pjskClient *pjskDB.Client, pjskPolicy utils.SharedBindingPolicy,
+	publisher *harukiEvents.Publisher) {
+	registry := gameBindingAPI.NewRegistry()
+	if pjskClient != nil {
+		if err := registry.Register(PJSKAPI.NewBindingGame(pjskClient, redisClient, usersClient, pjskPolicy, publisher)); err != nil {
+			mainLogger.Errorf("Failed to register PJSK bindings: %v", err)
+			os.Exit(1)
+		}
+	}
+	if chunithmMainClient != nil {
+		if err := registry.Register(chunithmAPI.NewBindingGame(chunithmMainClient, redisClient, usersClient, chunithmPolicy, publisher)); err != nil {
+			mainLogger.Errorf("Failed to register Chunithm bindings: %v", err)
+			os.Exit(1)
+		}
+	}
+	if err := gameBindingAPI.RegisterConfiguredGames(registry, usersClient, harukiConfig.Cfg.GameBindings, publisher); err != nil {
+		mainLogger.Errorf("Invalid game_bindings config: %v", err)
+		os.Exit(1)
+	}
+	gameBindingAPI.RegisterGameBindingRoutes(app, registry)
+}
+
 func initCensor(mainLogger *harukiLogger.Logger, app *fiber.App, usersClient *usersDB.Client, redisClient *redis.Client) (*censorDB.Client, *censorTool.Service) {
 	censorDBClient, err := censorDB.Open(harukiConfig.Cfg.Censor.CensorDBType, harukiConfig.Cfg.Censor.CensorDBURL)
 	if err != nil {
@@ -195,7 +282,7 @@ func initBot(mainLogger *harukiLogger.Logger, app *fiber.App, redisClient *redis
 	return botDBClient
 }
 
-func initUsers(mainLogger *harukiLogger.Logger, app *fiber.App) *usersDB.Client {
+func initUsers(mainLogger *harukiLogger.Logger, app *fiber.App, redisClient *redis.Client, publisher *harukiEvents.Publisher) *usersDB.Client {
 	usersDBClient, err := usersDB.Open(harukiConfig.Cfg.UsersDB.DBType, harukiConfig.Cfg.UsersDB.DBURL)
 	if err != nil {
 		mainLogger.Errorf("Failed to initialize Users entgo client: %v", err)
@@ -207,6 +294,7 @@ func initUsers(mainLogger *harukiLogger.Logger, app *fiber.App) *usersDB.Client
 	}
 
 	usersAPI.RegisterUsersRoutes(app, usersDBClient)
+	preferenceAPI.RegisterPreferenceRoutes(app, usersDBClient, redisClient, publisher)
 	return usersDBClient
 }
 
@@ -1,6 +1,9 @@
 package utils
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // ================= Validation Constants =================
 
@@ -29,6 +32,27 @@ const (
 	ErrInternalServer      = "Internal server error"
 )
 
+// ================= Error Codes =================
+
+// Machine-readable codes sent in the "error_code" field of error responses.
+const (
+	ErrCodeInvalidRequest      = "invalid_request"
+	ErrCodeInvalidHarukiUserID = "invalid_haruki_user_id"
+	ErrCodeInvalidServer       = "invalid_server"
+	ErrCodeInvalidAimeID       = "invalid_aime_id"
+	ErrCodeInvalidLabel        = "invalid_label"
+	ErrCodeInvalidOrder        = "invalid_order"
+	ErrCodeBindingShared       = "binding_shared"
+	ErrCodeChallengeNotFound   = "challenge_not_found"
+	ErrCodeChallengeMismatch   = "challenge_mismatch"
+	ErrCodeBindingLimit        = "binding_limit_reached"
+	ErrCodeUnknownPreference   = "unknown_preference"
+	ErrCodeInvalidPreference   = "invalid_preference_value"
+	ErrCodeInvalidScope        = "invalid_scope"
+	ErrCodeUnknownGame         = "unknown_game"
+	ErrCodeInvalidAccountID    = "invalid_account_id"
+)
+
 // ================= Alias Type Enum =================
 
 type AliasType string
@@ -78,6 +102,9 @@ func (s BindingServer) Valid() bool {
 	}
 }
 
+// BindingServers lists every valid binding server
+var BindingServers = []BindingServer{BindingServerJP, BindingServerEN, BindingServerTW, BindingServerKR, BindingServerCN}
+
 func ParseBindingServer(s string) (BindingServer, error) {
 	bs := BindingServer(s)
 	if !bs.Valid() {
@@ -117,3 +144,200 @@ func ParseDefaultBindingServer(s string) (DefaultBindingServer, error) {
 	}
 	return dbs, nil
 }
+
+// ================= Shared Binding Policy Enum =================
+
+// SharedBindingPolicy decides what happens when a game account is bound by
+// more than one Haruki user.
+type SharedBindingPolicy string
+
+const (
+	SharedBindingPolicyAllow  SharedBindingPolicy = "allow"
+	SharedBindingPolicyFlag   SharedBindingPolicy = "flag"
+	SharedBindingPolicyForbid SharedBindingPolicy = "forbid"
+)
+
+// Valid returns true if the shared binding policy is valid
+func (p SharedBindingPolicy) Valid() bool {
+	switch p {
+	case SharedBindingPolicyAllow, SharedBindingPolicyFlag, SharedBindingPolicyForbid:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseSharedBindingPolicy parses a configured policy; an empty value means
+// SharedBindingPolicyAllow.
+func ParseSharedBindingPolicy(p string) (SharedBindingPolicy, error) {
+	if p == "" {
+		return SharedBindingPolicyAllow, nil
+	}
+	sp := SharedBindingPolicy(p)
+	if !sp.Valid() {
+		return "", fmt.Errorf("invalid shared binding policy: %s", p)
+	}
+	return sp, nil
+}
+
+// ================= Preference Type Enum =================
+
+// PreferenceType is the value type of a registered preference option.
+type PreferenceType string
+
+const (
+	PreferenceTypeBool   PreferenceType = "bool"
+	PreferenceTypeInt    PreferenceType = "int"
+	PreferenceTypeEnum   PreferenceType = "enum"
+	PreferenceTypeString PreferenceType = "string"
+)
+
+// Valid returns true if the preference type is valid
+func (t PreferenceType) Valid() bool {
+	switch t {
+	case PreferenceTypeBool, PreferenceTypeInt, PreferenceTypeEnum, PreferenceTypeString:
+		return true
+	default:
+		return false
+	}
+}
+
+// ================= Game Enum =================
+
+type Game string
+
+const (
+	GamePJSK     Game = "pjsk"
+	GameChunithm Game = "chunithm"
+)
+
+// Valid returns true if the game is valid
+func (g Game) Valid() bool {
+	switch g {
+	case GamePJSK, GameChunithm:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParseGame(g string) (Game, error) {
+	game := Game(g)
+	if !game.Valid() {
+		return "", fmt.Errorf("invalid game: %s", g)
+	}
+	return game, nil
+}
+
+// ================= Preference Scope Enum =================
+
+// PreferenceScope is the level a preference is stored at. Effective values
+// are resolved group first, then game, then global.
+type PreferenceScope string
+
+const (
+	PreferenceScopeGlobal PreferenceScope = "global"
+	PreferenceScopeGame   PreferenceScope = "game"
+	PreferenceScopeGroup  PreferenceScope = "group"
+)
+
+// Valid returns true if the preference scope is valid
+func (s PreferenceScope) Valid() bool {
+	switch s {
+	case PreferenceScopeGlobal, PreferenceScopeGame, PreferenceScopeGroup:
+		return true
+	default:
+		return false
+	}
+}
+
+func ParsePreferenceScope(s string) (PreferenceScope, error) {
+	ps := PreferenceScope(s)
+	if !ps.Valid() {
+		return "", fmt.Errorf("invalid scope: %s", s)
+	}
+	return ps, nil
+}
+
+// ================= Change Event Type Enum =================
+
+// EventType names a change published on the events channel and sent to
+// webhooks.
+type EventType string
+
+const (
+	EventBindingCreated        EventType = "binding.created"
+	EventBindingUpdated        EventType = "binding.updated"
+	EventBindingDeleted        EventType = "binding.deleted"
+	EventBindingReordered      EventType = "binding.reordered"
+	EventBindingVerified       EventType = "binding.verified"
+	EventDefaultBindingChanged EventType = "binding.default_changed"
+	EventDefaultServerChanged  EventType = "default_server.changed"
+	EventPreferenceChanged     EventType = "preference.changed"
+)
+
+// ================= Chunithm Server Enum =================
+
+type ChunithmServer string
+
+const (
+	ChunithmServerJP   ChunithmServer = "jp"
+	ChunithmServerIntl ChunithmServer = "intl"
+	ChunithmServerCN   ChunithmServer = "cn"
+)
+
+// Valid returns true if the Chunithm server is valid
+func (s ChunithmServer) Valid() bool {
+	switch s {
+	case ChunithmServerJP, ChunithmServerIntl, ChunithmServerCN:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChunithmServers lists every valid Chunithm server
+var ChunithmServers = []ChunithmServer{ChunithmServerJP, ChunithmServerIntl, ChunithmServerCN}
+
+func ParseChunithmServer(s string) (ChunithmServer, error) {
+	cs := ChunithmServer(s)
+	if !cs.Valid() {
+		return "", fmt.Errorf("invalid server: %s", s)
+	}
+	return cs, nil
+}
+
+// ================= Aime ID Validation =================
+
+// AimeIDRule describes the access code format accepted on a Chunithm server.
+// Checksum is optional and runs after the length and charset checks.
+type AimeIDRule struct {
+	Length   int
+	Checksum func(aimeID string) bool
+}
+
+// AimeIDRules holds the access code format of every Chunithm server. All
+// servers currently use the 20-digit access code printed on Aime cards.
+var AimeIDRules = map[ChunithmServer]AimeIDRule{
+	ChunithmServerJP:   {Length: 20},
+	ChunithmServerIntl: {Length: 20},
+	ChunithmServerCN:   {Length: 20},
+}
+
+// ValidateAimeID checks aimeID against the access code format of server
+func ValidateAimeID(server ChunithmServer, aimeID string) error {
+	rule, ok := AimeIDRules[server]
+	if !ok {
+		return fmt.Errorf("invalid server: %s", server)
+	}
+	if len(aimeID) != rule.Length {
+		return fmt.Errorf("invalid aime_id: must be %d digits", rule.Length)
+	}
+	if strings.Trim(aimeID, "0123456789") != "" {
+		return fmt.Errorf("invalid aime_id: must contain digits only")
+	}
+	if rule.Checksum != nil && !rule.Checksum(aimeID) {
+		return fmt.Errorf("invalid aime_id: checksum mismatch")
+	}
+	return nil
+}
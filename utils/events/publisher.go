@@ -0,0 +1,95 @@
+package events
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"haruki-database/config"
+	"haruki-database/utils"
+	"haruki-database/utils/logger"
+	"haruki-database/utils/types"
+
+	"github.com/bytedance/sonic"
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultChannel is the Redis channel events are published on when the
+// config leaves it empty.
+const DefaultChannel = "hdb:events"
+
+type Event = types.ChangeEvent
+
+// Publisher publishes change events on a Redis channel and hands them to the
+// webhook dispatcher. A nil *Publisher drops every event, so services may
+// hold one whether or not events are enabled.
+type Publisher struct {
+	redisClient *redis.Client
+	channel     string
+	webhooks    *Dispatcher
+	logger      *logger.Logger
+}
+
+// NewPublisher builds the publisher described by cfg. It returns nil when
+// events are disabled, and starts the webhook dispatcher when URLs are set.
+func NewPublisher(cfg config.EventsConfig, redisClient *redis.Client) (*Publisher, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	p := &Publisher{
+		redisClient: redisClient,
+		channel:     cfg.Channel,
+		logger:      logger.NewLogger("HarukiEventPublisher", "INFO", nil),
+	}
+	if p.channel == "" {
+		p.channel = DefaultChannel
+	}
+	if len(cfg.Webhooks.URLs) > 0 {
+		webhooks, err := NewDispatcher(cfg.Webhooks, redisClient, p.logger)
+		if err != nil {
+			return nil, err
+		}
+		p.webhooks = webhooks
+	}
+	return p, nil
+}
+
+// Publish stamps the event with an id and the current time, publishes it and
+// queues it for the webhooks. Failures are logged and never returned: the
+// change has already been committed when an event is published.
+func (p *Publisher) Publish(ctx context.Context, eventType utils.EventType, event Event) {
+	if p == nil {
+		return
+	}
+	event.ID = newEventID()
+	event.Type = string(eventType)
+	event.Timestamp = time.Now().Unix()
+	payload, err := sonic.Marshal(event)
+	if err != nil {
+		p.logger.Errorf("failed to encode %s event: %v", event.Type, err)
+		return
+	}
+	if err := p.redisClient.Publish(ctx, p.channel, payload).Err(); err != nil {
+		p.logger.Errorf("failed to publish %s event %s: %v", event.Type, event.ID, err)
+	}
+	p.webhooks.Enqueue(event.ID, event.Type, payload)
+}
+
+// Close stops the webhook dispatcher, moving undelivered events to the
+// dead-letter list.
+func (p *Publisher) Close() {
+	if p == nil {
+		return
+	}
+	p.webhooks.Close()
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
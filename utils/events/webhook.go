@@ -0,0 +1,254 @@
+package events
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"haruki-database/config"
+	"haruki-database/utils/logger"
+	"haruki-database/utils/types"
+
+	"github.com/bytedance/sonic"
+	"github.com/go-resty/resty/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// ================= Webhook Defaults =================
+
+const (
+	DefaultWebhookTimeout      = 10 * time.Second
+	DefaultMaxAttempts         = 5
+	DefaultInitialBackoff      = time.Second
+	DefaultMaxBackoff          = time.Minute
+	DefaultWebhookWorkers      = 4
+	DefaultWebhookQueueSize    = 1000
+	DefaultDeadLetterKey       = "hdb:events:dead_letter"
+	DefaultDeadLetterMaxLength = 10000
+)
+
+// ================= Webhook Headers =================
+
+// Every delivery carries these headers. The signature is
+// "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body)).
+const (
+	HeaderEvent     = "X-Haruki-Event"
+	HeaderDelivery  = "X-Haruki-Delivery"
+	HeaderTimestamp = "X-Haruki-Timestamp"
+	HeaderSignature = "X-Haruki-Signature"
+)
+
+var errDispatcherClosed = errors.New("webhook dispatcher closed")
+
+type DeadLetter = types.WebhookDeadLetter
+
+type delivery struct {
+	url       string
+	eventID   string
+	eventType string
+	payload   []byte
+}
+
+// Dispatcher POSTs events to the configured webhooks from a pool of workers.
+// Failed deliveries are retried with exponential backoff and pushed to the
+// dead-letter list after the last attempt.
+type Dispatcher struct {
+	client      *resty.Client
+	redisClient *redis.Client
+	cfg         config.WebhooksConfig
+	queue       chan delivery
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	logger      *logger.Logger
+}
+
+// NewDispatcher validates cfg, fills in the defaults and starts the workers.
+func NewDispatcher(cfg config.WebhooksConfig, redisClient *redis.Client, log *logger.Logger) (*Dispatcher, error) {
+	if cfg.Secret == "" {
+		return nil, fmt.Errorf("webhooks need a secret to sign payloads")
+	}
+	for _, u := range cfg.URLs {
+		parsed, err := url.Parse(u)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid webhook url: %s", u)
+		}
+	}
+	applyWebhookDefaults(&cfg)
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Dispatcher{
+		client:      resty.New().SetTimeout(cfg.Timeout),
+		redisClient: redisClient,
+		cfg:         cfg,
+		queue:       make(chan delivery, cfg.QueueSize),
+		ctx:         ctx,
+		cancel:      cancel,
+		logger:      log,
+	}
+	for range cfg.Workers {
+		d.wg.Add(1)
+		go d.work()
+	}
+	return d, nil
+}
+
+func applyWebhookDefaults(cfg *config.WebhooksConfig) {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultWebhookTimeout
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = DefaultInitialBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = DefaultWebhookWorkers
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = DefaultWebhookQueueSize
+	}
+	if cfg.DeadLetterKey == "" {
+		cfg.DeadLetterKey = DefaultDeadLetterKey
+	}
+	if cfg.DeadLetterMaxLength <= 0 {
+		cfg.DeadLetterMaxLength = DefaultDeadLetterMaxLength
+	}
+}
+
+// Sign returns the signature header value of payload sent at timestamp.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Enqueue queues the event for every webhook without blocking. Deliveries
+// that do not fit in the queue go straight to the dead-letter list.
+func (d *Dispatcher) Enqueue(eventID, eventType string, payload []byte) {
+	if d == nil {
+		return
+	}
+	for _, u := range d.cfg.URLs {
+		dl := delivery{url: u, eventID: eventID, eventType: eventType, payload: payload}
+		if d.ctx.Err() != nil {
+			d.deadLetter(dl, 0, errDispatcherClosed)
+			continue
+		}
+		select {
+		case d.queue <- dl:
+		default:
+			d.deadLetter(dl, 0, errors.New("webhook queue full"))
+		}
+	}
+}
+
+// Close stops the workers and dead-letters the deliveries still queued or
+// waiting for a retry.
+func (d *Dispatcher) Close() {
+	if d == nil {
+		return
+	}
+	d.cancel()
+	d.wg.Wait()
+	for {
+		select {
+		case dl := <-d.queue:
+			d.deadLetter(dl, 0, errDispatcherClosed)
+		default:
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) work() {
+	defer d.wg.Done()
+	for {
+		select {
+		case dl := <-d.queue:
+			d.deliver(dl)
+		case <-d.ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(dl delivery) {
+	backoff := d.cfg.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := d.post(dl)
+		if err == nil {
+			return
+		}
+		if attempt >= d.cfg.MaxAttempts {
+			d.deadLetter(dl, attempt, err)
+			return
+		}
+		select {
+		case <-time.After(backoff):
+		case <-d.ctx.Done():
+			d.deadLetter(dl, attempt, err)
+			return
+		}
+		backoff = min(backoff*2, d.cfg.MaxBackoff)
+	}
+}
+
+func (d *Dispatcher) post(dl delivery) error {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	resp, err := d.client.R().
+		SetContext(d.ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader(HeaderEvent, dl.eventType).
+		SetHeader(HeaderDelivery, dl.eventID).
+		SetHeader(HeaderTimestamp, timestamp).
+		SetHeader(HeaderSignature, Sign(d.cfg.Secret, timestamp, dl.payload)).
+		SetBody(dl.payload).
+		Post(dl.url)
+	if err != nil {
+		return err
+	}
+	if !resp.IsSuccess() {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode())
+	}
+	return nil
+}
+
+// deadLetter pushes a failed delivery to the head of the dead-letter list and
+// trims the list to its maximum length.
+func (d *Dispatcher) deadLetter(dl delivery, attempts int, cause error) {
+	d.logger.Warnf("webhook delivery of event %s to %s failed after %d attempts: %v", dl.eventID, dl.url, attempts, cause)
+	entry, err := sonic.Marshal(DeadLetter{
+		URL:       dl.url,
+		EventID:   dl.eventID,
+		EventType: dl.eventType,
+		Payload:   string(dl.payload),
+		Attempts:  attempts,
+		LastError: cause.Error(),
+		FailedAt:  time.Now().Unix(),
+	})
+	if err != nil {
+		d.logger.Errorf("failed to encode dead letter of event %s: %v", dl.eventID, err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), d.cfg.Timeout)
+	defer cancel()
+	pipe := d.redisClient.TxPipeline()
+	pipe.LPush(ctx, d.cfg.DeadLetterKey, entry)
+	pipe.LTrim(ctx, d.cfg.DeadLetterKey, 0, d.cfg.DeadLetterMaxLength-1)
+	if _, err := pipe.Exec(ctx); err != nil {
+		d.logger.Errorf("failed to dead-letter event %s: %v", dl.eventID, err)
+	}
+}
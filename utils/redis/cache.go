@@ -63,6 +63,17 @@ func CacheKeyBuilder(c fiber.Ctx, namespace string) string {
 	return fmt.Sprintf("%s:%s:query=%s", namespace, fullPath, queryHash)
 }
 
+// CacheKey builds the key CacheKeyBuilder would produce for a request to path
+// with the given query string, for caches filled outside a request handler.
+func CacheKey(namespace, path, queryString string) string {
+	queryHash := "none"
+	if canonicalQuery := CanonicalizeQueryString(queryString); canonicalQuery != "" {
+		hash := md5.Sum([]byte(canonicalQuery))
+		queryHash = hex.EncodeToString(hash[:])
+	}
+	return fmt.Sprintf("%s:%s:query=%s", namespace, path, queryHash)
+}
+
 func SetCache(ctx context.Context, client *redis.Client, key string, value interface{}, ttl time.Duration) error {
 	data, err := sonic.Marshal(value)
 	if err != nil {
@@ -82,6 +93,43 @@ func GetCache(ctx context.Context, client *redis.Client, key string, out interfa
 	return true, sonic.Unmarshal([]byte(val), out)
 }
 
+// GetCacheMulti fetches keys with a single MGET and returns the raw values of
+// the keys that were found.
+func GetCacheMulti(ctx context.Context, client *redis.Client, keys []string) (map[string][]byte, error) {
+	found := make(map[string][]byte, len(keys))
+	if len(keys) == 0 {
+		return found, nil
+	}
+	values, err := client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range values {
+		if s, ok := v.(string); ok {
+			found[keys[i]] = []byte(s)
+		}
+	}
+	return found, nil
+}
+
+// SetCacheMulti stores every entry with the same ttl in one pipeline, since
+// MSET cannot set an expiry.
+func SetCacheMulti(ctx context.Context, client *redis.Client, entries map[string]interface{}, ttl time.Duration) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	pipe := client.Pipeline()
+	for key, value := range entries {
+		data, err := sonic.Marshal(value)
+		if err != nil {
+			return err
+		}
+		pipe.Set(ctx, key, data, ttl)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
 func DeleteCache(ctx context.Context, client *redis.Client, key string) error {
 	return client.Del(ctx, key).Err()
 }
@@ -44,3 +44,7 @@ func ClearAllCacheForPath(ctx context.Context, redisClient *redis.Client, namesp
 	}
 	return nil
 }
+
+func ClearNamespace(ctx context.Context, redisClient *redis.Client, namespace string) error {
+	return ClearAllCacheForPath(ctx, redisClient, namespace, "*")
+}
@@ -13,6 +13,15 @@ type ChunithmMusicInfo struct {
 	ReleaseDate    *time.Time `json:"release_date,omitempty"`
 	IsDeleted      *bool      `json:"is_deleted,omitempty"`
 	DeletedVersion *string    `json:"deleted_version,omitempty"`
+	Server         *string    `json:"server,omitempty"`
+}
+
+type ChunithmMusicAvailability struct {
+	Server         string     `json:"server"`
+	ReleaseVersion *string    `json:"release_version,omitempty"`
+	ReleaseDate    *time.Time `json:"release_date,omitempty"`
+	IsDeleted      bool       `json:"is_deleted"`
+	DeletedVersion *string    `json:"deleted_version,omitempty"`
 }
 
 type ChunithmMusicDifficulty struct {
@@ -25,6 +34,31 @@ type ChunithmMusicDifficulty struct {
 	Diff4   *float64 `json:"diff4_const,omitempty"`
 }
 
+type ChunithmVersion struct {
+	Version      string     `json:"version"`
+	Name         *string    `json:"name,omitempty"`
+	ReleaseOrder int        `json:"release_order"`
+	ReleaseDate  *time.Time `json:"release_date,omitempty"`
+}
+
+type ChunithmDifficultyChange struct {
+	Difficulty int      `json:"difficulty"`
+	From       *float64 `json:"from"`
+	To         *float64 `json:"to"`
+}
+
+type ChunithmDifficultyHistoryEntry struct {
+	Version     string                     `json:"version"`
+	ReleaseDate *time.Time                 `json:"release_date,omitempty"`
+	Difficulty  []*float64                 `json:"difficulty"`
+	Changes     []ChunithmDifficultyChange `json:"changes"`
+}
+
+type ChunithmDifficultyHistory struct {
+	MusicID int                              `json:"music_id"`
+	History []ChunithmDifficultyHistoryEntry `json:"history"`
+}
+
 type ChunithmChartData struct {
 	Difficulty int      `json:"difficulty"`
 	Creator    *string  `json:"creator,omitempty"`
@@ -35,12 +69,213 @@ type ChunithmChartData struct {
 	AirCount   *int     `json:"air_count,omitempty"`
 	FlickCount *int     `json:"flick_count,omitempty"`
 	TotalCount *int     `json:"total_count,omitempty"`
+	Duration   *float64 `json:"duration,omitempty"`
 }
 
 type ChunithmMusicBatchItem struct {
-	Version    *string           `json:"version,omitempty"`
-	Difficulty []*float64        `json:"difficulty"`
-	Info       ChunithmMusicInfo `json:"info"`
+	Version    *string             `json:"version,omitempty"`
+	Difficulty []*float64          `json:"difficulty"`
+	Info       ChunithmMusicInfo   `json:"info"`
+	Charts     []ChunithmChartData `json:"charts,omitempty"`
+	Aliases    []string            `json:"aliases,omitempty"`
+}
+
+type ChunithmQueryBatchRequest struct {
+	MusicIDs       []int  `json:"music_ids"`
+	Version        string `json:"version"`
+	IncludeCharts  bool   `json:"include_charts"`
+	IncludeAliases bool   `json:"include_aliases"`
+	Server         string `json:"server,omitempty"`
+	HarukiUserID   int    `json:"haruki_user_id,omitempty"`
+}
+
+type ChunithmQueryBatchResponse struct {
+	Items   map[int]ChunithmMusicBatchItem `json:"items"`
+	Missing []int                          `json:"missing"`
+}
+
+type ChunithmMusicSearchResponse struct {
+	Total    int                      `json:"total"`
+	Page     int                      `json:"page"`
+	PageSize int                      `json:"page_size"`
+	Items    []ChunithmMusicBatchItem `json:"items"`
+}
+
+// ================= Chunithm Chart Statistics Types =================
+
+type ChunithmChartSummary struct {
+	MusicID        int      `json:"music_id"`
+	Difficulty     int      `json:"difficulty"`
+	Title          string   `json:"title"`
+	Creator        *string  `json:"creator,omitempty"`
+	Const          *float64 `json:"const"`
+	TotalCount     *int     `json:"total_count,omitempty"`
+	Duration       *float64 `json:"duration,omitempty"`
+	NotesPerMinute *float64 `json:"notes_per_minute,omitempty"`
+}
+
+type ChunithmConstantCount struct {
+	Const float64 `json:"const"`
+	Count int     `json:"count"`
+}
+
+type ChunithmLevelDistribution struct {
+	Level     string                  `json:"level"`
+	Count     int                     `json:"count"`
+	Constants []ChunithmConstantCount `json:"constants"`
+}
+
+type ChunithmCreatorStat struct {
+	Creator    string `json:"creator"`
+	ChartCount int    `json:"chart_count"`
+}
+
+type ChunithmNoteCounts struct {
+	Tap   int `json:"tap"`
+	Hold  int `json:"hold"`
+	Slide int `json:"slide"`
+	Air   int `json:"air"`
+	Flick int `json:"flick"`
+	Total int `json:"total"`
+}
+
+type ChunithmNoteComposition struct {
+	Tap   float64 `json:"tap"`
+	Hold  float64 `json:"hold"`
+	Slide float64 `json:"slide"`
+	Air   float64 `json:"air"`
+	Flick float64 `json:"flick"`
+}
+
+type ChunithmChartDetail struct {
+	ChunithmChartSummary
+	Counts      ChunithmNoteCounts      `json:"counts"`
+	Composition ChunithmNoteComposition `json:"composition"`
+}
+
+type ChunithmChartComparison struct {
+	A     ChunithmChartDetail     `json:"a"`
+	B     ChunithmChartDetail     `json:"b"`
+	Delta ChunithmNoteCounts      `json:"delta"`
+	Share ChunithmNoteComposition `json:"composition_delta"`
+}
+
+// ================= Chunithm Music Ingestion Types =================
+
+type ChunithmDatasetMusic struct {
+	MusicID     int                         `json:"music_id"`
+	Title       string                      `json:"title"`
+	Artist      string                      `json:"artist"`
+	Category    *string                     `json:"category,omitempty"`
+	Version     *string                     `json:"version,omitempty"`
+	ReleaseDate *time.Time                  `json:"release_date,omitempty"`
+	Difficulty  []*float64                  `json:"difficulty"`
+	Charts      []ChunithmChartData         `json:"charts,omitempty"`
+	Servers     []ChunithmMusicAvailability `json:"servers,omitempty"`
+}
+
+type ChunithmMusicDataset struct {
+	Version            string                 `json:"version"`
+	VersionName        *string                `json:"version_name,omitempty"`
+	VersionReleaseDate *time.Time             `json:"version_release_date,omitempty"`
+	ReleaseOrder       *int                   `json:"release_order,omitempty"`
+	Music              []ChunithmDatasetMusic `json:"music"`
+}
+
+// ChunithmChartRef names one chart of a song.
+type ChunithmChartRef struct {
+	MusicID    int `json:"music_id"`
+	Difficulty int `json:"difficulty"`
+}
+
+type ChunithmIngestReport struct {
+	Version             string `json:"version"`
+	DryRun              bool   `json:"dry_run"`
+	Added               []int  `json:"added"`
+	Updated             []int  `json:"updated"`
+	Restored            []int  `json:"restored"`
+	Deleted             []int  `json:"deleted"`
+	Unchanged           int    `json:"unchanged"`
+	DifficultiesWritten int    `json:"difficulties_written"`
+	DifficultiesDeleted int    `json:"difficulties_deleted"`
+	ChartsWritten       int    `json:"charts_written"`
+	// ChartsDeleted lists the charts of ingested songs the dataset no
+	// longer contains.
+	ChartsDeleted       []ChunithmChartRef `json:"charts_deleted"`
+	AvailabilityWritten int                `json:"availability_written"`
+}
+
+// ================= Chunithm Rating Types =================
+
+type ChunithmRatingPlay struct {
+	MusicID    int `json:"music_id"`
+	Difficulty int `json:"difficulty"`
+	Score      int `json:"score"`
+}
+
+type ChunithmRatingRequest struct {
+	Version string               `json:"version"`
+	Plays   []ChunithmRatingPlay `json:"plays"`
+}
+
+type ChunithmRatingPlayResult struct {
+	MusicID    int      `json:"music_id"`
+	Difficulty int      `json:"difficulty"`
+	Score      int      `json:"score"`
+	Title      *string  `json:"title,omitempty"`
+	Const      *float64 `json:"const"`
+	Rating     float64  `json:"rating"`
+	IsNew      bool     `json:"is_new"`
+}
+
+type ChunithmRatingResult struct {
+	Version   string                     `json:"version"`
+	Rating    float64                    `json:"rating"`
+	BestTotal float64                    `json:"best_total"`
+	NewTotal  float64                    `json:"new_total"`
+	Best      []ChunithmRatingPlayResult `json:"best"`
+	New       []ChunithmRatingPlayResult `json:"new"`
+	Plays     []ChunithmRatingPlayResult `json:"plays"`
+	Missing   []ChunithmRatingPlay       `json:"missing"`
+}
+
+type ChunithmRecommendRequest struct {
+	Version      string               `json:"version"`
+	Plays        []ChunithmRatingPlay `json:"plays"`
+	TargetRating *float64             `json:"target_rating,omitempty"`
+	Category     *string              `json:"category,omitempty"`
+	MusicVersion *string              `json:"music_version,omitempty"`
+	Difficulty   *int                 `json:"difficulty,omitempty"`
+	Limit        int                  `json:"limit,omitempty"`
+}
+
+type ChunithmRecommendation struct {
+	MusicID       int      `json:"music_id"`
+	Difficulty    int      `json:"difficulty"`
+	Title         string   `json:"title"`
+	Category      *string  `json:"category,omitempty"`
+	Version       *string  `json:"version,omitempty"`
+	Const         float64  `json:"const"`
+	Frame         string   `json:"frame"`
+	CurrentScore  *int     `json:"current_score,omitempty"`
+	CurrentRating *float64 `json:"current_rating,omitempty"`
+	MinScore      int      `json:"min_score"`
+	// TargetScore is the score that alone raises the overall rating to the
+	// target, omitted when no score on the chart is enough.
+	TargetScore *int    `json:"target_score,omitempty"`
+	MaxGain     float64 `json:"max_gain"`
+}
+
+type ChunithmRecommendResult struct {
+	Version      string   `json:"version"`
+	Rating       float64  `json:"rating"`
+	TargetRating *float64 `json:"target_rating,omitempty"`
+	// TargetGain is how much the frame total must still grow to reach the
+	// target rating; zero once it is reached.
+	TargetGain *float64                 `json:"target_gain,omitempty"`
+	BestFloor  float64                  `json:"best_floor"`
+	NewFloor   float64                  `json:"new_floor"`
+	Items      []ChunithmRecommendation `json:"items"`
 }
 
 // ================= Chunithm Binding Types =================
@@ -51,9 +286,47 @@ type ChunithmDefaultServer struct {
 }
 
 type ChunithmBinding struct {
-	UserID int     `json:"user_id"`
-	Server *string `json:"server,omitempty"`
-	AimeID *string `json:"aime_id,omitempty"`
+	ID        int     `json:"id"`
+	UserID    int     `json:"user_id"`
+	Server    *string `json:"server,omitempty"`
+	AimeID    *string `json:"aime_id,omitempty"`
+	Label     *string `json:"label,omitempty"`
+	Visible   bool    `json:"visible"`
+	IsDefault bool    `json:"is_default"`
+	SortOrder int     `json:"sort_order"`
+}
+
+type ChunithmBindingListResponse struct {
+	Bindings []ChunithmBinding `json:"bindings"`
+}
+
+type ChunithmAddBindingRequest struct {
+	Server    string  `json:"server"`
+	AimeID    string  `json:"aime_id"`
+	Label     *string `json:"label,omitempty"`
+	Visible   *bool   `json:"visible,omitempty"`
+	IsDefault bool    `json:"is_default"`
+}
+
+type ChunithmUpdateBindingRequest struct {
+	Label   *string `json:"label,omitempty"`
+	Visible *bool   `json:"visible,omitempty"`
+}
+
+type ChunithmReorderBindingsRequest struct {
+	Server     string `json:"server"`
+	BindingIDs []int  `json:"binding_ids"`
+}
+
+type ChunithmAddBindingResponse struct {
+	BindingID int  `json:"binding_id"`
+	Shared    bool `json:"shared,omitempty"`
+}
+
+type ChunithmBindingOwnersResponse struct {
+	Server   string            `json:"server"`
+	AimeID   string            `json:"aime_id"`
+	Bindings []ChunithmBinding `json:"bindings"`
 }
 
 // ================= Chunithm Alias Types =================
@@ -62,3 +335,11 @@ type ChunithmMusicAlias struct {
 	ID    int64  `json:"id,omitempty"`
 	Alias string `json:"alias"`
 }
+
+type ChunithmPendingAlias struct {
+	ID          int64     `json:"id"`
+	MusicID     int       `json:"music_id"`
+	Alias       string    `json:"alias"`
+	SubmittedBy string    `json:"submitted_by"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
@@ -0,0 +1,32 @@
+package types
+
+// ================= Change Event Types =================
+
+// ChangeEvent is published whenever a binding or preference of a user
+// changes, so consumers can drop what they cached for that user. Fields that
+// do not apply to the event type are omitted.
+type ChangeEvent struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	Game         string `json:"game,omitempty"`
+	HarukiUserID int    `json:"haruki_user_id"`
+	Server       string `json:"server,omitempty"`
+	BindingID    int    `json:"binding_id,omitempty"`
+	// Scope and ScopeKey locate the changed preferences; Options lists them.
+	Scope     string   `json:"scope,omitempty"`
+	ScopeKey  string   `json:"scope_key,omitempty"`
+	Options   []string `json:"options,omitempty"`
+	Timestamp int64    `json:"timestamp"`
+}
+
+// WebhookDeadLetter is kept in the dead-letter list for a delivery whose
+// last attempt failed. Payload is the exact body that was signed and sent.
+type WebhookDeadLetter struct {
+	URL       string `json:"url"`
+	EventID   string `json:"event_id"`
+	EventType string `json:"event_type"`
+	Payload   string `json:"payload"`
+	Attempts  int    `json:"attempts"`
+	LastError string `json:"last_error"`
+	FailedAt  int64  `json:"failed_at"`
+}
@@ -0,0 +1,53 @@
+package types
+
+// ================= Game Binding Types =================
+
+type GameBinding struct {
+	ID           int     `json:"id"`
+	Game         string  `json:"game"`
+	HarukiUserID int     `json:"haruki_user_id"`
+	Server       string  `json:"server"`
+	AccountID    string  `json:"account_id"`
+	Label        *string `json:"label,omitempty"`
+	Visible      bool    `json:"visible"`
+	IsDefault    bool    `json:"is_default"`
+	SortOrder    int     `json:"sort_order"`
+}
+
+type GameBindingListResponse struct {
+	Game     string        `json:"game"`
+	Bindings []GameBinding `json:"bindings"`
+}
+
+type AddGameBindingRequest struct {
+	Server    string  `json:"server"`
+	AccountID string  `json:"account_id"`
+	Label     *string `json:"label,omitempty"`
+	Visible   *bool   `json:"visible,omitempty"`
+	IsDefault bool    `json:"is_default"`
+}
+
+type AddGameBindingResponse struct {
+	BindingID int  `json:"binding_id"`
+	Shared    bool `json:"shared,omitempty"`
+}
+
+type UpdateGameBindingRequest struct {
+	Label   *string `json:"label,omitempty"`
+	Visible *bool   `json:"visible,omitempty"`
+}
+
+type ReorderGameBindingsRequest struct {
+	Server     string `json:"server"`
+	BindingIDs []int  `json:"binding_ids"`
+}
+
+// GameInfo describes a game registered with the binding subsystem.
+type GameInfo struct {
+	Game    string   `json:"game"`
+	Servers []string `json:"servers"`
+}
+
+type GameListResponse struct {
+	Games []GameInfo `json:"games"`
+}
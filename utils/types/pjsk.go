@@ -18,6 +18,11 @@ type PJSKPendingAlias struct {
 type PJSKPreference struct {
 	Option string `json:"option,omitempty"`
 	Value  string `json:"value"`
+	// IsDefault marks a registered option the user has not set, reported with
+	// its default value.
+	IsDefault bool `json:"is_default,omitempty"`
+	// Source is the scope the effective value was resolved from.
+	Source string `json:"source,omitempty"`
 }
 
 type PJSKPreferenceResponse struct {
@@ -28,11 +33,20 @@ type PJSKPreferenceResponse struct {
 // ================= PJSK Binding Types =================
 
 type PJSKBinding struct {
-	ID           int    `json:"id"`
-	HarukiUserID int    `json:"haruki_user_id"`
-	Server       string `json:"server"`
-	UserID       string `json:"user_id"`
-	Visible      bool   `json:"visible"`
+	// ID is left out of the third-party view.
+	ID           int        `json:"id,omitempty"`
+	HarukiUserID int        `json:"haruki_user_id"`
+	Server       string     `json:"server"`
+	UserID       string     `json:"user_id"`
+	Visible      bool       `json:"visible"`
+	Verified     bool       `json:"verified"`
+	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
+	Label        *string    `json:"label,omitempty"`
+	SortOrder    int        `json:"sort_order"`
+	// IsDefault marks the default binding of its server and IsGlobalDefault
+	// the binding used when no server is given.
+	IsDefault       bool `json:"is_default"`
+	IsGlobalDefault bool `json:"is_global_default"`
 }
 
 type PJSKBindingResponse struct {
@@ -41,5 +55,46 @@ type PJSKBindingResponse struct {
 }
 
 type PJSKAddBindingResponse struct {
-	BindingID int `json:"binding_id"`
+	BindingID int  `json:"binding_id"`
+	Shared    bool `json:"shared,omitempty"`
+}
+
+type PJSKResolvedBindingResponse struct {
+	Binding PJSKBinding `json:"binding"`
+	Rule    string      `json:"rule"`
+}
+
+type PJSKBatchBindingRequest struct {
+	HarukiUserIDs []int  `json:"haruki_user_ids"`
+	Server        string `json:"server"`
+}
+
+type PJSKBatchBindingResponse struct {
+	Bindings map[int]PJSKResolvedBindingResponse `json:"bindings"`
+	Missing  []int                               `json:"missing"`
+}
+
+type PJSKUpdateBindingRequest struct {
+	Visible *bool   `json:"visible,omitempty"`
+	Label   *string `json:"label,omitempty"`
+}
+
+type PJSKReorderBindingsRequest struct {
+	Server     string `json:"server"`
+	BindingIDs []int  `json:"binding_ids"`
+}
+
+type PJSKBindingChallengeResponse struct {
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type PJSKVerifyBindingRequest struct {
+	ProfileText string `json:"profile_text"`
+}
+
+type PJSKBindingOwnersResponse struct {
+	Server   string        `json:"server"`
+	UserID   string        `json:"user_id"`
+	Bindings []PJSKBinding `json:"bindings"`
 }
@@ -0,0 +1,46 @@
+package types
+
+// ================= Preference Types =================
+
+// PreferenceDefinition describes a registered preference option.
+type PreferenceDefinition struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+	Min           *int     `json:"min,omitempty"`
+	Max           *int     `json:"max,omitempty"`
+	MaxLength     int      `json:"max_length,omitempty"`
+	Default       string   `json:"default"`
+	Description   string   `json:"description"`
+}
+
+type PreferenceSchemaResponse struct {
+	Options []PreferenceDefinition `json:"options"`
+	// FreeFormPrefix marks experimental options that skip the registry and
+	// accept any string value up to FreeFormMaxLength.
+	FreeFormPrefix    string `json:"free_form_prefix"`
+	FreeFormMaxLength int    `json:"free_form_max_length"`
+}
+
+// BulkPreferenceRequest maps options to their new values; a null value
+// deletes the option.
+type BulkPreferenceRequest map[string]*string
+
+type PreferenceValueRequest struct {
+	Value string `json:"value"`
+}
+
+// EffectivePreference is the value of an option after scope resolution.
+type EffectivePreference struct {
+	Option string `json:"option"`
+	Value  string `json:"value"`
+	// Source is group, game, global or default.
+	Source string `json:"source"`
+	// ScopeKey is the game or platform:group_id the value was stored under.
+	ScopeKey string `json:"scope_key,omitempty"`
+}
+
+type EffectivePreferenceResponse struct {
+	Options []EffectivePreference `json:"options,omitempty"`
+	Option  *EffectivePreference  `json:"option,omitempty"`
+}